@@ -61,6 +61,7 @@ const (
 
 type startupOptions struct {
 	directLaunchConnString string
+	readOnly               bool
 	informationalCommand   startupInformationalCommand
 }
 
@@ -211,6 +212,9 @@ func parseStartupOptions(args []string) (startupOptions, error) {
 			if options.directLaunchConnString != "" {
 				return startupOptions{}, newStartupUsageError("informational flag cannot be combined with -d/--database in the same startup invocation")
 			}
+			if options.readOnly {
+				return startupOptions{}, newStartupUsageError("informational flag cannot be combined with --read-only in the same startup invocation")
+			}
 			helpFlagCount++
 			if helpFlagCount > 1 {
 				return startupOptions{}, newStartupUsageError("help flag was provided more than once; use exactly one of -h or --help")
@@ -223,6 +227,9 @@ func parseStartupOptions(args []string) (startupOptions, error) {
 			if options.directLaunchConnString != "" {
 				return startupOptions{}, newStartupUsageError("informational flag cannot be combined with -d/--database in the same startup invocation")
 			}
+			if options.readOnly {
+				return startupOptions{}, newStartupUsageError("informational flag cannot be combined with --read-only in the same startup invocation")
+			}
 			versionFlagCount++
 			if versionFlagCount > 1 {
 				return startupOptions{}, newStartupUsageError("version flag was provided more than once; use exactly one of -v or --version")
@@ -244,9 +251,17 @@ func parseStartupOptions(args []string) (startupOptions, error) {
 			}
 
 			options.directLaunchConnString = next
+		case "--read-only":
+			if options.readOnly {
+				return startupOptions{}, newStartupUsageError("read-only flag was provided more than once; use --read-only at most once")
+			}
+			if options.informationalCommand != startupInformationalNone {
+				return startupOptions{}, newStartupUsageError("informational flag cannot be combined with --read-only in the same startup invocation")
+			}
+			options.readOnly = true
 		default:
 			return startupOptions{}, newStartupUsageErrorf(
-				"unsupported startup argument %q; supported options: -d <sqlite-db-path>, --database <sqlite-db-path>, -h/--help, -v/--version",
+				"unsupported startup argument %q; supported options: -d <sqlite-db-path>, --database <sqlite-db-path>, --read-only, -h/--help, -v/--version",
 				parser.current(),
 			)
 		}
@@ -318,10 +333,13 @@ func renderStartupHelpOutput() string {
 		"Options:",
 		"  -h, --help                      Show startup help and exit.",
 		"  -v, --version                   Print build version token and exit.",
-		"  -d, --database <sqlite-db-path> Launch directly with a SQLite database path.",
+		"  -d, --database <sqlite-db-path> Launch directly with a SQLite database path or file: URI.",
+		"      --read-only                 Open databases read-only for the whole session.",
 		"",
 		"Examples:",
 		"  dbc --database ./data/app.sqlite",
+		"  dbc --database ./data/prod.sqlite --read-only",
+		"  dbc --database 'file:./data/app.sqlite?immutable=1'",
 		"  dbc --version",
 	}
 
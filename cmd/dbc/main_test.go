@@ -94,6 +94,16 @@ func TestParseStartupOptions_SuccessCases(t *testing.T) {
 			args: []string{"-v"},
 			want: startupOptions{informationalCommand: startupInformationalVersion},
 		},
+		{
+			name: "accepts read-only flag with direct launch",
+			args: []string{"-d", "/tmp/direct.sqlite", "--read-only"},
+			want: startupOptions{directLaunchConnString: "/tmp/direct.sqlite", readOnly: true},
+		},
+		{
+			name: "accepts read-only flag without direct launch",
+			args: []string{"--read-only"},
+			want: startupOptions{readOnly: true},
+		},
 	}
 
 	for _, tc := range cases {
@@ -138,6 +148,16 @@ func TestParseStartupOptions_ValidationFailures(t *testing.T) {
 			args:      []string{"--help", "-d", "/tmp/direct.sqlite"},
 			errTokens: []string{"cannot be combined"},
 		},
+		{
+			name:      "rejects repeated read-only flag",
+			args:      []string{"--read-only", "--read-only"},
+			errTokens: []string{"more than once", "--read-only"},
+		},
+		{
+			name:      "rejects mixed informational and read-only flags",
+			args:      []string{"--read-only", "--version"},
+			errTokens: []string{"cannot be combined", "--read-only"},
+		},
 		{
 			name:      "rejects mixed informational flags",
 			args:      []string{"--help", "--version"},
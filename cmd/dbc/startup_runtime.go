@@ -7,9 +7,14 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/mgierok/dbc/internal/application/port"
 	"github.com/mgierok/dbc/internal/application/usecase"
 	"github.com/mgierok/dbc/internal/infrastructure/config"
 	"github.com/mgierok/dbc/internal/infrastructure/engine"
+	"github.com/mgierok/dbc/internal/infrastructure/export"
+	"github.com/mgierok/dbc/internal/infrastructure/filesystem"
+	"github.com/mgierok/dbc/internal/infrastructure/history"
+	"github.com/mgierok/dbc/internal/infrastructure/importer"
 	"github.com/mgierok/dbc/internal/interfaces/tui"
 )
 
@@ -28,6 +33,8 @@ type runtimeStartupDependencies struct {
 	createConfiguredDB        *usecase.CreateConfiguredDatabase
 	updateConfiguredDB        *usecase.UpdateConfiguredDatabase
 	deleteConfiguredDB        *usecase.DeleteConfiguredDatabase
+	historyStore              port.HistoryStore
+	configStore               port.ConfigStore
 }
 
 func newRuntimeStartupDependencies() (runtimeStartupDependencies, error) {
@@ -44,6 +51,8 @@ func newRuntimeStartupDependencies() (runtimeStartupDependencies, error) {
 		createConfiguredDB:        usecase.NewCreateConfiguredDatabase(configStore, connectionChecker),
 		updateConfiguredDB:        usecase.NewUpdateConfiguredDatabase(configStore, connectionChecker),
 		deleteConfiguredDB:        usecase.NewDeleteConfiguredDatabase(configStore),
+		historyStore:              history.NewStore(history.DirFromConfigPath(cfgPath)),
+		configStore:               configStore,
 	}, nil
 }
 
@@ -197,6 +206,9 @@ func (o *runtimeStartupOrchestrator) openRuntimeRunDeps(selected tui.DatabaseOpt
 	if connectDatabaseFn == nil {
 		connectDatabaseFn = connectSelectedDatabase
 	}
+	if o.options.readOnly {
+		selected.ReadOnly = true
+	}
 
 	db, err := connectDatabaseFn(selected)
 	if err != nil {
@@ -204,17 +216,37 @@ func (o *runtimeStartupOrchestrator) openRuntimeRunDeps(selected tui.DatabaseOpt
 	}
 
 	sqliteEngine := engine.NewSQLiteEngine(db)
+	var runtimeHistory *usecase.RuntimeHistory
+	if o.deps.historyStore != nil {
+		runtimeHistory = usecase.NewRuntimeHistory(o.deps.historyStore, selected.ConnString)
+	}
+	var savedViews *usecase.SavedViews
+	if o.deps.configStore != nil {
+		savedViews = usecase.NewSavedViews(o.deps.configStore, sqliteEngine, selected.ConnString)
+	}
 	return tui.RuntimeRunDeps{
 		ListTables:             usecase.NewListTables(sqliteEngine),
 		GetSchema:              usecase.NewGetSchema(sqliteEngine),
 		ListRecords:            usecase.NewListRecords(sqliteEngine),
+		CountRecords:           usecase.NewCountRecords(sqliteEngine),
 		ListOperators:          usecase.NewListOperators(sqliteEngine),
 		SaveChanges:            usecase.NewSaveTableChanges(sqliteEngine),
+		PreviewChanges:         usecase.NewPreviewDatabaseChanges(sqliteEngine),
+		ExportChanges:          usecase.NewExportDatabaseChanges(sqliteEngine, filesystem.NewFileWriter()),
+		ExportRecords:          usecase.NewExportRecords(sqliteEngine, filesystem.NewFileWriter(), export.NewRegistry()),
+		ImportRecords:          usecase.NewImportRecords(filesystem.NewFileReader(), importer.NewRegistry(), usecase.NewStagingPolicy(), usecase.NewStagedChangesTranslator()),
+		YankRecords:            usecase.NewYankRecords(sqliteEngine, export.NewRegistry()),
+		ReadRecord:             usecase.NewReadRecord(sqliteEngine),
+		RunSQL:                 usecase.NewRunSQL(sqliteEngine),
+		History:                runtimeHistory,
+		SavedViews:             savedViews,
 		SaveWorkflow:           usecase.NewRuntimeSaveWorkflow(),
 		RecordLimitPolicy:      usecase.NewRuntimeRecordLimitPolicy(),
 		NavigationWorkflow:     usecase.NewRuntimeNavigationWorkflow(),
 		DatabaseTargetResolver: usecase.NewRuntimeDatabaseTargetResolver(),
 		Translator:             usecase.NewStagedChangesTranslator(),
+		ReadOnly:               selected.ReadOnly,
+		ConfirmSave:            selected.ConfirmSave,
 		DatabaseSelector: &tui.RuntimeDatabaseSelectorDeps{
 			LoadDatabaseSelectorState: o.deps.loadDatabaseSelectorState,
 			ListConfiguredDatabases:   o.deps.listConfiguredDatabases,
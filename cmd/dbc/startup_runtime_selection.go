@@ -93,9 +93,12 @@ func listConfiguredDatabaseOptions(ctx context.Context, listConfiguredDatabases
 	options := make([]tui.DatabaseOption, len(entries))
 	for i, entry := range entries {
 		options[i] = tui.DatabaseOption{
-			Name:       entry.Name,
-			ConnString: entry.Path,
-			Source:     tui.DatabaseOptionSourceConfig,
+			Name:        entry.Name,
+			ConnString:  entry.Path,
+			Source:      tui.DatabaseOptionSourceConfig,
+			ReadOnly:    entry.ReadOnly,
+			ConfirmSave: entry.ConfirmSave,
+			Pragmas:     entry.Pragmas,
 		}
 	}
 	return options, nil
@@ -110,7 +113,10 @@ func sqliteConnectionIdentityEqual(left string, right string) bool {
 }
 
 func connectSelectedDatabase(selected tui.DatabaseOption) (*sql.DB, error) {
-	return engine.OpenSQLiteDatabase(context.Background(), selected.ConnString)
+	return engine.OpenSQLiteDatabaseWithOptions(context.Background(), selected.ConnString, engine.SQLiteOpenOptions{
+		ReadOnly: selected.ReadOnly,
+		Pragmas:  engine.SQLitePragmas(selected.Pragmas),
+	})
 }
 
 func buildConnectionFailureStatus(selected tui.DatabaseOption, reason string) string {
@@ -177,9 +183,12 @@ func runtimeDatabaseOptionsFromSelectorOptions(options []tui.DatabaseOption) []u
 			source = usecase.RuntimeDatabaseOptionSourceCLI
 		}
 		converted[i] = usecase.RuntimeDatabaseOption{
-			Name:       option.Name,
-			ConnString: option.ConnString,
-			Source:     source,
+			Name:        option.Name,
+			ConnString:  option.ConnString,
+			Source:      source,
+			ReadOnly:    option.ReadOnly,
+			ConfirmSave: option.ConfirmSave,
+			Pragmas:     option.Pragmas,
 		}
 	}
 	return converted
@@ -191,9 +200,12 @@ func selectorOptionFromRuntimeDatabaseOption(option usecase.RuntimeDatabaseOptio
 		source = tui.DatabaseOptionSourceCLI
 	}
 	return tui.DatabaseOption{
-		Name:       option.Name,
-		ConnString: option.ConnString,
-		Source:     source,
+		Name:        option.Name,
+		ConnString:  option.ConnString,
+		Source:      source,
+		ReadOnly:    option.ReadOnly,
+		ConfirmSave: option.ConfirmSave,
+		Pragmas:     option.Pragmas,
 	}
 }
 
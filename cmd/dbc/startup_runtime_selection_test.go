@@ -463,3 +463,11 @@ func (f *fakeStartupSelectionConfigStore) Delete(_ context.Context, index int) e
 func (f *fakeStartupSelectionConfigStore) ActivePath(_ context.Context) (string, error) {
 	return "/tmp/config.json", nil
 }
+
+func (f *fakeStartupSelectionConfigStore) ListViews(_ context.Context, _ int) ([]port.ConfigView, error) {
+	return nil, nil
+}
+
+func (f *fakeStartupSelectionConfigStore) SaveView(_ context.Context, _ int, _ port.ConfigView) error {
+	return nil
+}
@@ -45,6 +45,12 @@ func TestNewRuntimeStartupDependencies_UsesDefaultConfigPathAndBuildsUseCases(t
 	if deps.loadDatabaseSelectorState == nil {
 		t.Fatal("expected loadDatabaseSelectorState use case to be initialized")
 	}
+	if deps.historyStore == nil {
+		t.Fatal("expected historyStore to be initialized")
+	}
+	if deps.configStore == nil {
+		t.Fatal("expected configStore to be initialized")
+	}
 
 	state, err := deps.loadDatabaseSelectorState.Execute(context.Background(), dto.DatabaseSelectorLoadInput{})
 	if err != nil {
@@ -746,3 +752,68 @@ func TestConnectSelectedDatabase_OpensReachableSQLiteFile(t *testing.T) {
 		t.Fatalf("expected sqlite connection close to succeed, got %v", err)
 	}
 }
+
+func TestRuntimeStartupOrchestratorOpenRuntimeRunDeps_ReadOnlyFlagForcesReadOnlyConnection(t *testing.T) {
+	// Arrange
+	selected := tui.DatabaseOption{
+		Name:       "prod",
+		ConnString: "/tmp/prod.sqlite",
+		Source:     tui.DatabaseOptionSourceConfig,
+	}
+	orchestrator := newRuntimeStartupOrchestrator(startupOptions{readOnly: true}, runtimeStartupDependencies{})
+	var connected tui.DatabaseOption
+	orchestrator.connectDatabaseFn = func(got tui.DatabaseOption) (*sql.DB, error) {
+		connected = got
+		return &sql.DB{}, nil
+	}
+
+	// Act
+	runtimeDeps, err := orchestrator.openRuntimeRunDeps(selected)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected runtime deps, got %v", err)
+	}
+	if !connected.ReadOnly {
+		t.Fatal("expected read-only flag to force a read-only connection")
+	}
+	if !runtimeDeps.ReadOnly {
+		t.Fatal("expected runtime deps to mark the session read-only")
+	}
+	if !runtimeDeps.DatabaseSelector.CurrentDatabase.ReadOnly {
+		t.Fatal("expected current database option to carry read-only mode")
+	}
+}
+
+func TestConnectSelectedDatabase_OpensReadOnlyOptionWithoutWriteAccess(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	dbPath := filepath.Join(t.TempDir(), "readonly.sqlite")
+	seed, err := sql.Open("sqlite", dbPath)
+	if err != nil {
+		t.Fatalf("expected seed sqlite database to open, got %v", err)
+	}
+	if _, err := seed.Exec(`CREATE TABLE users (id INTEGER PRIMARY KEY)`); err != nil {
+		t.Fatalf("expected seed schema to be created, got %v", err)
+	}
+	if err := seed.Close(); err != nil {
+		t.Fatalf("expected seed sqlite database close to succeed, got %v", err)
+	}
+
+	// Act
+	db, err := connectSelectedDatabase(tui.DatabaseOption{
+		Name:       "readonly",
+		ConnString: dbPath,
+		ReadOnly:   true,
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected sqlite connection to open, got %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	if _, err := db.Exec(`INSERT INTO users (id) VALUES (1)`); err == nil {
+		t.Fatal("expected write through read-only connection to fail")
+	}
+}
@@ -1,6 +1,16 @@
 package dto
 
 type ConfigDatabase struct {
-	Name string
-	Path string
+	Name        string
+	Path        string
+	ReadOnly    bool
+	ConfirmSave bool
+	Pragmas     ConnectionPragmas
+}
+
+type ConnectionPragmas struct {
+	BusyTimeout int
+	JournalMode string
+	ForeignKeys bool
+	Synchronous string
 }
@@ -21,6 +21,9 @@ type DatabaseSelectorOption struct {
 	Name        string
 	ConnString  string
 	Source      DatabaseSelectorOptionSource
+	ReadOnly    bool
+	ConfirmSave bool
+	Pragmas     ConnectionPragmas
 	ConfigIndex int
 	CanEdit     bool
 	CanDelete   bool
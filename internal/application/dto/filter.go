@@ -12,16 +12,48 @@ const (
 	OperatorKindLike      OperatorKind = "like"
 	OperatorKindIsNull    OperatorKind = "is_null"
 	OperatorKindIsNotNull OperatorKind = "is_not_null"
+	OperatorKindEqNoCase  OperatorKind = "eq_nocase"
+	OperatorKindNotLike   OperatorKind = "not_like"
+	OperatorKindGlob      OperatorKind = "glob"
+	OperatorKindStarts    OperatorKind = "starts_with"
+	OperatorKindEnds      OperatorKind = "ends_with"
+	OperatorKindContains  OperatorKind = "contains"
+	OperatorKindBetween   OperatorKind = "between"
+	OperatorKindIn        OperatorKind = "in"
+	OperatorKindNotIn     OperatorKind = "not_in"
+)
+
+type OperatorValueMode string
+
+const (
+	OperatorValueSingle OperatorValueMode = ""
+	OperatorValueRange  OperatorValueMode = "range"
+	OperatorValueList   OperatorValueMode = "list"
 )
 
 type Operator struct {
 	Name          string
 	Kind          OperatorKind
 	RequiresValue bool
+	ValueMode     OperatorValueMode
 }
 
 type Filter struct {
 	Column   string
 	Operator Operator
 	Value    string
+	Values   []string
+}
+
+type FilterLogic string
+
+const (
+	FilterLogicAnd FilterLogic = "AND"
+	FilterLogicOr  FilterLogic = "OR"
+)
+
+type FilterGroup struct {
+	Logic      FilterLogic
+	Conditions []Filter
+	Groups     []FilterGroup
 }
@@ -0,0 +1,8 @@
+package dto
+
+type HistoryKind string
+
+const (
+	HistoryKindCommands HistoryKind = "commands"
+	HistoryKindSQL      HistoryKind = "sql"
+)
@@ -1,7 +1,10 @@
 package dto
 
 type RecordRow struct {
-	Values              []string
+	Values []string
+	// Nulls marks the Values that are SQL NULL; Values shows them as "NULL",
+	// the same text a stored "NULL" string shows.
+	Nulls               []bool
 	EditableFromBrowse  []bool
 	RowKey              string
 	Identity            RecordIdentity
@@ -11,5 +14,6 @@ type RecordRow struct {
 type RecordPage struct {
 	Rows       []RecordRow
 	HasMore    bool
-	TotalCount int
+	NextCursor string
+	PrevCursor string
 }
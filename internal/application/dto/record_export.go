@@ -0,0 +1,13 @@
+package dto
+
+// RecordExport describes a file export of every record of Table that
+// matches Filter, in Sorts order.
+type RecordExport struct {
+	Table    string
+	Format   string
+	Path     string
+	Filter   *FilterGroup
+	Sorts    []Sort
+	Blob     string
+	NullText string
+}
@@ -0,0 +1,38 @@
+package dto
+
+// ImportFile is a parsed import file. Rows keeps every data line, including
+// the ones that could not be parsed.
+type ImportFile struct {
+	Path    string
+	Format  string
+	Headers []string
+	Rows    []ImportRow
+}
+
+// ImportRow is one data line of an import file. Values is keyed by header;
+// Err describes why the line could not be parsed.
+type ImportRow struct {
+	Line   int
+	Values map[string]ImportValue
+	Err    string
+}
+
+type ImportValue struct {
+	Text   string
+	IsNull bool
+}
+
+// ImportLineError reports a line of an import file that was not staged.
+// Column is empty when the error concerns the whole line.
+type ImportLineError struct {
+	Line    int
+	Column  string
+	Message string
+}
+
+// ImportResult holds the insert rows built from the valid lines of an import
+// file and the errors of the rejected ones.
+type ImportResult struct {
+	Inserts []PendingInsertRow
+	Errors  []ImportLineError
+}
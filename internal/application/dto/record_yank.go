@@ -0,0 +1,19 @@
+package dto
+
+// YankRow is one row to copy. Its stored values are read by Identity when
+// it has keys; Values replaces single columns, such as staged edits, or holds
+// every column of a row that is not stored yet.
+type YankRow struct {
+	Identity RecordIdentity
+	Values   map[int]StagedValue
+}
+
+// RecordYank describes a clipboard copy of Rows of Table in Format.
+type RecordYank struct {
+	Table    string
+	Columns  []SchemaColumn
+	Format   string
+	Rows     []YankRow
+	Blob     string
+	NullText string
+}
@@ -0,0 +1,9 @@
+package dto
+
+// SavedView is a named filter and sort of one table.
+type SavedView struct {
+	Name   string
+	Table  string
+	Filter *FilterGroup
+	Sorts  []Sort
+}
@@ -3,6 +3,12 @@ package dto
 type Schema struct {
 	TableName string
 	Columns   []SchemaColumn
+	Indexes   []SchemaIndex
+	Triggers  []SchemaTrigger
+	// Constraints lists foreign key, UNIQUE, and CHECK constraints at table
+	// level, in that order.
+	Constraints []SchemaConstraint
+	SQL         string
 }
 
 type ColumnInputKind string
@@ -18,19 +24,65 @@ type ColumnInput struct {
 }
 
 type SchemaColumn struct {
-	Name           string
-	Type           string
-	Nullable       bool
-	PrimaryKey     bool
-	Unique         bool
-	DefaultValue   *string
-	AutoIncrement  bool
-	ForeignKeys    []ForeignKeyRef
-	MetadataBadges []string
-	Input          ColumnInput
+	Name          string
+	Type          string
+	Nullable      bool
+	PrimaryKey    bool
+	Unique        bool
+	DefaultValue  *string
+	AutoIncrement bool
+	ForeignKeys   []ForeignKeyRef
+	// Generated columns are computed by the database and never written.
+	Generated            bool
+	GeneratedStored      bool
+	GenerationExpression string
+	MetadataBadges       []string
+	Input                ColumnInput
 }
 
 type ForeignKeyRef struct {
 	Table  string
 	Column string
 }
+
+type SchemaIndexOrigin string
+
+const (
+	SchemaIndexOriginCreate     SchemaIndexOrigin = "c"
+	SchemaIndexOriginUnique     SchemaIndexOrigin = "u"
+	SchemaIndexOriginPrimaryKey SchemaIndexOrigin = "pk"
+)
+
+type SchemaIndex struct {
+	Name    string
+	Columns []string
+	Unique  bool
+	Partial bool
+	Origin  SchemaIndexOrigin
+	SQL     string
+}
+
+type SchemaTrigger struct {
+	Name string
+	SQL  string
+}
+
+type SchemaConstraintKind string
+
+const (
+	SchemaConstraintForeignKey SchemaConstraintKind = "FOREIGN KEY"
+	SchemaConstraintUnique     SchemaConstraintKind = "UNIQUE"
+	SchemaConstraintCheck      SchemaConstraintKind = "CHECK"
+)
+
+type SchemaConstraint struct {
+	Kind       SchemaConstraintKind
+	Name       string
+	Columns    []string
+	RefTable   string
+	RefColumns []string
+	OnUpdate   string
+	OnDelete   string
+	Expression string
+	Label      string
+}
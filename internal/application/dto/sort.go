@@ -7,7 +7,25 @@ const (
 	SortDirectionDesc SortDirection = "DESC"
 )
 
+type SortNulls string
+
+const (
+	SortNullsDefault SortNulls = ""
+	SortNullsFirst   SortNulls = "FIRST"
+	SortNullsLast    SortNulls = "LAST"
+)
+
+type SortCollation string
+
+const (
+	SortCollationDefault SortCollation = ""
+	SortCollationNoCase  SortCollation = "NOCASE"
+	SortCollationRTrim   SortCollation = "RTRIM"
+)
+
 type Sort struct {
 	Column    string
 	Direction SortDirection
+	Nulls     SortNulls
+	Collation SortCollation
 }
@@ -0,0 +1,20 @@
+package dto
+
+import "time"
+
+type SQLStatementKind string
+
+const (
+	SQLStatementQuery  SQLStatementKind = "query"
+	SQLStatementModify SQLStatementKind = "modify"
+	SQLStatementOther  SQLStatementKind = "other"
+)
+
+type SQLResult struct {
+	Kind         SQLStatementKind
+	Columns      []string
+	Rows         []RecordRow
+	HasMore      bool
+	RowsAffected int
+	Elapsed      time.Duration
+}
@@ -21,8 +21,9 @@ type RecordIdentity struct {
 }
 
 type RecordUpdate struct {
-	Identity RecordIdentity
-	Changes  []ColumnValue
+	Identity  RecordIdentity
+	Changes   []ColumnValue
+	Originals []ColumnValue
 }
 
 type RecordInsert struct {
@@ -38,6 +39,34 @@ type TableChanges struct {
 	Inserts []RecordInsert
 	Updates []RecordUpdate
 	Deletes []RecordDelete
+	Force   bool
+}
+
+type TableChangeSet struct {
+	Table   string
+	Changes TableChanges
+}
+
+// WriteStatement is one statement a save would run, with its bound values
+// rendered as SQL literals for display.
+type WriteStatement struct {
+	Table string
+	SQL   string
+	Args  []string
+}
+
+type ColumnConflict struct {
+	Column   string
+	Original StagedValue
+	Current  StagedValue
+	Staged   StagedValue
+}
+
+type RecordConflict struct {
+	Table    string
+	Identity RecordIdentity
+	Missing  bool
+	Columns  []ColumnConflict
 }
 
 type StagedEdit struct {
@@ -51,6 +80,15 @@ type PendingInsertRow struct {
 	ExplicitAuto map[int]bool
 }
 
+// BulkEditTarget is one row of a bulk cell edit: the pending insert InsertID
+// when set, otherwise the persisted Record with the loaded value of the
+// edited column.
+type BulkEditTarget struct {
+	InsertID InsertDraftID
+	Record   PersistedRecordRef
+	Original StagedValue
+}
+
 type InsertDraftSnapshot struct {
 	ID           InsertDraftID
 	Values       map[int]StagedEdit
@@ -58,8 +96,9 @@ type InsertDraftSnapshot struct {
 }
 
 type PendingRecordEdits struct {
-	Identity RecordIdentity
-	Changes  map[int]StagedEdit
+	Identity  RecordIdentity
+	Changes   map[int]StagedEdit
+	Originals map[int]StagedValue
 }
 
 type PendingRecordDelete struct {
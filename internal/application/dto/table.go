@@ -1,5 +1,20 @@
 package dto
 
+type TableKind string
+
+const (
+	TableKindTable TableKind = "table"
+	TableKindView  TableKind = "view"
+)
+
+type TableWriteTriggers struct {
+	Insert bool
+	Update bool
+	Delete bool
+}
+
 type Table struct {
-	Name string
+	Name      string
+	Kind      TableKind
+	InsteadOf TableWriteTriggers
 }
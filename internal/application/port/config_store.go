@@ -1,16 +1,45 @@
 package port
 
-import "context"
+import (
+	"context"
+
+	"github.com/mgierok/dbc/internal/domain/model"
+)
 
 type ConfigEntry struct {
+	Name        string
+	DBPath      string
+	ReadOnly    bool
+	ConfirmSave bool
+	Pragmas     ConnectionPragmas
+}
+
+// ConnectionPragmas are SQLite pragmas applied on connect. Zero values leave
+// SQLite's defaults in place.
+type ConnectionPragmas struct {
+	BusyTimeout int
+	JournalMode string
+	ForeignKeys bool
+	Synchronous string
+}
+
+// ConfigView is a named filter and sort of one table, saved with a database
+// entry. Filter operators carry only their kind.
+type ConfigView struct {
 	Name   string
-	DBPath string
+	Table  string
+	Filter *model.FilterGroup
+	Sorts  []model.Sort
 }
 
+// ConfigStore persists database entries. Update keeps the saved views of the
+// entry it replaces.
 type ConfigStore interface {
 	List(ctx context.Context) ([]ConfigEntry, error)
 	Create(ctx context.Context, entry ConfigEntry) error
 	Update(ctx context.Context, index int, entry ConfigEntry) error
 	Delete(ctx context.Context, index int) error
 	ActivePath(ctx context.Context) (string, error)
+	ListViews(ctx context.Context, index int) ([]ConfigView, error)
+	SaveView(ctx context.Context, index int, view ConfigView) error
 }
@@ -9,7 +9,17 @@ import (
 type Engine interface {
 	ListTables(ctx context.Context) ([]model.Table, error)
 	GetSchema(ctx context.Context, tableName string) (model.Schema, error)
-	ListRecords(ctx context.Context, tableName string, offset, limit int, filter *model.Filter, sort *model.Sort) (model.RecordPage, error)
+	ListRecords(ctx context.Context, tableName string, offset, limit int, filter *model.FilterGroup, sorts []model.Sort) (model.RecordPage, error)
+	ListRecordsFromCursor(ctx context.Context, tableName, cursor string, limit int, filter *model.FilterGroup, sorts []model.Sort) (model.RecordPage, error)
+	StreamRecords(ctx context.Context, tableName string, filter *model.FilterGroup, sorts []model.Sort, writer RecordWriter) error
+	ReadRecord(ctx context.Context, tableName string, identity model.RecordIdentity) ([]model.Value, error)
+	CountRecords(ctx context.Context, tableName string, filter *model.FilterGroup) (int, error)
+	EstimateRecordCount(ctx context.Context, tableName string) (int, bool, error)
 	ListOperators(ctx context.Context, columnType string) ([]model.Operator, error)
 	ApplyRecordChanges(ctx context.Context, tableName string, changes model.TableChanges) (int, error)
+	ApplyDatabaseChanges(ctx context.Context, changeSets []model.TableChangeSet) (int, error)
+	PreviewDatabaseChanges(ctx context.Context, changeSets []model.TableChangeSet) ([]model.WriteStatement, error)
+	ScriptDatabaseChanges(ctx context.Context, changeSets []model.TableChangeSet) ([]string, error)
+	RunSQL(ctx context.Context, statement string, limit int) (model.SQLResult, error)
+	QuerySQLPage(ctx context.Context, statement string, offset, limit int) (model.SQLResult, error)
 }
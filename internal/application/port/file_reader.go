@@ -0,0 +1,11 @@
+package port
+
+import (
+	"context"
+	"io"
+)
+
+// FileReader opens files the user asks the application to read.
+type FileReader interface {
+	OpenFile(ctx context.Context, path string) (io.ReadCloser, error)
+}
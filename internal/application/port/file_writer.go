@@ -0,0 +1,20 @@
+package port
+
+import (
+	"context"
+	"io"
+)
+
+// FileWriter writes files the user asks the application to produce.
+type FileWriter interface {
+	WriteFile(ctx context.Context, path string, content []byte) error
+	CreateFile(ctx context.Context, path string) (FileSink, error)
+}
+
+// FileSink is a file being written. Commit replaces the target path with the
+// written content; Abort discards it and leaves the target untouched.
+type FileSink interface {
+	io.Writer
+	Commit() error
+	Abort() error
+}
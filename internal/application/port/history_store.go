@@ -0,0 +1,18 @@
+package port
+
+import "context"
+
+// HistoryKind names one of the histories kept for a database.
+type HistoryKind string
+
+const (
+	HistoryKindCommands HistoryKind = "commands"
+	HistoryKindSQL      HistoryKind = "sql"
+)
+
+// HistoryStore keeps entered runtime commands and SQL statements per
+// database, oldest entry first.
+type HistoryStore interface {
+	Load(ctx context.Context, database string, kind HistoryKind) ([]string, error)
+	Save(ctx context.Context, database string, kind HistoryKind, entries []string) error
+}
@@ -0,0 +1,38 @@
+package port
+
+import (
+	"io"
+
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+// BlobEncoding names how exported BLOB values are written.
+type BlobEncoding string
+
+const (
+	BlobEncodingBase64 BlobEncoding = "base64"
+	BlobEncodingHex    BlobEncoding = "hex"
+	BlobEncodingOmit   BlobEncoding = "omit"
+)
+
+// RecordFormatOptions controls how values are written by a RecordWriter.
+// NullText is used by text formats that have no NULL of their own.
+type RecordFormatOptions struct {
+	Blob     BlobEncoding
+	NullText string
+}
+
+// RecordWriter formats a streamed table. WriteHeader is called once before
+// the first record and Finish once after the last one. Record values carry
+// the stored SQLite value in Raw: nil, int64, float64, string, or []byte.
+type RecordWriter interface {
+	WriteHeader(table string, columns []model.Column) error
+	WriteRecord(values []model.Value) error
+	Finish() error
+}
+
+// RecordWriterFactory creates a RecordWriter for a named export format.
+type RecordWriterFactory interface {
+	Formats() []string
+	NewRecordWriter(format string, out io.Writer, options RecordFormatOptions) (RecordWriter, error)
+}
@@ -0,0 +1,30 @@
+package port
+
+import (
+	"io"
+
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+// ImportRecord is one data line of an import file. Values is keyed by header;
+// a header the line has no value for is absent. Err is set instead of Values
+// when the line could not be parsed.
+type ImportRecord struct {
+	Line   int
+	Values map[string]model.Value
+	Err    error
+}
+
+// RecordReader parses an import file one record at a time. Next returns
+// io.EOF after the last record. Headers lists the header names in file order
+// and is complete once Next returned io.EOF.
+type RecordReader interface {
+	Next() (ImportRecord, error)
+	Headers() []string
+}
+
+// RecordReaderFactory creates a RecordReader for a named import format.
+type RecordReaderFactory interface {
+	Formats() []string
+	NewRecordReader(format string, in io.Reader) (RecordReader, error)
+}
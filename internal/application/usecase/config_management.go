@@ -33,8 +33,11 @@ func (uc *ListConfiguredDatabases) Execute(ctx context.Context) ([]dto.ConfigDat
 	result := make([]dto.ConfigDatabase, len(entries))
 	for i, entry := range entries {
 		result[i] = dto.ConfigDatabase{
-			Name: entry.Name,
-			Path: entry.DBPath,
+			Name:        entry.Name,
+			Path:        entry.DBPath,
+			ReadOnly:    entry.ReadOnly,
+			ConfirmSave: entry.ConfirmSave,
+			Pragmas:     dto.ConnectionPragmas(entry.Pragmas),
 		}
 	}
 	return result, nil
@@ -125,5 +128,11 @@ func toConfigEntry(database dto.ConfigDatabase) (port.ConfigEntry, error) {
 	if path == "" {
 		return port.ConfigEntry{}, ErrConfigDatabasePathRequired
 	}
-	return port.ConfigEntry{Name: name, DBPath: path}, nil
+	return port.ConfigEntry{
+		Name:        name,
+		DBPath:      path,
+		ReadOnly:    database.ReadOnly,
+		ConfirmSave: database.ConfirmSave,
+		Pragmas:     port.ConnectionPragmas(database.Pragmas),
+	}, nil
 }
@@ -26,6 +26,10 @@ type fakeConfigStore struct {
 	lastDeletedIndex int
 	createCalls      int
 	updateCalls      int
+
+	views         map[int][]port.ConfigView
+	lastViewIndex int
+	saveViewCalls int
 }
 
 func (f *fakeConfigStore) List(context.Context) ([]port.ConfigEntry, error) {
@@ -78,6 +82,20 @@ func (f *fakeConfigStore) ActivePath(context.Context) (string, error) {
 	return f.activePath, nil
 }
 
+func (f *fakeConfigStore) ListViews(_ context.Context, index int) ([]port.ConfigView, error) {
+	return append([]port.ConfigView(nil), f.views[index]...), nil
+}
+
+func (f *fakeConfigStore) SaveView(_ context.Context, index int, view port.ConfigView) error {
+	f.saveViewCalls++
+	f.lastViewIndex = index
+	if f.views == nil {
+		f.views = map[int][]port.ConfigView{}
+	}
+	f.views[index] = append(f.views[index], view)
+	return nil
+}
+
 type fakeDatabaseConnectionChecker struct {
 	err       error
 	callCount int
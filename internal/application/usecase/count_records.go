@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/port"
+)
+
+type CountRecords struct {
+	engine port.Engine
+}
+
+func NewCountRecords(engine port.Engine) *CountRecords {
+	return &CountRecords{engine: engine}
+}
+
+func (uc *CountRecords) Execute(ctx context.Context, tableName string, filter *dto.FilterGroup) (int, error) {
+	return uc.engine.CountRecords(ctx, tableName, mapFilterToDomain(filter))
+}
+
+// Estimate returns a cheap row-count estimate for tableName. Statistics cover
+// whole tables only, so no estimate is offered while a filter is active.
+func (uc *CountRecords) Estimate(ctx context.Context, tableName string, filter *dto.FilterGroup) (int, bool, error) {
+	if filterGroupHasConditions(filter) {
+		return 0, false, nil
+	}
+	return uc.engine.EstimateRecordCount(ctx, tableName)
+}
+
+func filterGroupHasConditions(group *dto.FilterGroup) bool {
+	if group == nil {
+		return false
+	}
+	if len(group.Conditions) > 0 {
+		return true
+	}
+	for i := range group.Groups {
+		if filterGroupHasConditions(&group.Groups[i]) {
+			return true
+		}
+	}
+	return false
+}
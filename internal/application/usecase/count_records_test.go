@@ -0,0 +1,80 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/usecase"
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+func TestCountRecords_MapsFilterAndReturnsCount(t *testing.T) {
+	t.Parallel()
+
+	engine := &engineStub{count: 42}
+	uc := usecase.NewCountRecords(engine)
+	filter := &dto.FilterGroup{
+		Logic: dto.FilterLogicAnd,
+		Conditions: []dto.Filter{{
+			Column:   "name",
+			Operator: dto.Operator{Kind: dto.OperatorKindEq, RequiresValue: true},
+			Value:    "alice",
+		}},
+	}
+
+	count, err := uc.Execute(context.Background(), "users", filter)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if count != 42 {
+		t.Fatalf("expected count 42, got %d", count)
+	}
+	if engine.lastRecordsTable != "users" {
+		t.Fatalf("expected table users, got %q", engine.lastRecordsTable)
+	}
+	if engine.lastRecordsFilter == nil || len(engine.lastRecordsFilter.Conditions) != 1 || engine.lastRecordsFilter.Conditions[0].Operator.Kind != model.OperatorKindEq {
+		t.Fatalf("expected mapped filter, got %+v", engine.lastRecordsFilter)
+	}
+}
+
+func TestCountRecords_PropagatesEngineError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("count failed")
+	uc := usecase.NewCountRecords(&engineStub{countRecordsErr: expectedErr})
+
+	_, err := uc.Execute(context.Background(), "users", nil)
+
+	if !errors.Is(err, expectedErr) {
+		t.Fatalf("expected error %v, got %v", expectedErr, err)
+	}
+}
+
+func TestCountRecords_EstimateOnlyWithoutFilterConditions(t *testing.T) {
+	t.Parallel()
+
+	uc := usecase.NewCountRecords(&engineStub{estimate: 1000, hasStats: true})
+	filter := &dto.FilterGroup{
+		Logic: dto.FilterLogicAnd,
+		Groups: []dto.FilterGroup{{
+			Logic:      dto.FilterLogicOr,
+			Conditions: []dto.Filter{{Column: "id", Operator: dto.Operator{Kind: dto.OperatorKindIsNull}}},
+		}},
+	}
+
+	estimate, ok, err := uc.Estimate(context.Background(), "users", &dto.FilterGroup{Logic: dto.FilterLogicAnd})
+	_, filteredOK, filteredErr := uc.Estimate(context.Background(), "users", filter)
+
+	if err != nil || filteredErr != nil {
+		t.Fatalf("expected no errors, got %v and %v", err, filteredErr)
+	}
+	if !ok || estimate != 1000 {
+		t.Fatalf("expected estimate 1000, got %d (ok=%t)", estimate, ok)
+	}
+	if filteredOK {
+		t.Fatal("expected no estimate while a filter condition is active")
+	}
+}
@@ -35,6 +35,9 @@ func (uc *LoadDatabaseSelectorState) Execute(ctx context.Context, input dto.Data
 			Name:        entry.Name,
 			ConnString:  entry.DBPath,
 			Source:      dto.DatabaseSelectorOptionSourceConfig,
+			ReadOnly:    entry.ReadOnly,
+			ConfirmSave: entry.ConfirmSave,
+			Pragmas:     dto.ConnectionPragmas(entry.Pragmas),
 			ConfigIndex: i,
 			CanEdit:     true,
 			CanDelete:   true,
@@ -25,23 +25,6 @@ func NewDirtyNavigationPolicy() *DirtyNavigationPolicy {
 	return &DirtyNavigationPolicy{}
 }
 
-func (p *DirtyNavigationPolicy) BuildTableSwitchPrompt(changeCount int) DirtyDecisionPrompt {
-	if changeCount < 0 {
-		changeCount = 0
-	}
-	return DirtyDecisionPrompt{
-		Title: "Switch Table",
-		Message: fmt.Sprintf(
-			"Switching tables will cause loss of unsaved data (%d rows). Are you sure you want to discard unsaved data?",
-			changeCount,
-		),
-		Options: []DirtyDecisionOption{
-			{ID: DirtyDecisionDiscard, Label: "Discard changes and switch table"},
-			{ID: DirtyDecisionCancel, Label: "Continue editing"},
-		},
-	}
-}
-
 func (p *DirtyNavigationPolicy) BuildConfigPrompt() DirtyDecisionPrompt {
 	return DirtyDecisionPrompt{
 		Title:   "Config",
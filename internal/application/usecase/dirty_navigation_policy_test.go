@@ -17,18 +17,6 @@ func TestDirtyNavigationPolicy_BuildPrompt_ReturnsExpectedCopyAndOptions(t *test
 		build    func() usecase.DirtyDecisionPrompt
 		expected usecase.DirtyDecisionPrompt
 	}{
-		{
-			name:  "table switch",
-			build: func() usecase.DirtyDecisionPrompt { return policy.BuildTableSwitchPrompt(3) },
-			expected: usecase.DirtyDecisionPrompt{
-				Title:   "Switch Table",
-				Message: "Switching tables will cause loss of unsaved data (3 rows). Are you sure you want to discard unsaved data?",
-				Options: []usecase.DirtyDecisionOption{
-					{ID: usecase.DirtyDecisionDiscard, Label: "Discard changes and switch table"},
-					{ID: usecase.DirtyDecisionCancel, Label: "Continue editing"},
-				},
-			},
-		},
 		{
 			name:  "config",
 			build: func() usecase.DirtyDecisionPrompt { return policy.BuildConfigPrompt() },
@@ -104,11 +92,6 @@ func TestDirtyNavigationPolicy_BuildPrompt_ClampsNegativeCountToZero(t *testing.
 		build           func() usecase.DirtyDecisionPrompt
 		expectedMessage string
 	}{
-		{
-			name:            "table switch",
-			build:           func() usecase.DirtyDecisionPrompt { return policy.BuildTableSwitchPrompt(-5) },
-			expectedMessage: "Switching tables will cause loss of unsaved data (0 rows). Are you sure you want to discard unsaved data?",
-		},
 		{
 			name:            "database reload",
 			build:           func() usecase.DirtyDecisionPrompt { return policy.BuildDatabaseReloadPrompt(-5) },
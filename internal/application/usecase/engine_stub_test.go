@@ -3,6 +3,7 @@ package usecase_test
 import (
 	"context"
 
+	"github.com/mgierok/dbc/internal/application/port"
 	"github.com/mgierok/dbc/internal/domain/model"
 )
 
@@ -10,23 +11,51 @@ type engineStub struct {
 	tables    []model.Table
 	schema    model.Schema
 	records   model.RecordPage
+	count     int
+	estimate  int
+	hasStats  bool
 	operators []model.Operator
 
 	listTablesErr    error
 	getSchemaErr     error
 	listRecordsErr   error
+	countRecordsErr  error
 	listOperatorsErr error
 	applyChangesErr  error
 
 	lastRecordsTable  string
 	lastRecordsOffset int
 	lastRecordsLimit  int
-	lastRecordsFilter *model.Filter
-	lastRecordsSort   *model.Sort
+	lastRecordsFilter *model.FilterGroup
+	lastRecordsSort   []model.Sort
+	lastRecordsCursor string
+	appliedChangeSets []model.TableChangeSet
 
 	appliedTableName string
 	appliedChanges   model.TableChanges
 	appliedCount     int
+
+	previewStatements []model.WriteStatement
+	previewChangeSets []model.TableChangeSet
+	previewChangesErr error
+	scriptStatements  []string
+	scriptChangeSets  []model.TableChangeSet
+	scriptChangesErr  error
+
+	streamColumns []model.Column
+	streamRecords [][]model.Value
+	streamErr     error
+
+	readRecordValues   []model.Value
+	readRecordErr      error
+	readRecordIdentity []model.RecordIdentity
+
+	sqlResult      model.SQLResult
+	sqlErr         error
+	lastStatement  string
+	lastSQLOffset  int
+	lastSQLLimit   int
+	sqlPageQueried bool
 }
 
 func (s *engineStub) ListTables(context.Context) ([]model.Table, error) {
@@ -45,7 +74,7 @@ func (s *engineStub) GetSchema(_ context.Context, tableName string) (model.Schem
 	return schema, nil
 }
 
-func (s *engineStub) ListRecords(_ context.Context, tableName string, offset, limit int, filter *model.Filter, sort *model.Sort) (model.RecordPage, error) {
+func (s *engineStub) ListRecords(_ context.Context, tableName string, offset, limit int, filter *model.FilterGroup, sorts []model.Sort) (model.RecordPage, error) {
 	if s.listRecordsErr != nil {
 		return model.RecordPage{}, s.listRecordsErr
 	}
@@ -54,11 +83,63 @@ func (s *engineStub) ListRecords(_ context.Context, tableName string, offset, li
 	s.lastRecordsOffset = offset
 	s.lastRecordsLimit = limit
 	s.lastRecordsFilter = filter
-	s.lastRecordsSort = sort
+	s.lastRecordsSort = sorts
+
+	return s.records, nil
+}
+
+func (s *engineStub) ListRecordsFromCursor(_ context.Context, tableName, cursor string, limit int, filter *model.FilterGroup, sorts []model.Sort) (model.RecordPage, error) {
+	if s.listRecordsErr != nil {
+		return model.RecordPage{}, s.listRecordsErr
+	}
+
+	s.lastRecordsTable = tableName
+	s.lastRecordsCursor = cursor
+	s.lastRecordsLimit = limit
+	s.lastRecordsFilter = filter
+	s.lastRecordsSort = sorts
 
 	return s.records, nil
 }
 
+func (s *engineStub) StreamRecords(_ context.Context, tableName string, filter *model.FilterGroup, sorts []model.Sort, writer port.RecordWriter) error {
+	s.lastRecordsTable = tableName
+	s.lastRecordsFilter = filter
+	s.lastRecordsSort = sorts
+	if err := writer.WriteHeader(tableName, s.streamColumns); err != nil {
+		return err
+	}
+	for _, values := range s.streamRecords {
+		if err := writer.WriteRecord(values); err != nil {
+			return err
+		}
+	}
+	return s.streamErr
+}
+
+func (s *engineStub) ReadRecord(_ context.Context, tableName string, identity model.RecordIdentity) ([]model.Value, error) {
+	s.lastRecordsTable = tableName
+	s.readRecordIdentity = append(s.readRecordIdentity, identity)
+	if s.readRecordErr != nil {
+		return nil, s.readRecordErr
+	}
+	return append([]model.Value(nil), s.readRecordValues...), nil
+}
+
+func (s *engineStub) CountRecords(_ context.Context, tableName string, filter *model.FilterGroup) (int, error) {
+	if s.countRecordsErr != nil {
+		return 0, s.countRecordsErr
+	}
+	s.lastRecordsTable = tableName
+	s.lastRecordsFilter = filter
+	return s.count, nil
+}
+
+func (s *engineStub) EstimateRecordCount(_ context.Context, tableName string) (int, bool, error) {
+	s.lastRecordsTable = tableName
+	return s.estimate, s.hasStats, nil
+}
+
 func (s *engineStub) ListOperators(context.Context, string) ([]model.Operator, error) {
 	if s.listOperatorsErr != nil {
 		return nil, s.listOperatorsErr
@@ -75,3 +156,52 @@ func (s *engineStub) ApplyRecordChanges(_ context.Context, tableName string, cha
 	}
 	return s.appliedCount, nil
 }
+
+func (s *engineStub) ApplyDatabaseChanges(_ context.Context, changeSets []model.TableChangeSet) (int, error) {
+	s.appliedChangeSets = changeSets
+
+	if s.applyChangesErr != nil {
+		return 0, s.applyChangesErr
+	}
+	return s.appliedCount, nil
+}
+
+func (s *engineStub) PreviewDatabaseChanges(_ context.Context, changeSets []model.TableChangeSet) ([]model.WriteStatement, error) {
+	s.previewChangeSets = changeSets
+
+	if s.previewChangesErr != nil {
+		return nil, s.previewChangesErr
+	}
+	return s.previewStatements, nil
+}
+
+func (s *engineStub) ScriptDatabaseChanges(_ context.Context, changeSets []model.TableChangeSet) ([]string, error) {
+	s.scriptChangeSets = changeSets
+
+	if s.scriptChangesErr != nil {
+		return nil, s.scriptChangesErr
+	}
+	return s.scriptStatements, nil
+}
+
+func (s *engineStub) RunSQL(_ context.Context, statement string, limit int) (model.SQLResult, error) {
+	s.lastStatement = statement
+	s.lastSQLLimit = limit
+
+	if s.sqlErr != nil {
+		return model.SQLResult{}, s.sqlErr
+	}
+	return s.sqlResult, nil
+}
+
+func (s *engineStub) QuerySQLPage(_ context.Context, statement string, offset, limit int) (model.SQLResult, error) {
+	s.lastStatement = statement
+	s.lastSQLOffset = offset
+	s.lastSQLLimit = limit
+	s.sqlPageQueried = true
+
+	if s.sqlErr != nil {
+		return model.SQLResult{}, s.sqlErr
+	}
+	return s.sqlResult, nil
+}
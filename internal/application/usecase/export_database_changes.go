@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/port"
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+var ErrExportPathRequired = errors.New("export path is required")
+
+// ExportDatabaseChanges writes staged change sets to a file as a SQL script
+// that replays them in one transaction.
+type ExportDatabaseChanges struct {
+	engine port.Engine
+	files  port.FileWriter
+}
+
+func NewExportDatabaseChanges(engine port.Engine, files port.FileWriter) *ExportDatabaseChanges {
+	return &ExportDatabaseChanges{engine: engine, files: files}
+}
+
+// Execute writes the script to path and returns the number of statements it
+// contains.
+func (uc *ExportDatabaseChanges) Execute(ctx context.Context, path string, changeSets []model.TableChangeSet) (int, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return 0, ErrExportPathRequired
+	}
+	if err := validateTableChangeSets(changeSets); err != nil {
+		return 0, err
+	}
+	statements, err := uc.engine.ScriptDatabaseChanges(ctx, changeSets)
+	if err != nil {
+		return 0, err
+	}
+	if err := uc.files.WriteFile(ctx, path, renderChangeScript(statements)); err != nil {
+		return 0, err
+	}
+	return len(statements), nil
+}
+
+func (uc *ExportDatabaseChanges) ExecuteDTO(ctx context.Context, path string, changeSets []dto.TableChangeSet) (int, error) {
+	return uc.Execute(ctx, path, toDomainTableChangeSets(changeSets))
+}
+
+func renderChangeScript(statements []string) []byte {
+	var script strings.Builder
+	script.WriteString("-- Staged changes exported by dbc.\n")
+	script.WriteString("BEGIN TRANSACTION;\n")
+	for _, statement := range statements {
+		script.WriteString(statement)
+		script.WriteString(";\n")
+	}
+	script.WriteString("COMMIT;\n")
+	return []byte(script.String())
+}
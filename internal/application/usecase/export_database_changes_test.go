@@ -0,0 +1,133 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/port"
+	"github.com/mgierok/dbc/internal/application/usecase"
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+type fileWriterStub struct {
+	path    string
+	content string
+	err     error
+	aborted bool
+}
+
+func (s *fileWriterStub) WriteFile(_ context.Context, path string, content []byte) error {
+	s.path = path
+	s.content = string(content)
+	return s.err
+}
+
+func (s *fileWriterStub) CreateFile(_ context.Context, path string) (port.FileSink, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.path = path
+	return &fileSinkStub{files: s}, nil
+}
+
+type fileSinkStub struct {
+	files   *fileWriterStub
+	written strings.Builder
+}
+
+func (s *fileSinkStub) Write(p []byte) (int, error) {
+	return s.written.Write(p)
+}
+
+func (s *fileSinkStub) Commit() error {
+	s.files.content = s.written.String()
+	return nil
+}
+
+func (s *fileSinkStub) Abort() error {
+	s.files.aborted = true
+	return nil
+}
+
+func TestExportDatabaseChanges_ExecuteDTO_WritesTransactionalScript(t *testing.T) {
+	t.Parallel()
+
+	engine := &engineStub{scriptStatements: []string{
+		`INSERT INTO "users" ("name") VALUES ('bob')`,
+		`DELETE FROM "users" WHERE "id" = 1`,
+	}}
+	files := &fileWriterStub{}
+	uc := usecase.NewExportDatabaseChanges(engine, files)
+	identity := dto.RecordIdentity{
+		Keys: []dto.RecordIdentityKey{{Column: "id", Value: dto.StagedValue{Text: "1", Raw: int64(1)}}},
+	}
+
+	count, err := uc.ExecuteDTO(context.Background(), " /tmp/fix.sql ", []dto.TableChangeSet{
+		{Table: "users", Changes: dto.TableChanges{Deletes: []dto.RecordDelete{{Identity: identity}}}},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if count != 2 {
+		t.Fatalf("expected 2 statements, got %d", count)
+	}
+	if files.path != "/tmp/fix.sql" {
+		t.Fatalf("expected trimmed export path, got %q", files.path)
+	}
+	expected := "-- Staged changes exported by dbc.\n" +
+		"BEGIN TRANSACTION;\n" +
+		"INSERT INTO \"users\" (\"name\") VALUES ('bob');\n" +
+		"DELETE FROM \"users\" WHERE \"id\" = 1;\n" +
+		"COMMIT;\n"
+	if files.content != expected {
+		t.Fatalf("expected script %q, got %q", expected, files.content)
+	}
+	if len(engine.scriptChangeSets) != 1 || engine.scriptChangeSets[0].Table != "users" {
+		t.Fatalf("expected users change set to be scripted, got %+v", engine.scriptChangeSets)
+	}
+	if engine.appliedChangeSets != nil {
+		t.Fatal("expected export not to apply changes")
+	}
+}
+
+func TestExportDatabaseChanges_RequiresPath(t *testing.T) {
+	t.Parallel()
+
+	engine := &engineStub{}
+	files := &fileWriterStub{}
+	uc := usecase.NewExportDatabaseChanges(engine, files)
+
+	_, err := uc.Execute(context.Background(), "  ", []model.TableChangeSet{{Table: "users"}})
+
+	if !errors.Is(err, usecase.ErrExportPathRequired) {
+		t.Fatalf("expected export path required error, got %v", err)
+	}
+	if engine.scriptChangeSets != nil {
+		t.Fatal("expected engine not to be called")
+	}
+}
+
+func TestExportDatabaseChanges_ReturnsWriteError(t *testing.T) {
+	t.Parallel()
+
+	writeErr := errors.New("disk full")
+	engine := &engineStub{scriptStatements: []string{`DELETE FROM "users" WHERE "id" = 1`}}
+	files := &fileWriterStub{err: writeErr}
+	uc := usecase.NewExportDatabaseChanges(engine, files)
+	changeSets := []model.TableChangeSet{{
+		Table: "users",
+		Changes: model.TableChanges{Deletes: []model.RecordDelete{{Identity: model.RecordIdentity{
+			Keys: []model.RecordIdentityKey{{Column: "id", Value: model.Value{Text: "1", Raw: int64(1)}}},
+		}}}},
+	}}
+
+	_, err := uc.Execute(context.Background(), "/tmp/fix.sql", changeSets)
+
+	if !errors.Is(err, writeErr) {
+		t.Fatalf("expected write error, got %v", err)
+	}
+}
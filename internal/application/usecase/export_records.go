@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/port"
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+const DefaultExportBlobEncoding = string(port.BlobEncodingBase64)
+
+var (
+	ErrExportTableRequired       = errors.New("export table is required")
+	ErrUnknownExportFormat       = errors.New("unknown export format")
+	ErrUnknownExportBlobEncoding = errors.New("unknown BLOB export encoding")
+)
+
+// ExportRecords streams the records of one table to a file in a format
+// provided by a port.RecordWriterFactory. The file is replaced only after
+// every record was written.
+type ExportRecords struct {
+	engine  port.Engine
+	files   port.FileWriter
+	formats port.RecordWriterFactory
+}
+
+func NewExportRecords(engine port.Engine, files port.FileWriter, formats port.RecordWriterFactory) *ExportRecords {
+	return &ExportRecords{engine: engine, files: files, formats: formats}
+}
+
+// Formats lists the export format names accepted by Execute.
+func (uc *ExportRecords) Formats() []string {
+	return uc.formats.Formats()
+}
+
+// Execute writes the export to its path and returns the number of records
+// written.
+func (uc *ExportRecords) Execute(ctx context.Context, export dto.RecordExport) (count int, err error) {
+	if strings.TrimSpace(export.Table) == "" {
+		return 0, ErrExportTableRequired
+	}
+	path := strings.TrimSpace(export.Path)
+	if path == "" {
+		return 0, ErrExportPathRequired
+	}
+	format := strings.ToLower(strings.TrimSpace(export.Format))
+	if !slices.Contains(uc.formats.Formats(), format) {
+		return 0, fmt.Errorf("%w: %s (expected %s)", ErrUnknownExportFormat, export.Format, strings.Join(uc.formats.Formats(), ", "))
+	}
+	blob := export.Blob
+	if blob == "" {
+		blob = DefaultExportBlobEncoding
+	}
+	if err := ValidateExportBlobEncoding(blob); err != nil {
+		return 0, err
+	}
+
+	sink, err := uc.files.CreateFile(ctx, path)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			_ = sink.Abort()
+		}
+	}()
+	writer, err := uc.formats.NewRecordWriter(format, sink, port.RecordFormatOptions{
+		Blob:     port.BlobEncoding(blob),
+		NullText: export.NullText,
+	})
+	if err != nil {
+		return 0, err
+	}
+	counter := &countingRecordWriter{RecordWriter: writer}
+	if err := uc.engine.StreamRecords(ctx, export.Table, mapFilterToDomain(export.Filter), mapSortsToDomain(export.Sorts), counter); err != nil {
+		return 0, err
+	}
+	if err := writer.Finish(); err != nil {
+		return 0, err
+	}
+	if err := sink.Commit(); err != nil {
+		return 0, err
+	}
+	return counter.count, nil
+}
+
+// ValidateExportBlobEncoding reports whether encoding names a supported way
+// of writing BLOB values.
+func ValidateExportBlobEncoding(encoding string) error {
+	switch port.BlobEncoding(encoding) {
+	case port.BlobEncodingBase64, port.BlobEncodingHex, port.BlobEncodingOmit:
+		return nil
+	default:
+		return fmt.Errorf("%w: %s (expected base64, hex, or omit)", ErrUnknownExportBlobEncoding, encoding)
+	}
+}
+
+type countingRecordWriter struct {
+	port.RecordWriter
+	count int
+}
+
+func (w *countingRecordWriter) WriteRecord(values []model.Value) error {
+	if err := w.RecordWriter.WriteRecord(values); err != nil {
+		return err
+	}
+	w.count++
+	return nil
+}
@@ -0,0 +1,163 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/port"
+	"github.com/mgierok/dbc/internal/application/usecase"
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+type recordFormatsStub struct {
+	options port.RecordFormatOptions
+}
+
+func (s *recordFormatsStub) Formats() []string {
+	return []string{"csv", "lines"}
+}
+
+func (s *recordFormatsStub) NewRecordWriter(_ string, out io.Writer, options port.RecordFormatOptions) (port.RecordWriter, error) {
+	s.options = options
+	return &lineRecordWriter{out: out}, nil
+}
+
+type lineRecordWriter struct {
+	out io.Writer
+}
+
+func (w *lineRecordWriter) WriteHeader(table string, columns []model.Column) error {
+	_, err := fmt.Fprintf(w.out, "%s:%d\n", table, len(columns))
+	return err
+}
+
+func (w *lineRecordWriter) WriteRecord(values []model.Value) error {
+	texts := make([]string, len(values))
+	for i, value := range values {
+		texts[i] = value.Text
+	}
+	_, err := fmt.Fprintln(w.out, strings.Join(texts, ","))
+	return err
+}
+
+func (w *lineRecordWriter) Finish() error {
+	_, err := fmt.Fprintln(w.out, "end")
+	return err
+}
+
+func TestExportRecords_ExecuteStreamsFilteredRecordsToFile(t *testing.T) {
+	t.Parallel()
+
+	engine := &engineStub{
+		streamColumns: []model.Column{{Name: "id"}, {Name: "name"}},
+		streamRecords: [][]model.Value{
+			{{Text: "1"}, {Text: "alice"}},
+			{{Text: "2"}, {Text: "bob"}},
+		},
+	}
+	files := &fileWriterStub{}
+	formats := &recordFormatsStub{}
+	uc := usecase.NewExportRecords(engine, files, formats)
+	filter := &dto.FilterGroup{Logic: dto.FilterLogicAnd, Conditions: []dto.Filter{{
+		Column:   "name",
+		Operator: dto.Operator{Kind: dto.OperatorKindLike, RequiresValue: true},
+		Value:    "%a%",
+	}}}
+
+	count, err := uc.Execute(context.Background(), dto.RecordExport{
+		Table:    "users",
+		Format:   " LINES ",
+		Path:     " /tmp/users.txt ",
+		Filter:   filter,
+		Sorts:    []dto.Sort{{Column: "name", Direction: dto.SortDirectionDesc}},
+		NullText: "NULL",
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if count != 2 {
+		t.Fatalf("expected 2 exported records, got %d", count)
+	}
+	if files.path != "/tmp/users.txt" || files.content != "users:2\n1,alice\n2,bob\nend\n" {
+		t.Fatalf("unexpected file %q with %q", files.path, files.content)
+	}
+	if formats.options.Blob != port.BlobEncodingBase64 || formats.options.NullText != "NULL" {
+		t.Fatalf("expected default BLOB encoding and NULL text, got %+v", formats.options)
+	}
+	if engine.lastRecordsFilter == nil || engine.lastRecordsFilter.Conditions[0].Value != "%a%" {
+		t.Fatalf("expected filter to reach engine, got %+v", engine.lastRecordsFilter)
+	}
+	if len(engine.lastRecordsSort) != 1 || engine.lastRecordsSort[0].Direction != model.SortDirectionDesc {
+		t.Fatalf("expected sort to reach engine, got %+v", engine.lastRecordsSort)
+	}
+}
+
+func TestExportRecords_ExecuteAbortsFileWhenStreamingFails(t *testing.T) {
+	t.Parallel()
+
+	engine := &engineStub{
+		streamRecords: [][]model.Value{{{Text: "1"}}},
+		streamErr:     errors.New("database is locked"),
+	}
+	files := &fileWriterStub{}
+	uc := usecase.NewExportRecords(engine, files, &recordFormatsStub{})
+
+	_, err := uc.Execute(context.Background(), dto.RecordExport{Table: "users", Format: "csv", Path: "/tmp/users.csv"})
+
+	if err == nil || err.Error() != "database is locked" {
+		t.Fatalf("expected stream error, got %v", err)
+	}
+	if !files.aborted || files.content != "" {
+		t.Fatalf("expected aborted file without content, got aborted=%t content=%q", files.aborted, files.content)
+	}
+}
+
+func TestExportRecords_ExecuteRejectsInvalidRequests(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		export   dto.RecordExport
+		expected error
+	}{
+		{
+			name:     "missing path",
+			export:   dto.RecordExport{Table: "users", Format: "csv", Path: " "},
+			expected: usecase.ErrExportPathRequired,
+		},
+		{
+			name:     "unknown format",
+			export:   dto.RecordExport{Table: "users", Format: "xml", Path: "/tmp/users.xml"},
+			expected: usecase.ErrUnknownExportFormat,
+		},
+		{
+			name:     "unknown BLOB encoding",
+			export:   dto.RecordExport{Table: "users", Format: "csv", Path: "/tmp/users.csv", Blob: "raw"},
+			expected: usecase.ErrUnknownExportBlobEncoding,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			files := &fileWriterStub{}
+			uc := usecase.NewExportRecords(&engineStub{}, files, &recordFormatsStub{})
+
+			_, err := uc.Execute(context.Background(), tc.export)
+
+			if !errors.Is(err, tc.expected) {
+				t.Fatalf("expected %v, got %v", tc.expected, err)
+			}
+			if files.path != "" {
+				t.Fatalf("expected no file to be created, got %q", files.path)
+			}
+		})
+	}
+}
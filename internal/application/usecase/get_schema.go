@@ -40,15 +40,18 @@ func (uc *GetSchema) Execute(ctx context.Context, tableName string) (dto.Schema,
 			}
 		}
 		columns[i] = dto.SchemaColumn{
-			Name:           column.Name,
-			Type:           column.Type,
-			Nullable:       column.Nullable,
-			PrimaryKey:     column.PrimaryKey,
-			Unique:         column.Unique,
-			DefaultValue:   column.DefaultValue,
-			AutoIncrement:  column.AutoIncrement,
-			ForeignKeys:    foreignKeys,
-			MetadataBadges: projectedSchemaMetadataBadges(column),
+			Name:                 column.Name,
+			Type:                 column.Type,
+			Nullable:             column.Nullable,
+			PrimaryKey:           column.PrimaryKey,
+			Unique:               column.Unique,
+			DefaultValue:         column.DefaultValue,
+			AutoIncrement:        column.AutoIncrement,
+			ForeignKeys:          foreignKeys,
+			Generated:            column.Generated,
+			GeneratedStored:      column.GeneratedStored,
+			GenerationExpression: column.GenerationExpression,
+			MetadataBadges:       projectedSchemaMetadataBadges(column),
 			Input: dto.ColumnInput{
 				Kind:    inputKind,
 				Options: inputSpec.Options,
@@ -56,8 +59,37 @@ func (uc *GetSchema) Execute(ctx context.Context, tableName string) (dto.Schema,
 		}
 	}
 
+	var indexes []dto.SchemaIndex
+	if len(schema.Indexes) > 0 {
+		indexes = make([]dto.SchemaIndex, len(schema.Indexes))
+		for i, index := range schema.Indexes {
+			indexes[i] = dto.SchemaIndex{
+				Name:    index.Name,
+				Columns: append([]string(nil), index.Columns...),
+				Unique:  index.Unique,
+				Partial: index.Partial,
+				Origin:  dto.SchemaIndexOrigin(index.Origin),
+				SQL:     index.SQL,
+			}
+		}
+	}
+	var triggers []dto.SchemaTrigger
+	if len(schema.Triggers) > 0 {
+		triggers = make([]dto.SchemaTrigger, len(schema.Triggers))
+		for i, trigger := range schema.Triggers {
+			triggers[i] = dto.SchemaTrigger{
+				Name: trigger.Name,
+				SQL:  trigger.SQL,
+			}
+		}
+	}
+
 	return dto.Schema{
-		TableName: schema.Table.Name,
-		Columns:   columns,
+		TableName:   schema.Table.Name,
+		Columns:     columns,
+		Indexes:     indexes,
+		Triggers:    triggers,
+		Constraints: projectedSchemaConstraints(schema),
+		SQL:         schema.SQL,
 	}, nil
 }
@@ -120,6 +120,131 @@ func TestGetSchema_MapsForeignKeyBadgeWithoutReferencedColumn(t *testing.T) {
 	}
 }
 
+func TestGetSchema_MapsGeneratedColumnsWithBadge(t *testing.T) {
+	t.Parallel()
+
+	engine := &engineStub{
+		schema: model.Schema{
+			Columns: []model.Column{
+				{Name: "total", Type: "REAL", Nullable: true, Generated: true, GenerationExpression: "price * qty"},
+				{Name: "label", Type: "TEXT", Nullable: true, Generated: true, GeneratedStored: true},
+			},
+		},
+	}
+	uc := usecase.NewGetSchema(engine)
+
+	result, err := uc.Execute(context.Background(), "items")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	total := result.Columns[0]
+	if !total.Generated || total.GeneratedStored || total.GenerationExpression != "price * qty" {
+		t.Fatalf("expected generated metadata on total, got %+v", total)
+	}
+	if expected := []string{"NULL", "GENERATED VIRTUAL AS (price * qty)"}; !reflect.DeepEqual(total.MetadataBadges, expected) {
+		t.Fatalf("expected badges %v, got %v", expected, total.MetadataBadges)
+	}
+	if expected := []string{"NULL", "GENERATED STORED"}; !reflect.DeepEqual(result.Columns[1].MetadataBadges, expected) {
+		t.Fatalf("expected badges %v, got %v", expected, result.Columns[1].MetadataBadges)
+	}
+}
+
+func TestGetSchema_MapsIndexesTriggersAndDefinition(t *testing.T) {
+	t.Parallel()
+
+	engine := &engineStub{
+		schema: model.Schema{
+			Table: model.Table{Name: "users"},
+			Columns: []model.Column{
+				{Name: "email", Type: "TEXT"},
+			},
+			Indexes: []model.Index{
+				{Name: "users_email_idx", Columns: []string{"email"}, Unique: true, Partial: true, Origin: model.IndexOriginCreate, SQL: "CREATE UNIQUE INDEX users_email_idx ON users(email) WHERE email <> ''"},
+			},
+			Triggers: []model.Trigger{
+				{Name: "users_touch", SQL: "CREATE TRIGGER users_touch AFTER UPDATE ON users BEGIN SELECT 1; END"},
+			},
+			SQL: "CREATE TABLE users (email TEXT)",
+		},
+	}
+	uc := usecase.NewGetSchema(engine)
+
+	result, err := uc.Execute(context.Background(), "users")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	expectedIndexes := []dto.SchemaIndex{
+		{Name: "users_email_idx", Columns: []string{"email"}, Unique: true, Partial: true, Origin: dto.SchemaIndexOriginCreate, SQL: "CREATE UNIQUE INDEX users_email_idx ON users(email) WHERE email <> ''"},
+	}
+	if !reflect.DeepEqual(result.Indexes, expectedIndexes) {
+		t.Fatalf("expected indexes %+v, got %+v", expectedIndexes, result.Indexes)
+	}
+	expectedTriggers := []dto.SchemaTrigger{
+		{Name: "users_touch", SQL: "CREATE TRIGGER users_touch AFTER UPDATE ON users BEGIN SELECT 1; END"},
+	}
+	if !reflect.DeepEqual(result.Triggers, expectedTriggers) {
+		t.Fatalf("expected triggers %+v, got %+v", expectedTriggers, result.Triggers)
+	}
+	if result.SQL != "CREATE TABLE users (email TEXT)" {
+		t.Fatalf("expected table DDL, got %q", result.SQL)
+	}
+}
+
+func TestGetSchema_ProjectsTableLevelConstraints(t *testing.T) {
+	t.Parallel()
+
+	engine := &engineStub{
+		schema: model.Schema{
+			Columns: []model.Column{{Name: "parent_id", Type: "INTEGER"}},
+			ForeignKeys: []model.ForeignKeyConstraint{
+				{
+					Columns:    []string{"parent_id", "parent_code"},
+					RefTable:   "parents",
+					RefColumns: []string{"id", "code"},
+					OnUpdate:   "NO ACTION",
+					OnDelete:   "CASCADE",
+				},
+				{Columns: []string{"owner_id"}, RefTable: "owners", OnUpdate: "SET NULL", OnDelete: "NO ACTION"},
+			},
+			UniqueConstraints: []model.UniqueConstraint{{Columns: []string{"parent_id", "label"}}},
+			CheckConstraints: []model.CheckConstraint{
+				{Expression: "qty > 0"},
+				{Name: "label_len", Expression: "length(label) < 10"},
+			},
+		},
+	}
+	uc := usecase.NewGetSchema(engine)
+
+	result, err := uc.Execute(context.Background(), "children")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	labels := make([]string, len(result.Constraints))
+	for i, constraint := range result.Constraints {
+		labels[i] = constraint.Label
+	}
+	expectedLabels := []string{
+		"FOREIGN KEY (parent_id, parent_code) REFERENCES parents (id, code) ON DELETE CASCADE",
+		"FOREIGN KEY (owner_id) REFERENCES owners ON UPDATE SET NULL",
+		"UNIQUE (parent_id, label)",
+		"CHECK (qty > 0)",
+		"CONSTRAINT label_len CHECK (length(label) < 10)",
+	}
+	if !reflect.DeepEqual(labels, expectedLabels) {
+		t.Fatalf("expected constraint labels %v, got %v", expectedLabels, labels)
+	}
+	first := result.Constraints[0]
+	if first.Kind != dto.SchemaConstraintForeignKey || first.RefTable != "parents" || first.OnDelete != "CASCADE" {
+		t.Fatalf("expected structured foreign key constraint, got %+v", first)
+	}
+	if result.Constraints[4].Kind != dto.SchemaConstraintCheck || result.Constraints[4].Name != "label_len" {
+		t.Fatalf("expected named check constraint, got %+v", result.Constraints[4])
+	}
+}
+
 func TestGetSchema_PropagatesEngineError(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/port"
+)
+
+var (
+	ErrImportPathRequired  = errors.New("import path is required")
+	ErrUnknownImportFormat = errors.New("unknown import format")
+	ErrImportMappingEmpty  = errors.New("no file column is mapped to a table column")
+)
+
+// importFormatAliases maps file extensions to the import format they hold
+// when the two differ.
+var importFormatAliases = map[string]string{
+	"jsonl": "ndjson",
+}
+
+// ImportRecords reads a file of records in a format provided by a
+// port.RecordReaderFactory and turns its lines into pending insert rows. It
+// never writes to the database; the rows are staged like any other insert.
+type ImportRecords struct {
+	files      port.FileReader
+	formats    port.RecordReaderFactory
+	policy     *StagingPolicy
+	translator *StagedChangesTranslator
+}
+
+func NewImportRecords(
+	files port.FileReader,
+	formats port.RecordReaderFactory,
+	policy *StagingPolicy,
+	translator *StagedChangesTranslator,
+) *ImportRecords {
+	if policy == nil {
+		policy = NewStagingPolicy()
+	}
+	if translator == nil {
+		translator = NewStagedChangesTranslator()
+	}
+	return &ImportRecords{files: files, formats: formats, policy: policy, translator: translator}
+}
+
+// Read parses the file at path in the format named by its extension.
+func (uc *ImportRecords) Read(ctx context.Context, path string) (dto.ImportFile, error) {
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return dto.ImportFile{}, ErrImportPathRequired
+	}
+	format := strings.ToLower(strings.TrimPrefix(filepath.Ext(path), "."))
+	if alias, ok := importFormatAliases[format]; ok {
+		format = alias
+	}
+	if !slices.Contains(uc.formats.Formats(), format) {
+		return dto.ImportFile{}, fmt.Errorf("%w: %q (expected a .%s file)", ErrUnknownImportFormat, filepath.Ext(path), strings.Join(uc.formats.Formats(), ", ."))
+	}
+
+	file, err := uc.files.OpenFile(ctx, path)
+	if err != nil {
+		return dto.ImportFile{}, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+	reader, err := uc.formats.NewRecordReader(format, file)
+	if err != nil {
+		return dto.ImportFile{}, err
+	}
+	result := dto.ImportFile{Path: path, Format: format}
+	for {
+		if err := ctx.Err(); err != nil {
+			return dto.ImportFile{}, err
+		}
+		record, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return dto.ImportFile{}, err
+		}
+		row := dto.ImportRow{Line: record.Line}
+		if record.Err != nil {
+			row.Err = record.Err.Error()
+			result.Rows = append(result.Rows, row)
+			continue
+		}
+		row.Values = make(map[string]dto.ImportValue, len(record.Values))
+		for header, value := range record.Values {
+			row.Values[header] = dto.ImportValue{Text: value.Text, IsNull: value.IsNull}
+		}
+		result.Rows = append(result.Rows, row)
+	}
+	result.Headers = append([]string(nil), reader.Headers()...)
+	return result, nil
+}
+
+// MatchColumns proposes a mapping of headers to the columns of schema. The
+// result holds one header per column, or an empty string for a column no
+// header matches. Headers match column names exactly first, then ignoring
+// case. Generated columns are never mapped.
+func (uc *ImportRecords) MatchColumns(schema dto.Schema, headers []string) []string {
+	mapping := make([]string, len(schema.Columns))
+	used := make(map[string]bool, len(headers))
+	for i, column := range schema.Columns {
+		if column.Generated {
+			continue
+		}
+		for _, header := range headers {
+			if header == column.Name && !used[header] {
+				mapping[i] = header
+				used[header] = true
+				break
+			}
+		}
+	}
+	for i, column := range schema.Columns {
+		if column.Generated || mapping[i] != "" {
+			continue
+		}
+		for _, header := range headers {
+			if strings.EqualFold(header, column.Name) && !used[header] {
+				mapping[i] = header
+				used[header] = true
+				break
+			}
+		}
+	}
+	return mapping
+}
+
+// BuildInserts turns the lines of file into pending insert rows. mapping
+// holds the header read for each column of schema, as returned by
+// MatchColumns. Mapped values are parsed for their column type; a text value
+// equal to nullText is read as NULL for nullable columns. Unmapped columns,
+// columns a line has no value for, and empty auto-increment values start
+// from their staging default.
+// Lines that cannot be parsed or staged are reported instead of aborting the
+// import.
+func (uc *ImportRecords) BuildInserts(schema dto.Schema, file dto.ImportFile, mapping []string, nullText string) (dto.ImportResult, error) {
+	if len(mapping) != len(schema.Columns) {
+		return dto.ImportResult{}, fmt.Errorf("import mapping has %d columns, table has %d", len(mapping), len(schema.Columns))
+	}
+	mapped := false
+	for i, header := range mapping {
+		if header != "" && !schema.Columns[i].Generated {
+			mapped = true
+		}
+	}
+	if !mapped {
+		return dto.ImportResult{}, ErrImportMappingEmpty
+	}
+
+	result := dto.ImportResult{}
+	for _, line := range file.Rows {
+		if line.Err != "" {
+			result.Errors = append(result.Errors, dto.ImportLineError{Line: line.Line, Message: line.Err})
+			continue
+		}
+		row, lineErrors := uc.buildInsertRow(schema, line, mapping, nullText)
+		if len(lineErrors) == 0 {
+			if _, err := uc.translator.buildInsertChange(schema.Columns, row); err != nil {
+				lineErrors = append(lineErrors, dto.ImportLineError{Line: line.Line, Message: err.Error()})
+			}
+		}
+		if len(lineErrors) > 0 {
+			result.Errors = append(result.Errors, lineErrors...)
+			continue
+		}
+		result.Inserts = append(result.Inserts, row)
+	}
+	return result, nil
+}
+
+func (uc *ImportRecords) buildInsertRow(schema dto.Schema, line dto.ImportRow, mapping []string, nullText string) (dto.PendingInsertRow, []dto.ImportLineError) {
+	row := dto.PendingInsertRow{
+		Values:       make(map[int]dto.StagedEdit, len(schema.Columns)),
+		ExplicitAuto: make(map[int]bool),
+	}
+	var lineErrors []dto.ImportLineError
+	for index, column := range schema.Columns {
+		row.Values[index] = dto.StagedEdit{Value: uc.policy.InitialInsertValue(column)}
+		header := mapping[index]
+		if header == "" || column.Generated {
+			continue
+		}
+		value, ok := line.Values[header]
+		if !ok {
+			continue
+		}
+		if column.AutoIncrement && !value.IsNull && strings.TrimSpace(value.Text) == "" {
+			continue
+		}
+		isNull := value.IsNull || (column.Nullable && value.Text == nullText)
+		parsed, err := uc.translator.ParseStagedValue(column, value.Text, isNull)
+		if err != nil {
+			lineErrors = append(lineErrors, dto.ImportLineError{Line: line.Line, Column: column.Name, Message: err.Error()})
+			continue
+		}
+		row.Values[index] = dto.StagedEdit{Value: parsed}
+		row.ExplicitAuto[index] = true
+	}
+	return row, lineErrors
+}
@@ -0,0 +1,214 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/port"
+	"github.com/mgierok/dbc/internal/application/usecase"
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+type fileReaderStub struct {
+	path string
+	err  error
+}
+
+func (s *fileReaderStub) OpenFile(_ context.Context, path string) (io.ReadCloser, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.path = path
+	return io.NopCloser(strings.NewReader("")), nil
+}
+
+type recordReadersStub struct {
+	format  string
+	headers []string
+	records []port.ImportRecord
+}
+
+func (s *recordReadersStub) Formats() []string {
+	return []string{"csv", "ndjson"}
+}
+
+func (s *recordReadersStub) NewRecordReader(format string, _ io.Reader) (port.RecordReader, error) {
+	s.format = format
+	return &recordReaderStub{headers: s.headers, records: s.records}, nil
+}
+
+type recordReaderStub struct {
+	headers []string
+	records []port.ImportRecord
+}
+
+func (r *recordReaderStub) Headers() []string {
+	return r.headers
+}
+
+func (r *recordReaderStub) Next() (port.ImportRecord, error) {
+	if len(r.records) == 0 {
+		return port.ImportRecord{}, io.EOF
+	}
+	record := r.records[0]
+	r.records = r.records[1:]
+	return record, nil
+}
+
+func importSchemaForTest() dto.Schema {
+	return dto.Schema{Columns: []dto.SchemaColumn{
+		{Name: "id", Type: "INTEGER", PrimaryKey: true, AutoIncrement: true, Nullable: true},
+		{Name: "name", Type: "TEXT"},
+		{Name: "age", Type: "INTEGER", Nullable: true},
+		{Name: "slug", Type: "TEXT", Generated: true},
+	}}
+}
+
+func TestImportRecords_ReadParsesFileInFormatOfExtension(t *testing.T) {
+	t.Parallel()
+
+	files := &fileReaderStub{}
+	readers := &recordReadersStub{
+		headers: []string{"name", "age"},
+		records: []port.ImportRecord{
+			{Line: 1, Values: map[string]model.Value{"name": {Text: "alice"}, "age": {IsNull: true}}},
+			{Line: 2, Err: errors.New("unexpected end of JSON input")},
+		},
+	}
+	uc := usecase.NewImportRecords(files, readers, nil, nil)
+
+	file, err := uc.Read(context.Background(), " /tmp/users.JSONL ")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if files.path != "/tmp/users.JSONL" || readers.format != "ndjson" {
+		t.Fatalf("expected ndjson reader for trimmed path, got %q and %q", files.path, readers.format)
+	}
+	expected := dto.ImportFile{
+		Path:    "/tmp/users.JSONL",
+		Format:  "ndjson",
+		Headers: []string{"name", "age"},
+		Rows: []dto.ImportRow{
+			{Line: 1, Values: map[string]dto.ImportValue{"name": {Text: "alice"}, "age": {IsNull: true}}},
+			{Line: 2, Err: "unexpected end of JSON input"},
+		},
+	}
+	if !reflect.DeepEqual(file, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, file)
+	}
+}
+
+func TestImportRecords_ReadRejectsUnknownExtension(t *testing.T) {
+	t.Parallel()
+
+	files := &fileReaderStub{}
+	uc := usecase.NewImportRecords(files, &recordReadersStub{}, nil, nil)
+
+	_, err := uc.Read(context.Background(), "/tmp/users.xlsx")
+
+	if !errors.Is(err, usecase.ErrUnknownImportFormat) {
+		t.Fatalf("expected unknown format error, got %v", err)
+	}
+	if files.path != "" {
+		t.Fatalf("expected file not to be opened, got %q", files.path)
+	}
+}
+
+func TestImportRecords_MatchColumnsPrefersExactNamesAndSkipsGeneratedColumns(t *testing.T) {
+	t.Parallel()
+
+	uc := usecase.NewImportRecords(nil, nil, nil, nil)
+
+	mapping := uc.MatchColumns(importSchemaForTest(), []string{"NAME", "Age", "name", "slug"})
+
+	expected := []string{"", "name", "Age", ""}
+	if !reflect.DeepEqual(mapping, expected) {
+		t.Fatalf("expected mapping %v, got %v", expected, mapping)
+	}
+}
+
+func TestImportRecords_BuildInsertsReportsInvalidLinesAndKeepsValidOnes(t *testing.T) {
+	t.Parallel()
+
+	uc := usecase.NewImportRecords(nil, nil, nil, nil)
+	file := dto.ImportFile{Rows: []dto.ImportRow{
+		{Line: 2, Values: map[string]dto.ImportValue{"id": {Text: ""}, "name": {Text: "alice"}, "age": {Text: "30"}}},
+		{Line: 3, Values: map[string]dto.ImportValue{"id": {Text: "7"}, "name": {Text: "bob"}, "age": {Text: "-"}}},
+		{Line: 4, Err: "extraneous quote"},
+		{Line: 5, Values: map[string]dto.ImportValue{"id": {Text: ""}, "name": {Text: ""}, "age": {Text: ""}}},
+		{Line: 6, Values: map[string]dto.ImportValue{"id": {Text: "9"}, "name": {Text: "carol"}}},
+	}}
+
+	result, err := uc.BuildInserts(importSchemaForTest(), file, []string{"id", "name", "age", ""}, "")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	expectedErrors := []dto.ImportLineError{
+		{Line: 3, Column: "age", Message: "invalid integer value: invalid value"},
+		{Line: 4, Message: "extraneous quote"},
+		{Line: 5, Message: `value for column "name" is required`},
+	}
+	if !reflect.DeepEqual(result.Errors, expectedErrors) {
+		t.Fatalf("expected errors %+v, got %+v", expectedErrors, result.Errors)
+	}
+	if len(result.Inserts) != 2 {
+		t.Fatalf("expected two inserts, got %d", len(result.Inserts))
+	}
+	first, second := result.Inserts[0], result.Inserts[1]
+	if first.ExplicitAuto[0] || !first.ExplicitAuto[1] {
+		t.Fatalf("expected empty auto-increment value to stay automatic, got %+v", first.ExplicitAuto)
+	}
+	if first.Values[2].Value.Raw != int64(30) {
+		t.Fatalf("expected parsed integer age, got %+v", first.Values[2].Value)
+	}
+	if !second.ExplicitAuto[0] || second.Values[0].Value.Raw != int64(9) {
+		t.Fatalf("expected explicit id 9, got %+v", second.Values[0])
+	}
+	if !second.Values[2].Value.IsNull || second.ExplicitAuto[2] {
+		t.Fatalf("expected missing age to keep its NULL default, got %+v", second.Values[2])
+	}
+}
+
+func TestImportRecords_BuildInsertsReadsNullTextAsNullForNullableColumns(t *testing.T) {
+	t.Parallel()
+
+	uc := usecase.NewImportRecords(nil, nil, nil, nil)
+	file := dto.ImportFile{Rows: []dto.ImportRow{
+		{Line: 2, Values: map[string]dto.ImportValue{"name": {Text: "NULL"}, "age": {Text: "NULL"}}},
+	}}
+
+	result, err := uc.BuildInserts(importSchemaForTest(), file, []string{"", "name", "age", ""}, "NULL")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(result.Inserts) != 1 {
+		t.Fatalf("expected one insert, got %+v", result)
+	}
+	row := result.Inserts[0]
+	if row.Values[1].Value.IsNull || row.Values[1].Value.Text != "NULL" {
+		t.Fatalf("expected NOT NULL column to keep text, got %+v", row.Values[1].Value)
+	}
+	if !row.Values[2].Value.IsNull {
+		t.Fatalf("expected nullable column to read NULL, got %+v", row.Values[2].Value)
+	}
+}
+
+func TestImportRecords_BuildInsertsRequiresMappedColumn(t *testing.T) {
+	t.Parallel()
+
+	uc := usecase.NewImportRecords(nil, nil, nil, nil)
+
+	_, err := uc.BuildInserts(importSchemaForTest(), dto.ImportFile{}, []string{"", "", "", "slug"}, "")
+
+	if !errors.Is(err, usecase.ErrImportMappingEmpty) {
+		t.Fatalf("expected empty mapping error, got %v", err)
+	}
+}
@@ -26,6 +26,7 @@ func (uc *ListOperators) Execute(ctx context.Context, columnType string) ([]dto.
 			Name:          operator.Name,
 			Kind:          dto.OperatorKind(operator.Kind),
 			RequiresValue: operator.RequiresValue,
+			ValueMode:     dto.OperatorValueMode(operator.ValueMode),
 		}
 	}
 	return result, nil
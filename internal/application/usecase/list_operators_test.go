@@ -17,6 +17,7 @@ func TestListOperators_MapsOperators(t *testing.T) {
 	engine := &engineStub{
 		operators: []model.Operator{
 			{Name: "Equals", Kind: model.OperatorKindEq, RequiresValue: true},
+			{Name: "In", Kind: model.OperatorKindIn, RequiresValue: true, ValueMode: model.OperatorValueList},
 		},
 	}
 	uc := usecase.NewListOperators(engine)
@@ -29,6 +30,7 @@ func TestListOperators_MapsOperators(t *testing.T) {
 
 	expected := []dto.Operator{
 		{Name: "Equals", Kind: dto.OperatorKindEq, RequiresValue: true},
+		{Name: "In", Kind: dto.OperatorKindIn, RequiresValue: true, ValueMode: dto.OperatorValueList},
 	}
 	if !reflect.DeepEqual(result, expected) {
 		t.Fatalf("expected %v, got %v", expected, result)
@@ -16,45 +16,64 @@ func NewListRecords(engine port.Engine) *ListRecords {
 	return &ListRecords{engine: engine}
 }
 
-func (uc *ListRecords) Execute(ctx context.Context, tableName string, offset, limit int, filter *dto.Filter, sort *dto.Sort) (dto.RecordPage, error) {
-	var domainFilter *model.Filter
-	if filter != nil {
-		domainFilter = &model.Filter{
-			Column: filter.Column,
-			Operator: model.Operator{
-				Name:          filter.Operator.Name,
-				Kind:          model.OperatorKind(filter.Operator.Kind),
-				RequiresValue: filter.Operator.RequiresValue,
-			},
-			Value: filter.Value,
-		}
+func (uc *ListRecords) Execute(ctx context.Context, tableName string, offset, limit int, filter *dto.FilterGroup, sorts []dto.Sort) (dto.RecordPage, error) {
+	page, err := uc.engine.ListRecords(ctx, tableName, offset, limit, mapFilterToDomain(filter), mapSortsToDomain(sorts))
+	if err != nil {
+		return dto.RecordPage{}, err
+	}
+	return mapRecordPageToDTO(page), nil
+}
+
+// ExecuteFromCursor reads the page addressed by an opaque cursor taken from a
+// previous dto.RecordPage; an empty cursor reads the first page.
+func (uc *ListRecords) ExecuteFromCursor(ctx context.Context, tableName, cursor string, limit int, filter *dto.FilterGroup, sorts []dto.Sort) (dto.RecordPage, error) {
+	page, err := uc.engine.ListRecordsFromCursor(ctx, tableName, cursor, limit, mapFilterToDomain(filter), mapSortsToDomain(sorts))
+	if err != nil {
+		return dto.RecordPage{}, err
 	}
+	return mapRecordPageToDTO(page), nil
+}
+
+func mapFilterToDomain(filter *dto.FilterGroup) *model.FilterGroup {
+	if filter == nil {
+		return nil
+	}
+	group := mapFilterGroupToDomain(*filter)
+	return &group
+}
 
-	var domainSort *model.Sort
-	if sort != nil {
-		domainSort = &model.Sort{
+func mapSortsToDomain(sorts []dto.Sort) []model.Sort {
+	if len(sorts) == 0 {
+		return nil
+	}
+	domainSorts := make([]model.Sort, len(sorts))
+	for i, sort := range sorts {
+		domainSorts[i] = model.Sort{
 			Column:    sort.Column,
 			Direction: model.SortDirection(sort.Direction),
+			Nulls:     model.SortNulls(sort.Nulls),
+			Collation: model.SortCollation(sort.Collation),
 		}
 	}
+	return domainSorts
+}
 
-	page, err := uc.engine.ListRecords(ctx, tableName, offset, limit, domainFilter, domainSort)
-	if err != nil {
-		return dto.RecordPage{}, err
-	}
-
+func mapRecordPageToDTO(page model.RecordPage) dto.RecordPage {
 	rows := make([]dto.RecordRow, len(page.Records))
 	for i, record := range page.Records {
 		values := make([]string, len(record.Values))
+		nulls := make([]bool, len(record.Values))
 		for j, value := range record.Values {
 			if value.IsNull {
 				values[j] = "NULL"
+				nulls[j] = true
 			} else {
 				values[j] = value.Text
 			}
 		}
 		rows[i] = dto.RecordRow{
 			Values:              values,
+			Nulls:               nulls,
 			EditableFromBrowse:  cloneEditableFromBrowse(record.EditableFromBrowse),
 			RowKey:              record.RowKey,
 			Identity:            mapRecordIdentityToDTO(record.Identity),
@@ -65,8 +84,36 @@ func (uc *ListRecords) Execute(ctx context.Context, tableName string, offset, li
 	return dto.RecordPage{
 		Rows:       rows,
 		HasMore:    page.HasMore,
-		TotalCount: page.TotalCount,
-	}, nil
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
+	}
+}
+
+func mapFilterGroupToDomain(group dto.FilterGroup) model.FilterGroup {
+	domainGroup := model.FilterGroup{Logic: model.FilterLogic(group.Logic)}
+	if len(group.Conditions) > 0 {
+		domainGroup.Conditions = make([]model.Filter, len(group.Conditions))
+		for i, condition := range group.Conditions {
+			domainGroup.Conditions[i] = model.Filter{
+				Column: condition.Column,
+				Operator: model.Operator{
+					Name:          condition.Operator.Name,
+					Kind:          model.OperatorKind(condition.Operator.Kind),
+					RequiresValue: condition.Operator.RequiresValue,
+					ValueMode:     model.OperatorValueMode(condition.Operator.ValueMode),
+				},
+				Value:  condition.Value,
+				Values: cloneStrings(condition.Values),
+			}
+		}
+	}
+	if len(group.Groups) > 0 {
+		domainGroup.Groups = make([]model.FilterGroup, len(group.Groups))
+		for i, nested := range group.Groups {
+			domainGroup.Groups[i] = mapFilterGroupToDomain(nested)
+		}
+	}
+	return domainGroup
 }
 
 func mapRecordIdentityToDTO(identity model.RecordIdentity) dto.RecordIdentity {
@@ -87,6 +134,15 @@ func mapRecordIdentityToDTO(identity model.RecordIdentity) dto.RecordIdentity {
 	return dto.RecordIdentity{Keys: keys}
 }
 
+func cloneStrings(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	cloned := make([]string, len(values))
+	copy(cloned, values)
+	return cloned
+}
+
 func cloneEditableFromBrowse(values []bool) []bool {
 	if len(values) == 0 {
 		return nil
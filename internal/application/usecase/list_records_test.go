@@ -17,10 +17,9 @@ func TestListRecords_MapsValues(t *testing.T) {
 	engine := &engineStub{
 		records: model.RecordPage{
 			Records: []model.Record{
-				{Values: []model.Value{{Text: "1"}, {Text: "alice"}, {IsNull: true}}},
+				{Values: []model.Value{{Text: "1"}, {Text: "alice"}, {IsNull: true}, {Text: "NULL"}}},
 			},
-			HasMore:    true,
-			TotalCount: 37,
+			HasMore: true,
 		},
 	}
 	uc := usecase.NewListRecords(engine)
@@ -33,10 +32,9 @@ func TestListRecords_MapsValues(t *testing.T) {
 
 	expected := dto.RecordPage{
 		Rows: []dto.RecordRow{
-			{Values: []string{"1", "alice", "NULL"}},
+			{Values: []string{"1", "alice", "NULL", "NULL"}, Nulls: []bool{false, false, true, false}},
 		},
-		HasMore:    true,
-		TotalCount: 37,
+		HasMore: true,
 	}
 	if !reflect.DeepEqual(result, expected) {
 		t.Fatalf("expected %v, got %v", expected, result)
@@ -80,6 +78,7 @@ func TestListRecords_MapsPrecomputedIdentity(t *testing.T) {
 		Rows: []dto.RecordRow{
 			{
 				Values: []string{"visible", "NULL"},
+				Nulls:  []bool{false, true},
 				RowKey: "id=0x0102",
 				Identity: dto.RecordIdentity{
 					Keys: []dto.RecordIdentityKey{
@@ -92,6 +91,7 @@ func TestListRecords_MapsPrecomputedIdentity(t *testing.T) {
 			},
 			{
 				Values:              []string{"<truncated 262145 bytes>"},
+				Nulls:               []bool{false},
 				IdentityUnavailable: true,
 			},
 		},
@@ -126,6 +126,7 @@ func TestListRecords_MapsEditableFromBrowseMetadata(t *testing.T) {
 		Rows: []dto.RecordRow{
 			{
 				Values:             []string{"alice", "<truncated 262145 bytes>"},
+				Nulls:              []bool{false, false},
 				EditableFromBrowse: []bool{true, false},
 			},
 		},
@@ -140,14 +141,35 @@ func TestListRecords_MapsFilter(t *testing.T) {
 
 	engine := &engineStub{}
 	uc := usecase.NewListRecords(engine)
-	filter := &dto.Filter{
-		Column: "name",
-		Operator: dto.Operator{
-			Name:          "Equals",
-			Kind:          dto.OperatorKindEq,
-			RequiresValue: true,
+	filter := &dto.FilterGroup{
+		Logic: dto.FilterLogicAnd,
+		Conditions: []dto.Filter{
+			{
+				Column: "name",
+				Operator: dto.Operator{
+					Name:          "Equals",
+					Kind:          dto.OperatorKindEq,
+					RequiresValue: true,
+				},
+				Value: "alice",
+			},
+		},
+		Groups: []dto.FilterGroup{
+			{
+				Logic: dto.FilterLogicOr,
+				Conditions: []dto.Filter{
+					{
+						Column:   "deleted_at",
+						Operator: dto.Operator{Name: "Is Null", Kind: dto.OperatorKindIsNull},
+					},
+					{
+						Column:   "role",
+						Operator: dto.Operator{Name: "In", Kind: dto.OperatorKindIn, RequiresValue: true, ValueMode: dto.OperatorValueList},
+						Values:   []string{"admin", "owner"},
+					},
+				},
+			},
 		},
-		Value: "alice",
 	}
 
 	_, err := uc.Execute(context.Background(), "users", 5, 20, filter, nil)
@@ -162,14 +184,35 @@ func TestListRecords_MapsFilter(t *testing.T) {
 		t.Fatalf("expected offset 5 and limit 20, got %d and %d", engine.lastRecordsOffset, engine.lastRecordsLimit)
 	}
 
-	expectedFilter := &model.Filter{
-		Column: "name",
-		Operator: model.Operator{
-			Name:          "Equals",
-			Kind:          model.OperatorKindEq,
-			RequiresValue: true,
+	expectedFilter := &model.FilterGroup{
+		Logic: model.FilterLogicAnd,
+		Conditions: []model.Filter{
+			{
+				Column: "name",
+				Operator: model.Operator{
+					Name:          "Equals",
+					Kind:          model.OperatorKindEq,
+					RequiresValue: true,
+				},
+				Value: "alice",
+			},
+		},
+		Groups: []model.FilterGroup{
+			{
+				Logic: model.FilterLogicOr,
+				Conditions: []model.Filter{
+					{
+						Column:   "deleted_at",
+						Operator: model.Operator{Name: "Is Null", Kind: model.OperatorKindIsNull},
+					},
+					{
+						Column:   "role",
+						Operator: model.Operator{Name: "In", Kind: model.OperatorKindIn, RequiresValue: true, ValueMode: model.OperatorValueList},
+						Values:   []string{"admin", "owner"},
+					},
+				},
+			},
 		},
-		Value: "alice",
 	}
 	if !reflect.DeepEqual(engine.lastRecordsFilter, expectedFilter) {
 		t.Fatalf("expected filter %v, got %v", expectedFilter, engine.lastRecordsFilter)
@@ -181,26 +224,55 @@ func TestListRecords_MapsSort(t *testing.T) {
 
 	engine := &engineStub{}
 	uc := usecase.NewListRecords(engine)
-	sort := &dto.Sort{
-		Column:    "created_at",
-		Direction: dto.SortDirectionDesc,
+	sorts := []dto.Sort{
+		{Column: "created_at", Direction: dto.SortDirectionDesc, Nulls: dto.SortNullsLast},
+		{Column: "name", Direction: dto.SortDirectionAsc, Collation: dto.SortCollationNoCase},
 	}
 
-	_, err := uc.Execute(context.Background(), "users", 0, 50, nil, sort)
+	_, err := uc.Execute(context.Background(), "users", 0, 50, nil, sorts)
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
 
-	expectedSort := &model.Sort{
-		Column:    "created_at",
-		Direction: model.SortDirectionDesc,
+	expectedSort := []model.Sort{
+		{Column: "created_at", Direction: model.SortDirectionDesc, Nulls: model.SortNullsLast},
+		{Column: "name", Direction: model.SortDirectionAsc, Collation: model.SortCollationNoCase},
 	}
 	if !reflect.DeepEqual(engine.lastRecordsSort, expectedSort) {
 		t.Fatalf("expected sort %v, got %v", expectedSort, engine.lastRecordsSort)
 	}
 }
 
+func TestListRecords_ExecuteFromCursorPassesCursorAndMapsPageCursors(t *testing.T) {
+	t.Parallel()
+
+	engine := &engineStub{
+		records: model.RecordPage{
+			Records:    []model.Record{{Values: []model.Value{{Text: "1"}}}},
+			HasMore:    true,
+			NextCursor: "next",
+			PrevCursor: "prev",
+		},
+	}
+	uc := usecase.NewListRecords(engine)
+
+	page, err := uc.ExecuteFromCursor(context.Background(), "users", "cursor", 1, nil, []dto.Sort{{Column: "id", Direction: dto.SortDirectionAsc}})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if engine.lastRecordsCursor != "cursor" || engine.lastRecordsLimit != 1 {
+		t.Fatalf("expected cursor and limit to reach engine, got %q and %d", engine.lastRecordsCursor, engine.lastRecordsLimit)
+	}
+	if page.NextCursor != "next" || page.PrevCursor != "prev" {
+		t.Fatalf("expected page cursors to be mapped, got next=%q prev=%q", page.NextCursor, page.PrevCursor)
+	}
+	if !page.HasMore || len(page.Rows) != 1 {
+		t.Fatalf("expected page metadata to be mapped, got %+v", page)
+	}
+}
+
 func TestListRecords_PropagatesEngineError(t *testing.T) {
 	t.Parallel()
 
@@ -24,7 +24,15 @@ func (uc *ListTables) Execute(ctx context.Context) ([]dto.Table, error) {
 	sorted := service.SortedTablesByName(tables)
 	result := make([]dto.Table, len(sorted))
 	for i, table := range sorted {
-		result[i] = dto.Table{Name: table.Name}
+		result[i] = dto.Table{
+			Name: table.Name,
+			Kind: dto.TableKind(table.Kind),
+			InsteadOf: dto.TableWriteTriggers{
+				Insert: table.InsteadOf.Insert,
+				Update: table.InsteadOf.Update,
+				Delete: table.InsteadOf.Delete,
+			},
+		}
 	}
 	return result, nil
 }
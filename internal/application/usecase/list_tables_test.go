@@ -49,3 +49,28 @@ func TestListTables_PropagatesEngineError(t *testing.T) {
 		t.Fatalf("expected error %v, got %v", expectedErr, err)
 	}
 }
+
+func TestListTables_MapsKindAndViewTriggers(t *testing.T) {
+	t.Parallel()
+
+	engine := &engineStub{
+		tables: []model.Table{
+			{Name: "users", Kind: model.TableKindTable},
+			{Name: "active_users", Kind: model.TableKindView, InsteadOf: model.TableWriteTriggers{Update: true}},
+		},
+	}
+	uc := usecase.NewListTables(engine)
+
+	result, err := uc.Execute(context.Background())
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	expected := []dto.Table{
+		{Name: "active_users", Kind: dto.TableKindView, InsteadOf: dto.TableWriteTriggers{Update: true}},
+		{Name: "users", Kind: dto.TableKindTable},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("expected %v, got %v", expected, result)
+	}
+}
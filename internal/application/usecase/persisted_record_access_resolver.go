@@ -12,6 +12,11 @@ import (
 var ErrSelectedRecordIdentityExceedsSafeBrowseLimit = errors.New("selected record identity exceeds safe browse limit")
 var ErrSelectedCellHasNoSafeEditableSource = errors.New("selected cell has no safe editable source")
 
+// ErrTableHasNoRecordIdentity is returned for rows the engine could not
+// identify: the table has no primary key and no rowid to fall back to, as
+// with virtual tables and WITHOUT ROWID tables lacking a usable key.
+var ErrTableHasNoRecordIdentity = errors.New("table has no primary key or rowid")
+
 type PersistedRecordAccessResolver struct{}
 
 func NewPersistedRecordAccessResolver() *PersistedRecordAccessResolver {
@@ -34,7 +39,7 @@ func (r *PersistedRecordAccessResolver) ResolveForDelete(schema dto.Schema, row
 
 	pkColumns := primaryKeyColumns(schema.Columns)
 	if len(pkColumns) == 0 {
-		return dto.PersistedRecordRef{}, fmt.Errorf("table has no primary key")
+		return dto.PersistedRecordRef{}, ErrTableHasNoRecordIdentity
 	}
 
 	values := row.Values
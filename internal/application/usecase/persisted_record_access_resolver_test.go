@@ -110,8 +110,37 @@ func TestPersistedRecordAccessResolver_ResolveForDelete_ReturnsErrorWhenTableHas
 	if err == nil {
 		t.Fatal("expected error for schema without primary key")
 	}
-	if !strings.Contains(err.Error(), "table has no primary key") {
-		t.Fatalf("expected missing primary key error, got %v", err)
+	if !errors.Is(err, usecase.ErrTableHasNoRecordIdentity) {
+		t.Fatalf("expected missing record identity error, got %v", err)
+	}
+}
+
+func TestPersistedRecordAccessResolver_ResolveForEdit_UsesRowIDIdentityWithoutPrimaryKey(t *testing.T) {
+	// Arrange
+	resolver := usecase.NewPersistedRecordAccessResolver()
+	schema := dto.Schema{
+		Columns: []dto.SchemaColumn{
+			{Name: "name", Type: "TEXT", Nullable: true},
+		},
+	}
+	row := dto.RecordRow{
+		Values:             []string{"alice"},
+		EditableFromBrowse: []bool{true},
+		RowKey:             "rowid=7",
+		Identity: dto.RecordIdentity{
+			Keys: []dto.RecordIdentityKey{{Column: "rowid", Value: dto.StagedValue{Text: "7", Raw: int64(7)}}},
+		},
+	}
+
+	// Act
+	ref, err := resolver.ResolveForEdit(schema, row, 0)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if ref.RowKey != "rowid=7" || ref.Identity.Keys[0].Column != "rowid" {
+		t.Fatalf("expected rowid record reference, got %+v", ref)
 	}
 }
 
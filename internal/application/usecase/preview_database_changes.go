@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/port"
+	"github.com/mgierok/dbc/internal/domain/model"
+	"github.com/mgierok/dbc/internal/domain/service"
+)
+
+// PreviewDatabaseChanges lists the statements a save of the given change sets
+// would execute, without writing anything.
+type PreviewDatabaseChanges struct {
+	engine port.Engine
+}
+
+func NewPreviewDatabaseChanges(engine port.Engine) *PreviewDatabaseChanges {
+	return &PreviewDatabaseChanges{engine: engine}
+}
+
+func (uc *PreviewDatabaseChanges) Execute(ctx context.Context, changeSets []model.TableChangeSet) ([]model.WriteStatement, error) {
+	if err := validateTableChangeSets(changeSets); err != nil {
+		return nil, err
+	}
+	return uc.engine.PreviewDatabaseChanges(ctx, changeSets)
+}
+
+func (uc *PreviewDatabaseChanges) ExecuteDTO(ctx context.Context, changeSets []dto.TableChangeSet) ([]dto.WriteStatement, error) {
+	statements, err := uc.Execute(ctx, toDomainTableChangeSets(changeSets))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]dto.WriteStatement, len(statements))
+	for i, statement := range statements {
+		args := make([]string, len(statement.Args))
+		for j, arg := range statement.Args {
+			literal, err := service.SQLLiteral(arg)
+			if err != nil {
+				return nil, err
+			}
+			args[j] = literal
+		}
+		result[i] = dto.WriteStatement{
+			Table: statement.Table,
+			SQL:   statement.SQL,
+			Args:  args,
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,119 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/usecase"
+	"github.com/mgierok/dbc/internal/domain/model"
+	"github.com/mgierok/dbc/internal/domain/service"
+)
+
+func TestPreviewDatabaseChanges_ExecuteDTO_MapsStatements(t *testing.T) {
+	t.Parallel()
+
+	engine := &engineStub{previewStatements: []model.WriteStatement{{
+		Table: "users",
+		SQL:   `DELETE FROM "users" WHERE "id" = ?`,
+		Args:  []model.Value{{Text: "1", Raw: int64(1)}, {Text: "o'neil", Raw: "o'neil"}, {IsNull: true}},
+	}}}
+	uc := usecase.NewPreviewDatabaseChanges(engine)
+	identity := dto.RecordIdentity{
+		Keys: []dto.RecordIdentityKey{{Column: "id", Value: dto.StagedValue{Text: "1", Raw: int64(1)}}},
+	}
+
+	statements, err := uc.ExecuteDTO(context.Background(), []dto.TableChangeSet{
+		{Table: "users", Changes: dto.TableChanges{Deletes: []dto.RecordDelete{{Identity: identity}}}},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	expected := []dto.WriteStatement{{
+		Table: "users",
+		SQL:   `DELETE FROM "users" WHERE "id" = ?`,
+		Args:  []string{"1", "'o''neil'", "NULL"},
+	}}
+	if !reflect.DeepEqual(statements, expected) {
+		t.Fatalf("expected statements %+v, got %+v", expected, statements)
+	}
+	if len(engine.previewChangeSets) != 1 || engine.previewChangeSets[0].Table != "users" {
+		t.Fatalf("expected users change set to be previewed, got %+v", engine.previewChangeSets)
+	}
+	if engine.appliedChangeSets != nil {
+		t.Fatal("expected preview not to apply changes")
+	}
+}
+
+func TestPreviewDatabaseChanges_ValidatesChangeSets(t *testing.T) {
+	t.Parallel()
+
+	engine := &engineStub{}
+	uc := usecase.NewPreviewDatabaseChanges(engine)
+
+	_, err := uc.Execute(context.Background(), nil)
+
+	if !errors.Is(err, model.ErrMissingTableChanges) {
+		t.Fatalf("expected missing table changes error, got %v", err)
+	}
+	if engine.previewChangeSets != nil {
+		t.Fatal("expected engine not to be called")
+	}
+}
+
+func TestPreviewDatabaseChanges_ExecuteDTO_RendersArgsAsSQLLiterals(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		value    model.Value
+		expected string
+		err      error
+	}{
+		{name: "null", value: model.Value{IsNull: true, Text: "NULL"}, expected: "NULL"},
+		{name: "text NULL stays text", value: model.Value{Text: "NULL", Raw: "NULL"}, expected: "'NULL'"},
+		{name: "quoted text", value: model.Value{Text: "o'neil", Raw: "o'neil"}, expected: "'o''neil'"},
+		{name: "text without raw", value: model.Value{Text: "plain"}, expected: "'plain'"},
+		{name: "integer", value: model.Value{Text: "42", Raw: int64(42)}, expected: "42"},
+		{name: "whole real", value: model.Value{Text: "4", Raw: float64(4)}, expected: "4.0"},
+		{name: "blob", value: model.Value{Raw: []byte{0xca, 0xfe}}, expected: "X'CAFE'"},
+		{name: "bool", value: model.Value{Text: "true", Raw: true}, expected: "1"},
+		{name: "NaN", value: model.Value{Text: "NaN", Raw: math.NaN()}, err: service.ErrValueHasNoSQLLiteral},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			engine := &engineStub{previewStatements: []model.WriteStatement{{
+				Table: "readings",
+				SQL:   `UPDATE "readings" SET "value" = ? WHERE "id" = 1`,
+				Args:  []model.Value{tc.value},
+			}}}
+			uc := usecase.NewPreviewDatabaseChanges(engine)
+
+			statements, err := uc.ExecuteDTO(context.Background(), []dto.TableChangeSet{
+				{Table: "readings", Changes: dto.TableChanges{Deletes: []dto.RecordDelete{{Identity: dto.RecordIdentity{
+					Keys: []dto.RecordIdentityKey{{Column: "id", Value: dto.StagedValue{Text: "1", Raw: int64(1)}}},
+				}}}}},
+			})
+
+			if tc.err != nil {
+				if !errors.Is(err, tc.err) {
+					t.Fatalf("expected %v, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got := statements[0].Args[0]; got != tc.expected {
+				t.Fatalf("expected literal %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
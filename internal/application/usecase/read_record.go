@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/port"
+)
+
+// ReadRecord reads the full stored values of one persisted record, for flows
+// that need more than the capped browse values.
+type ReadRecord struct {
+	engine port.Engine
+}
+
+func NewReadRecord(engine port.Engine) *ReadRecord {
+	return &ReadRecord{engine: engine}
+}
+
+// Execute returns the values of the record of tableName matching identity in
+// column order. BLOB values carry 0x-prefixed hex text, the form a BLOB is
+// typed in, so they display and edit like staged input.
+func (uc *ReadRecord) Execute(ctx context.Context, tableName string, identity dto.RecordIdentity) ([]dto.StagedValue, error) {
+	values, err := uc.engine.ReadRecord(ctx, tableName, toDomainRecordIdentity(identity))
+	if err != nil {
+		return nil, err
+	}
+	mapped := make([]dto.StagedValue, len(values))
+	for i, value := range values {
+		mapped[i] = toDTOStagedValue(value)
+		if raw, ok := value.Raw.([]byte); ok {
+			mapped[i].Text = "0x" + hex.EncodeToString(raw)
+		}
+	}
+	return mapped, nil
+}
@@ -0,0 +1,53 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/usecase"
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+func TestReadRecord_MapsStoredValuesAndHexEncodesBLOBText(t *testing.T) {
+	t.Parallel()
+
+	engine := &engineStub{readRecordValues: []model.Value{
+		{Text: "1", Raw: int64(1)},
+		{IsNull: true},
+		{Raw: []byte{0x0a, 0xff}},
+	}}
+	uc := usecase.NewReadRecord(engine)
+	identity := dto.RecordIdentity{Keys: []dto.RecordIdentityKey{{Column: "id", Value: dto.StagedValue{Text: "1", Raw: int64(1)}}}}
+
+	values, err := uc.Execute(context.Background(), "files", identity)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	expected := []dto.StagedValue{
+		{Text: "1", Raw: int64(1)},
+		{IsNull: true},
+		{Text: "0x0aff", Raw: []byte{0x0a, 0xff}},
+	}
+	if !reflect.DeepEqual(values, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, values)
+	}
+	if engine.lastRecordsTable != "files" || len(engine.readRecordIdentity) != 1 || engine.readRecordIdentity[0].Keys[0].Column != "id" {
+		t.Fatalf("expected record of files to be read by id, got %q %+v", engine.lastRecordsTable, engine.readRecordIdentity)
+	}
+}
+
+func TestReadRecord_ReturnsEngineError(t *testing.T) {
+	t.Parallel()
+
+	uc := usecase.NewReadRecord(&engineStub{readRecordErr: model.ErrRecordNotFound})
+
+	_, err := uc.Execute(context.Background(), "files", dto.RecordIdentity{})
+
+	if !errors.Is(err, model.ErrRecordNotFound) {
+		t.Fatalf("expected missing record error, got %v", err)
+	}
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/port"
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+// RunSQL runs statements typed into the SQL console and pages through query
+// results.
+type RunSQL struct {
+	engine port.Engine
+}
+
+func NewRunSQL(engine port.Engine) *RunSQL {
+	return &RunSQL{engine: engine}
+}
+
+func (uc *RunSQL) Execute(ctx context.Context, statement string, limit int) (dto.SQLResult, error) {
+	result, err := uc.engine.RunSQL(ctx, statement, limit)
+	if err != nil {
+		return dto.SQLResult{}, err
+	}
+	return mapSQLResultToDTO(result), nil
+}
+
+// ExecutePage reads the query result page starting at offset. It never runs
+// statements that do not return rows.
+func (uc *RunSQL) ExecutePage(ctx context.Context, statement string, offset, limit int) (dto.SQLResult, error) {
+	result, err := uc.engine.QuerySQLPage(ctx, statement, offset, limit)
+	if err != nil {
+		return dto.SQLResult{}, err
+	}
+	return mapSQLResultToDTO(result), nil
+}
+
+func mapSQLResultToDTO(result model.SQLResult) dto.SQLResult {
+	page := mapRecordPageToDTO(model.RecordPage{Records: result.Records})
+	return dto.SQLResult{
+		Kind:         dto.SQLStatementKind(result.Kind),
+		Columns:      append([]string(nil), result.Columns...),
+		Rows:         page.Rows,
+		HasMore:      result.HasMore,
+		RowsAffected: result.RowsAffected,
+		Elapsed:      result.Elapsed,
+	}
+}
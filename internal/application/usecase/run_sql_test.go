@@ -0,0 +1,73 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/usecase"
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+func TestRunSQL_Execute_MapsQueryResult(t *testing.T) {
+	t.Parallel()
+
+	engine := &engineStub{sqlResult: model.SQLResult{
+		Kind:    model.SQLStatementQuery,
+		Columns: []string{"id", "name"},
+		Records: []model.Record{{Values: []model.Value{{Text: "1"}, {IsNull: true}}}},
+		HasMore: true,
+		Elapsed: 3 * time.Millisecond,
+	}}
+	uc := usecase.NewRunSQL(engine)
+
+	result, err := uc.Execute(context.Background(), "SELECT id, name FROM users", 20)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if engine.lastStatement != "SELECT id, name FROM users" || engine.lastSQLLimit != 20 {
+		t.Fatalf("expected statement and limit to reach engine, got %q %d", engine.lastStatement, engine.lastSQLLimit)
+	}
+	if result.Kind != dto.SQLStatementQuery || !result.HasMore || result.Elapsed != 3*time.Millisecond {
+		t.Fatalf("unexpected result %+v", result)
+	}
+	if !reflect.DeepEqual(result.Columns, []string{"id", "name"}) {
+		t.Fatalf("expected columns to be mapped, got %v", result.Columns)
+	}
+	if len(result.Rows) != 1 || !reflect.DeepEqual(result.Rows[0].Values, []string{"1", "NULL"}) {
+		t.Fatalf("expected rows to be mapped with NULL text, got %+v", result.Rows)
+	}
+}
+
+func TestRunSQL_ExecutePage_ReadsPageAtOffset(t *testing.T) {
+	t.Parallel()
+
+	engine := &engineStub{sqlResult: model.SQLResult{Kind: model.SQLStatementQuery, Columns: []string{"id"}}}
+	uc := usecase.NewRunSQL(engine)
+
+	_, err := uc.ExecutePage(context.Background(), "SELECT id FROM users", 40, 20)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !engine.sqlPageQueried || engine.lastSQLOffset != 40 || engine.lastSQLLimit != 20 {
+		t.Fatalf("expected page query at offset 40, got queried=%t offset=%d limit=%d", engine.sqlPageQueried, engine.lastSQLOffset, engine.lastSQLLimit)
+	}
+}
+
+func TestRunSQL_Execute_ReturnsEngineError(t *testing.T) {
+	t.Parallel()
+
+	engine := &engineStub{sqlErr: model.ErrMultipleSQLStatements}
+	uc := usecase.NewRunSQL(engine)
+
+	_, err := uc.Execute(context.Background(), "SELECT 1; SELECT 2", 20)
+
+	if !errors.Is(err, model.ErrMultipleSQLStatements) {
+		t.Fatalf("expected multiple statements error, got %v", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/mgierok/dbc/internal/application/dto"
 	"github.com/mgierok/dbc/internal/sqliteidentity"
 )
 
@@ -16,9 +17,12 @@ const (
 )
 
 type RuntimeDatabaseOption struct {
-	Name       string
-	ConnString string
-	Source     RuntimeDatabaseOptionSource
+	Name        string
+	ConnString  string
+	Source      RuntimeDatabaseOptionSource
+	ReadOnly    bool
+	ConfirmSave bool
+	Pragmas     dto.ConnectionPragmas
 }
 
 type RuntimeDatabaseTransitionKind int
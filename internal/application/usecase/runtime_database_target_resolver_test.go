@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/mgierok/dbc/internal/application/dto"
 	"github.com/mgierok/dbc/internal/application/usecase"
 )
 
@@ -223,6 +224,33 @@ func TestRuntimeDatabaseTargetResolver_Resolve_ConfiguredMatchWinsOverAnonymousC
 	}
 }
 
+func TestRuntimeDatabaseTargetResolver_Resolve_PathMatchesConfiguredURIEntryWithPragmas(t *testing.T) {
+	// Arrange
+	resolver := usecase.NewRuntimeDatabaseTargetResolver()
+	configuredPath := filepath.Join(t.TempDir(), "shared.sqlite")
+	configured := usecase.RuntimeDatabaseOption{
+		Name:       "shared",
+		ConnString: "file:" + configuredPath + "?cache=shared",
+		Source:     usecase.RuntimeDatabaseOptionSourceConfig,
+		Pragmas:    dto.ConnectionPragmas{BusyTimeout: 5000},
+	}
+
+	// Act
+	target, err := resolver.Resolve(
+		usecase.RuntimeDatabaseOption{},
+		[]usecase.RuntimeDatabaseOption{configured},
+		runtimeDatabaseRequestOption(configuredPath),
+	)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if target.Option != configured {
+		t.Fatalf("expected configured uri option %+v, got %+v", configured, target.Option)
+	}
+}
+
 func TestRuntimeDatabaseTargetResolver_Resolve_DistinctConnStringOpensDifferentDatabase(t *testing.T) {
 	// Arrange
 	resolver := usecase.NewRuntimeDatabaseTargetResolver()
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/port"
+	"github.com/mgierok/dbc/internal/sqliteidentity"
+)
+
+const (
+	runtimeHistoryLimit         = 200
+	runtimeHistoryMaxEntryBytes = 64 << 10
+)
+
+// RuntimeHistory keeps the command and SQL histories of the database open in
+// one runtime session. Entries are ordered oldest first; recording an entry
+// again moves it to the end. Histories are keyed by database identity, so a
+// path and a file: URI of the same file share them.
+type RuntimeHistory struct {
+	store    port.HistoryStore
+	database string
+}
+
+func NewRuntimeHistory(store port.HistoryStore, database string) *RuntimeHistory {
+	return &RuntimeHistory{store: store, database: sqliteidentity.Normalize(database)}
+}
+
+func (uc *RuntimeHistory) Load(ctx context.Context, kind dto.HistoryKind) ([]string, error) {
+	entries, err := uc.store.Load(ctx, uc.database, port.HistoryKind(kind))
+	if err != nil {
+		return nil, err
+	}
+	return boundHistoryEntries(entries), nil
+}
+
+// Record adds entry to the stored history and returns the updated history.
+// Blank entries and entries over 64 KiB are not kept.
+func (uc *RuntimeHistory) Record(ctx context.Context, kind dto.HistoryKind, entry string) ([]string, error) {
+	entries, err := uc.Load(ctx, kind)
+	if err != nil {
+		return nil, err
+	}
+	entry = strings.TrimSpace(entry)
+	if entry == "" || len(entry) > runtimeHistoryMaxEntryBytes {
+		return entries, nil
+	}
+	entries = boundHistoryEntries(AppendHistoryEntry(entries, entry))
+	if err := uc.store.Save(ctx, uc.database, port.HistoryKind(kind), entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// AppendHistoryEntry drops an earlier copy of entry and appends it as the
+// newest entry.
+func AppendHistoryEntry(entries []string, entry string) []string {
+	updated := make([]string, 0, len(entries)+1)
+	for _, existing := range entries {
+		if existing != entry {
+			updated = append(updated, existing)
+		}
+	}
+	return append(updated, entry)
+}
+
+func boundHistoryEntries(entries []string) []string {
+	if len(entries) <= runtimeHistoryLimit {
+		return entries
+	}
+	return entries[len(entries)-runtimeHistoryLimit:]
+}
@@ -0,0 +1,117 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/port"
+	"github.com/mgierok/dbc/internal/application/usecase"
+)
+
+type historyStoreStub struct {
+	entries  map[port.HistoryKind][]string
+	database string
+	saves    int
+	loadErr  error
+	saveErr  error
+}
+
+func (s *historyStoreStub) Load(_ context.Context, database string, kind port.HistoryKind) ([]string, error) {
+	s.database = database
+	if s.loadErr != nil {
+		return nil, s.loadErr
+	}
+	return append([]string(nil), s.entries[kind]...), nil
+}
+
+func (s *historyStoreStub) Save(_ context.Context, database string, kind port.HistoryKind, entries []string) error {
+	s.database = database
+	s.saves++
+	if s.saveErr != nil {
+		return s.saveErr
+	}
+	if s.entries == nil {
+		s.entries = map[port.HistoryKind][]string{}
+	}
+	s.entries[kind] = append([]string(nil), entries...)
+	return nil
+}
+
+func TestRuntimeHistory_RecordMovesRepeatedEntryToNewest(t *testing.T) {
+	t.Parallel()
+
+	store := &historyStoreStub{entries: map[port.HistoryKind][]string{
+		port.HistoryKindCommands: {"w", "set limit=50", "preview"},
+	}}
+	uc := usecase.NewRuntimeHistory(store, " /tmp/app.db ")
+
+	entries, err := uc.Record(context.Background(), dto.HistoryKindCommands, " set limit=50 ")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	expected := []string{"w", "preview", "set limit=50"}
+	if fmt.Sprint(entries) != fmt.Sprint(expected) {
+		t.Fatalf("expected %v, got %v", expected, entries)
+	}
+	if fmt.Sprint(store.entries[port.HistoryKindCommands]) != fmt.Sprint(expected) {
+		t.Fatalf("expected stored %v, got %v", expected, store.entries[port.HistoryKindCommands])
+	}
+	if store.database != "/tmp/app.db" {
+		t.Fatalf("expected trimmed database key, got %q", store.database)
+	}
+}
+
+func TestRuntimeHistory_RecordKeepsNewest200Entries(t *testing.T) {
+	t.Parallel()
+
+	existing := make([]string, 200)
+	for i := range existing {
+		existing[i] = fmt.Sprintf("SELECT %d", i)
+	}
+	store := &historyStoreStub{entries: map[port.HistoryKind][]string{port.HistoryKindSQL: existing}}
+	uc := usecase.NewRuntimeHistory(store, "/tmp/app.db")
+
+	entries, err := uc.Record(context.Background(), dto.HistoryKindSQL, "SELECT 200")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(entries) != 200 || entries[0] != "SELECT 1" || entries[199] != "SELECT 200" {
+		t.Fatalf("expected oldest entry to be dropped, got %d entries from %q to %q", len(entries), entries[0], entries[len(entries)-1])
+	}
+}
+
+func TestRuntimeHistory_RecordSkipsBlankAndOversizedEntries(t *testing.T) {
+	t.Parallel()
+
+	store := &historyStoreStub{}
+	uc := usecase.NewRuntimeHistory(store, "/tmp/app.db")
+
+	for _, entry := range []string{"  ", strings.Repeat("x", 64<<10+1)} {
+		if _, err := uc.Record(context.Background(), dto.HistoryKindSQL, entry); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+
+	if store.saves != 0 {
+		t.Fatalf("expected nothing to be saved, got %d saves", store.saves)
+	}
+}
+
+func TestRuntimeHistory_RecordReturnsStoreErrors(t *testing.T) {
+	t.Parallel()
+
+	store := &historyStoreStub{saveErr: errors.New("disk full")}
+	uc := usecase.NewRuntimeHistory(store, "/tmp/app.db")
+
+	_, err := uc.Record(context.Background(), dto.HistoryKindCommands, "w")
+
+	if err == nil || err.Error() != "disk full" {
+		t.Fatalf("expected save error, got %v", err)
+	}
+}
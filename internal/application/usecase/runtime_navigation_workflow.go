@@ -64,21 +64,15 @@ func NewRuntimeNavigationWorkflow() *RuntimeNavigationWorkflow {
 	}
 }
 
-func (w *RuntimeNavigationWorkflow) PlanTableSwitch(targetTableName string, hasDirty bool, changeCount int) RuntimeNavigationPlan {
+// PlanTableSwitch switches tables without a prompt: each table keeps its own
+// staged changes, so switching never discards anything.
+func (w *RuntimeNavigationWorkflow) PlanTableSwitch(targetTableName string) RuntimeNavigationPlan {
 	action := RuntimeNavigationAction{
 		Kind:            RuntimeNavigationActionSwitchTable,
 		TargetTableName: targetTableName,
 	}
-	if !hasDirty {
-		return RuntimeNavigationPlan{
-			NextAction: w.executeAction(action, false),
-		}
-	}
-
-	prompt := w.dirtyNavigationPolicy().BuildTableSwitchPrompt(changeCount)
 	return RuntimeNavigationPlan{
-		Prompt:  runtimeNavigationDecisionPrompt(prompt),
-		Pending: &PendingRuntimeNavigation{Action: action},
+		NextAction: w.executeAction(action, false),
 	}
 }
 
@@ -8,59 +8,17 @@ import (
 	"github.com/mgierok/dbc/internal/application/usecase"
 )
 
-func TestRuntimeNavigationWorkflow_PlanTableSwitch(t *testing.T) {
+func TestRuntimeNavigationWorkflow_PlanTableSwitch_SwitchesWithoutPrompt(t *testing.T) {
 	t.Parallel()
 
 	workflow := usecase.NewRuntimeNavigationWorkflow()
 
-	tests := []struct {
-		name            string
-		hasDirty        bool
-		changeCount     int
-		expectedPending *usecase.PendingRuntimeNavigation
-		expectedPrompt  *usecase.RuntimeNavigationDecisionPrompt
-		expectedAction  usecase.RuntimeNavigationNextAction
-	}{
-		{
-			name:        "clean state switches immediately",
-			hasDirty:    false,
-			changeCount: 3,
-			expectedAction: usecase.RuntimeNavigationNextAction{
-				Kind:            usecase.RuntimeNavigationNextActionSwitchTable,
-				TargetTableName: "orders",
-			},
-		},
-		{
-			name:        "dirty state returns prompt and pending action",
-			hasDirty:    true,
-			changeCount: 3,
-			expectedPending: &usecase.PendingRuntimeNavigation{
-				Action: usecase.RuntimeNavigationAction{
-					Kind:            usecase.RuntimeNavigationActionSwitchTable,
-					TargetTableName: "orders",
-				},
-			},
-			expectedPrompt: &usecase.RuntimeNavigationDecisionPrompt{
-				Title:   "Switch Table",
-				Message: "Switching tables will cause loss of unsaved data (3 rows). Are you sure you want to discard unsaved data?",
-				Options: []usecase.DirtyDecisionOption{
-					{ID: usecase.DirtyDecisionDiscard, Label: "Discard changes and switch table"},
-					{ID: usecase.DirtyDecisionCancel, Label: "Continue editing"},
-				},
-			},
-		},
-	}
-
-	for _, tc := range tests {
-		tc := tc
-		t.Run(tc.name, func(t *testing.T) {
-			t.Parallel()
-
-			plan := workflow.PlanTableSwitch("orders", tc.hasDirty, tc.changeCount)
+	plan := workflow.PlanTableSwitch("orders")
 
-			assertRuntimeNavigationPlan(t, plan, tc.expectedPending, tc.expectedPrompt, tc.expectedAction)
-		})
-	}
+	assertRuntimeNavigationPlan(t, plan, nil, nil, usecase.RuntimeNavigationNextAction{
+		Kind:            usecase.RuntimeNavigationNextActionSwitchTable,
+		TargetTableName: "orders",
+	})
 }
 
 func TestRuntimeNavigationWorkflow_PlanQuit(t *testing.T) {
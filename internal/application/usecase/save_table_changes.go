@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -10,6 +11,20 @@ import (
 	"github.com/mgierok/dbc/internal/domain/model"
 )
 
+// SaveConflictError reports the records whose concurrent changes stopped a
+// save.
+type SaveConflictError struct {
+	Conflicts []dto.RecordConflict
+}
+
+func (e *SaveConflictError) Error() string {
+	return fmt.Sprintf("%s: %d conflicting record(s)", model.ErrRecordConflict, len(e.Conflicts))
+}
+
+func (e *SaveConflictError) Unwrap() error {
+	return model.ErrRecordConflict
+}
+
 type SaveTableChanges struct {
 	engine port.Engine
 }
@@ -20,7 +35,7 @@ func NewSaveTableChanges(engine port.Engine) *SaveTableChanges {
 
 func (uc *SaveTableChanges) Execute(ctx context.Context, tableName string, changes model.TableChanges) (int, error) {
 	if strings.TrimSpace(tableName) == "" {
-		return 0, fmt.Errorf("table name is required")
+		return 0, model.ErrMissingTableName
 	}
 	if err := validateTableChanges(changes); err != nil {
 		return 0, err
@@ -29,7 +44,55 @@ func (uc *SaveTableChanges) Execute(ctx context.Context, tableName string, chang
 }
 
 func (uc *SaveTableChanges) ExecuteDTO(ctx context.Context, tableName string, changes dto.TableChanges) (int, error) {
-	return uc.Execute(ctx, tableName, toDomainTableChanges(changes))
+	count, err := uc.Execute(ctx, tableName, toDomainTableChanges(changes))
+	return count, toDTOSaveError(err)
+}
+
+// ExecuteAll saves the changes of several tables in one transaction.
+func (uc *SaveTableChanges) ExecuteAll(ctx context.Context, changeSets []model.TableChangeSet) (int, error) {
+	if err := validateTableChangeSets(changeSets); err != nil {
+		return 0, err
+	}
+	return uc.engine.ApplyDatabaseChanges(ctx, changeSets)
+}
+
+func (uc *SaveTableChanges) ExecuteAllDTO(ctx context.Context, changeSets []dto.TableChangeSet) (int, error) {
+	count, err := uc.ExecuteAll(ctx, toDomainTableChangeSets(changeSets))
+	return count, toDTOSaveError(err)
+}
+
+func validateTableChangeSets(changeSets []model.TableChangeSet) error {
+	if len(changeSets) == 0 {
+		return model.ErrMissingTableChanges
+	}
+	for _, changeSet := range changeSets {
+		if strings.TrimSpace(changeSet.Table) == "" {
+			return model.ErrMissingTableName
+		}
+		if err := validateTableChanges(changeSet.Changes); err != nil {
+			return fmt.Errorf("%s: %w", changeSet.Table, err)
+		}
+	}
+	return nil
+}
+
+func toDomainTableChangeSets(changeSets []dto.TableChangeSet) []model.TableChangeSet {
+	mapped := make([]model.TableChangeSet, 0, len(changeSets))
+	for _, changeSet := range changeSets {
+		mapped = append(mapped, model.TableChangeSet{
+			Table:   changeSet.Table,
+			Changes: toDomainTableChanges(changeSet.Changes),
+		})
+	}
+	return mapped
+}
+
+func toDTOSaveError(err error) error {
+	var conflictErr *model.RecordConflictError
+	if errors.As(err, &conflictErr) {
+		return &SaveConflictError{Conflicts: toDTORecordConflicts(conflictErr.Conflicts)}
+	}
+	return err
 }
 
 func toDomainTableChanges(changes dto.TableChanges) model.TableChanges {
@@ -37,6 +100,7 @@ func toDomainTableChanges(changes dto.TableChanges) model.TableChanges {
 		Inserts: make([]model.RecordInsert, 0, len(changes.Inserts)),
 		Updates: make([]model.RecordUpdate, 0, len(changes.Updates)),
 		Deletes: make([]model.RecordDelete, 0, len(changes.Deletes)),
+		Force:   changes.Force,
 	}
 
 	for _, insert := range changes.Inserts {
@@ -52,6 +116,9 @@ func toDomainTableChanges(changes dto.TableChanges) model.TableChanges {
 			Identity: toDomainRecordIdentity(update.Identity),
 			Changes:  toDomainColumnValues(update.Changes),
 		}
+		if len(update.Originals) > 0 {
+			mappedUpdate.Originals = toDomainColumnValues(update.Originals)
+		}
 		mapped.Updates = append(mapped.Updates, mappedUpdate)
 	}
 
@@ -101,6 +168,36 @@ func toDomainColumnValues(values []dto.ColumnValue) []model.ColumnValue {
 	return mapped
 }
 
+func toDTORecordConflicts(conflicts []model.RecordConflict) []dto.RecordConflict {
+	mapped := make([]dto.RecordConflict, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		columns := make([]dto.ColumnConflict, 0, len(conflict.Columns))
+		for _, column := range conflict.Columns {
+			columns = append(columns, dto.ColumnConflict{
+				Column:   column.Column,
+				Original: toDTOStagedValue(column.Original),
+				Current:  toDTOStagedValue(column.Current),
+				Staged:   toDTOStagedValue(column.Staged),
+			})
+		}
+		mapped = append(mapped, dto.RecordConflict{
+			Table:    conflict.Table,
+			Identity: mapRecordIdentityToDTO(conflict.Identity),
+			Missing:  conflict.Missing,
+			Columns:  columns,
+		})
+	}
+	return mapped
+}
+
+func toDTOStagedValue(value model.Value) dto.StagedValue {
+	return dto.StagedValue{
+		IsNull: value.IsNull,
+		Text:   value.Text,
+		Raw:    value.Raw,
+	}
+}
+
 func validateTableChanges(changes model.TableChanges) error {
 	if len(changes.Inserts) == 0 && len(changes.Updates) == 0 && len(changes.Deletes) == 0 {
 		return model.ErrMissingTableChanges
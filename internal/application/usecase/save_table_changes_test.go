@@ -370,3 +370,143 @@ func TestSaveTableChanges_ExecuteDTO_PreservesCombinedPayloadShape(t *testing.T)
 		t.Fatalf("expected delete raw value tenant-a, got %#v", engine.appliedChanges.Deletes[0].Identity.Keys[1].Value.Raw)
 	}
 }
+
+func TestSaveTableChanges_ExecuteDTO_MapsRecordConflicts(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	identity := model.RecordIdentity{
+		Keys: []model.RecordIdentityKey{{Column: "id", Value: model.Value{Text: "1", Raw: int64(1)}}},
+	}
+	engine := &engineStub{applyChangesErr: &model.RecordConflictError{Conflicts: []model.RecordConflict{
+		{
+			Identity: identity,
+			Columns: []model.ColumnConflict{{
+				Column:   "name",
+				Original: model.Value{Text: "alison"},
+				Current:  model.Value{Text: "alice"},
+				Staged:   model.Value{Text: "alicia", Raw: "alicia"},
+			}},
+		},
+	}}}
+	uc := usecase.NewSaveTableChanges(engine)
+
+	// Act
+	_, err := uc.ExecuteDTO(context.Background(), "users", dto.TableChanges{
+		Updates: []dto.RecordUpdate{
+			{
+				Identity: dto.RecordIdentity{
+					Keys: []dto.RecordIdentityKey{{Column: "id", Value: dto.StagedValue{Text: "1", Raw: int64(1)}}},
+				},
+				Changes:   []dto.ColumnValue{{Column: "name", Value: dto.StagedValue{Text: "alicia", Raw: "alicia"}}},
+				Originals: []dto.ColumnValue{{Column: "name", Value: dto.StagedValue{Text: "alison"}}},
+			},
+		},
+		Force: true,
+	})
+
+	// Assert
+	var conflictErr *usecase.SaveConflictError
+	if !errors.As(err, &conflictErr) || !errors.Is(err, model.ErrRecordConflict) {
+		t.Fatalf("expected save conflict error, got %v", err)
+	}
+	expected := []dto.RecordConflict{
+		{
+			Identity: dto.RecordIdentity{
+				Keys: []dto.RecordIdentityKey{{Column: "id", Value: dto.StagedValue{Text: "1", Raw: int64(1)}}},
+			},
+			Columns: []dto.ColumnConflict{{
+				Column:   "name",
+				Original: dto.StagedValue{Text: "alison"},
+				Current:  dto.StagedValue{Text: "alice"},
+				Staged:   dto.StagedValue{Text: "alicia", Raw: "alicia"},
+			}},
+		},
+	}
+	if !reflect.DeepEqual(conflictErr.Conflicts, expected) {
+		t.Fatalf("expected conflicts %+v, got %+v", expected, conflictErr.Conflicts)
+	}
+	if !engine.appliedChanges.Force {
+		t.Fatal("expected force flag to reach the engine")
+	}
+	if len(engine.appliedChanges.Updates[0].Originals) != 1 {
+		t.Fatalf("expected originals to reach the engine, got %+v", engine.appliedChanges.Updates[0].Originals)
+	}
+}
+
+func TestSaveTableChanges_ExecuteAllDTO_DelegatesEveryTable(t *testing.T) {
+	t.Parallel()
+
+	engine := &engineStub{appliedCount: 2}
+	uc := usecase.NewSaveTableChanges(engine)
+	identity := dto.RecordIdentity{
+		Keys: []dto.RecordIdentityKey{{Column: "id", Value: dto.StagedValue{Text: "1", Raw: int64(1)}}},
+	}
+
+	count, err := uc.ExecuteAllDTO(context.Background(), []dto.TableChangeSet{
+		{Table: "orders", Changes: dto.TableChanges{Deletes: []dto.RecordDelete{{Identity: identity}}}},
+		{Table: "users", Changes: dto.TableChanges{Deletes: []dto.RecordDelete{{Identity: identity}}}},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if count != 2 {
+		t.Fatalf("expected applied row count 2, got %d", count)
+	}
+	if len(engine.appliedChangeSets) != 2 {
+		t.Fatalf("expected 2 change sets, got %d", len(engine.appliedChangeSets))
+	}
+	if engine.appliedChangeSets[0].Table != "orders" || engine.appliedChangeSets[1].Table != "users" {
+		t.Fatalf("expected change sets in given order, got %+v", engine.appliedChangeSets)
+	}
+}
+
+func TestSaveTableChanges_ExecuteAll_ValidatesEveryChangeSet(t *testing.T) {
+	t.Parallel()
+
+	validDelete := model.TableChanges{Deletes: []model.RecordDelete{{Identity: model.RecordIdentity{
+		Keys: []model.RecordIdentityKey{{Column: "id", Value: model.Value{Text: "1", Raw: int64(1)}}},
+	}}}}
+
+	for _, tc := range []struct {
+		name        string
+		changeSets  []model.TableChangeSet
+		expectedErr error
+	}{
+		{
+			name:        "no change sets",
+			expectedErr: model.ErrMissingTableChanges,
+		},
+		{
+			name:        "missing table name",
+			changeSets:  []model.TableChangeSet{{Changes: validDelete}},
+			expectedErr: model.ErrMissingTableName,
+		},
+		{
+			name: "empty changes in one table",
+			changeSets: []model.TableChangeSet{
+				{Table: "users", Changes: validDelete},
+				{Table: "orders"},
+			},
+			expectedErr: model.ErrMissingTableChanges,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			engine := &engineStub{}
+			uc := usecase.NewSaveTableChanges(engine)
+
+			_, err := uc.ExecuteAll(context.Background(), tc.changeSets)
+
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected %v, got %v", tc.expectedErr, err)
+			}
+			if engine.appliedChangeSets != nil {
+				t.Fatalf("expected engine not to be called, got %+v", engine.appliedChangeSets)
+			}
+		})
+	}
+}
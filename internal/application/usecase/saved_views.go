@@ -0,0 +1,196 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/port"
+	"github.com/mgierok/dbc/internal/domain/model"
+	"github.com/mgierok/dbc/internal/sqliteidentity"
+)
+
+var (
+	ErrSavedViewNameRequired       = errors.New("view name is required")
+	ErrSavedViewEmpty              = errors.New("no filter or sort to save")
+	ErrSavedViewNotFound           = errors.New("view not found")
+	ErrSavedViewOutdated           = errors.New("saved view no longer matches the table")
+	ErrSavedViewsRequireConfigured = errors.New("saved views require a database from config")
+)
+
+// SavedViews stores named filters and sorts with the config entry of the
+// database open in one runtime session. The entry is found by database
+// identity, so a database opened by path shares the views of its config entry.
+type SavedViews struct {
+	store    port.ConfigStore
+	engine   port.Engine
+	database string
+}
+
+func NewSavedViews(store port.ConfigStore, engine port.Engine, database string) *SavedViews {
+	return &SavedViews{store: store, engine: engine, database: database}
+}
+
+// List returns the names of the views saved for table, in saved order.
+func (uc *SavedViews) List(ctx context.Context, table string) ([]string, error) {
+	views, err := uc.tableViews(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(views))
+	for i, view := range views {
+		names[i] = view.Name
+	}
+	return names, nil
+}
+
+// Save stores view, replacing a view of the same table and name.
+func (uc *SavedViews) Save(ctx context.Context, view dto.SavedView) error {
+	view.Name = strings.TrimSpace(view.Name)
+	if view.Name == "" {
+		return ErrSavedViewNameRequired
+	}
+	if (view.Filter == nil || (len(view.Filter.Conditions) == 0 && len(view.Filter.Groups) == 0)) && len(view.Sorts) == 0 {
+		return ErrSavedViewEmpty
+	}
+	index, err := uc.entryIndex(ctx)
+	if err != nil {
+		return err
+	}
+	return uc.store.SaveView(ctx, index, port.ConfigView{
+		Name:   view.Name,
+		Table:  view.Table,
+		Filter: mapFilterToDomain(view.Filter),
+		Sorts:  mapSortsToDomain(view.Sorts),
+	})
+}
+
+// Load returns the named view of table with its operators resolved against
+// the current schema, so a view saved before a column was dropped or retyped
+// fails instead of filtering on something else.
+func (uc *SavedViews) Load(ctx context.Context, table, name string) (dto.SavedView, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return dto.SavedView{}, ErrSavedViewNameRequired
+	}
+	views, err := uc.tableViews(ctx, table)
+	if err != nil {
+		return dto.SavedView{}, err
+	}
+	for _, view := range views {
+		if view.Name == name {
+			return uc.resolve(ctx, view)
+		}
+	}
+	return dto.SavedView{}, fmt.Errorf("%w: %s", ErrSavedViewNotFound, name)
+}
+
+func (uc *SavedViews) tableViews(ctx context.Context, table string) ([]port.ConfigView, error) {
+	index, err := uc.entryIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+	views, err := uc.store.ListViews(ctx, index)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]port.ConfigView, 0, len(views))
+	for _, view := range views {
+		if view.Table == table {
+			result = append(result, view)
+		}
+	}
+	return result, nil
+}
+
+func (uc *SavedViews) entryIndex(ctx context.Context) (int, error) {
+	entries, err := uc.store.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for i, entry := range entries {
+		if sqliteidentity.Equivalent(entry.DBPath, uc.database) {
+			return i, nil
+		}
+	}
+	return 0, ErrSavedViewsRequireConfigured
+}
+
+func (uc *SavedViews) resolve(ctx context.Context, view port.ConfigView) (dto.SavedView, error) {
+	schema, err := uc.engine.GetSchema(ctx, view.Table)
+	if err != nil {
+		return dto.SavedView{}, err
+	}
+	columnTypes := make(map[string]string, len(schema.Columns))
+	for _, column := range schema.Columns {
+		columnTypes[column.Name] = column.Type
+	}
+	resolved := dto.SavedView{Name: view.Name, Table: view.Table}
+	if view.Filter != nil {
+		group, err := uc.resolveFilterGroup(ctx, *view.Filter, columnTypes)
+		if err != nil {
+			return dto.SavedView{}, fmt.Errorf("%w: %s: %w", ErrSavedViewOutdated, view.Name, err)
+		}
+		resolved.Filter = &group
+	}
+	for _, sort := range view.Sorts {
+		if _, ok := columnTypes[sort.Column]; !ok {
+			return dto.SavedView{}, fmt.Errorf("%w: %s: unknown sort column %s", ErrSavedViewOutdated, view.Name, sort.Column)
+		}
+		resolved.Sorts = append(resolved.Sorts, dto.Sort{
+			Column:    sort.Column,
+			Direction: dto.SortDirection(sort.Direction),
+			Nulls:     dto.SortNulls(sort.Nulls),
+			Collation: dto.SortCollation(sort.Collation),
+		})
+	}
+	return resolved, nil
+}
+
+func (uc *SavedViews) resolveFilterGroup(ctx context.Context, group model.FilterGroup, columnTypes map[string]string) (dto.FilterGroup, error) {
+	resolved := dto.FilterGroup{Logic: dto.FilterLogic(group.Logic)}
+	for _, condition := range group.Conditions {
+		columnType, ok := columnTypes[condition.Column]
+		if !ok {
+			return dto.FilterGroup{}, fmt.Errorf("unknown filter column %s", condition.Column)
+		}
+		operator, err := uc.operatorForColumn(ctx, columnType, condition.Operator.Kind)
+		if err != nil {
+			return dto.FilterGroup{}, fmt.Errorf("column %s: %w", condition.Column, err)
+		}
+		resolved.Conditions = append(resolved.Conditions, dto.Filter{
+			Column: condition.Column,
+			Operator: dto.Operator{
+				Name:          operator.Name,
+				Kind:          dto.OperatorKind(operator.Kind),
+				RequiresValue: operator.RequiresValue,
+				ValueMode:     dto.OperatorValueMode(operator.ValueMode),
+			},
+			Value:  condition.Value,
+			Values: cloneStrings(condition.Values),
+		})
+	}
+	for _, nested := range group.Groups {
+		nestedGroup, err := uc.resolveFilterGroup(ctx, nested, columnTypes)
+		if err != nil {
+			return dto.FilterGroup{}, err
+		}
+		resolved.Groups = append(resolved.Groups, nestedGroup)
+	}
+	return resolved, nil
+}
+
+func (uc *SavedViews) operatorForColumn(ctx context.Context, columnType string, kind model.OperatorKind) (model.Operator, error) {
+	operators, err := uc.engine.ListOperators(ctx, columnType)
+	if err != nil {
+		return model.Operator{}, err
+	}
+	for _, operator := range operators {
+		if operator.Kind == kind {
+			return operator, nil
+		}
+	}
+	return model.Operator{}, fmt.Errorf("operator %s is not available", kind)
+}
@@ -0,0 +1,152 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/port"
+	"github.com/mgierok/dbc/internal/application/usecase"
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+func savedViewsConfigStoreForTest() *fakeConfigStore {
+	return &fakeConfigStore{entries: []port.ConfigEntry{
+		{Name: "local", DBPath: "/tmp/local.sqlite"},
+		{Name: "app", DBPath: "/tmp/app.sqlite"},
+	}}
+}
+
+func TestSavedViews_SaveStoresViewWithEquivalentConfigEntry(t *testing.T) {
+	t.Parallel()
+
+	store := savedViewsConfigStoreForTest()
+	uc := usecase.NewSavedViews(store, &engineStub{}, "file:/tmp/app.sqlite?mode=ro")
+
+	err := uc.Save(context.Background(), dto.SavedView{
+		Name:  " active ",
+		Table: "users",
+		Filter: &dto.FilterGroup{Logic: dto.FilterLogicAnd, Conditions: []dto.Filter{
+			{Column: "status", Operator: dto.Operator{Name: "Equals", Kind: dto.OperatorKindEq, RequiresValue: true}, Value: "active"},
+		}},
+		Sorts: []dto.Sort{{Column: "id", Direction: dto.SortDirectionDesc}},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if store.saveViewCalls != 1 || store.lastViewIndex != 1 {
+		t.Fatalf("expected view saved with entry 1, got %d calls at index %d", store.saveViewCalls, store.lastViewIndex)
+	}
+	saved := store.views[1][0]
+	if saved.Name != "active" || saved.Table != "users" {
+		t.Fatalf("unexpected saved view %+v", saved)
+	}
+	if saved.Filter == nil || saved.Filter.Conditions[0].Operator.Kind != model.OperatorKindEq || saved.Sorts[0].Direction != model.SortDirectionDesc {
+		t.Fatalf("expected filter and sort to be saved, got %+v", saved)
+	}
+}
+
+func TestSavedViews_SaveRejectsInvalidRequests(t *testing.T) {
+	t.Parallel()
+
+	sorted := []dto.Sort{{Column: "id", Direction: dto.SortDirectionAsc}}
+	tests := []struct {
+		name     string
+		database string
+		view     dto.SavedView
+		expected error
+	}{
+		{name: "missing name", database: "/tmp/app.sqlite", view: dto.SavedView{Name: " ", Table: "users", Sorts: sorted}, expected: usecase.ErrSavedViewNameRequired},
+		{name: "nothing to save", database: "/tmp/app.sqlite", view: dto.SavedView{Name: "all", Table: "users", Filter: &dto.FilterGroup{Logic: dto.FilterLogicAnd}}, expected: usecase.ErrSavedViewEmpty},
+		{name: "database outside config", database: "/tmp/other.sqlite", view: dto.SavedView{Name: "all", Table: "users", Sorts: sorted}, expected: usecase.ErrSavedViewsRequireConfigured},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			store := savedViewsConfigStoreForTest()
+			uc := usecase.NewSavedViews(store, &engineStub{}, tc.database)
+
+			err := uc.Save(context.Background(), tc.view)
+
+			if !errors.Is(err, tc.expected) {
+				t.Fatalf("expected %v, got %v", tc.expected, err)
+			}
+			if store.saveViewCalls != 0 {
+				t.Fatalf("expected nothing to be saved, got %d saves", store.saveViewCalls)
+			}
+		})
+	}
+}
+
+func TestSavedViews_ListAndLoadResolveViewsOfTable(t *testing.T) {
+	t.Parallel()
+
+	store := savedViewsConfigStoreForTest()
+	store.views = map[int][]port.ConfigView{1: {
+		{Name: "recent", Table: "orders", Sorts: []model.Sort{{Column: "id", Direction: model.SortDirectionDesc}}},
+		{
+			Name:  "active",
+			Table: "users",
+			Filter: &model.FilterGroup{Logic: model.FilterLogicOr, Conditions: []model.Filter{
+				{Column: "status", Operator: model.Operator{Kind: model.OperatorKindIn}, Values: []string{"active", "trial"}},
+			}},
+			Sorts: []model.Sort{{Column: "name", Direction: model.SortDirectionAsc, Collation: model.SortCollationNoCase}},
+		},
+	}}
+	engine := &engineStub{
+		schema: model.Schema{Columns: []model.Column{{Name: "name", Type: "TEXT"}, {Name: "status", Type: "TEXT"}}},
+		operators: []model.Operator{
+			{Name: "Equals", Kind: model.OperatorKindEq, RequiresValue: true},
+			{Name: "In", Kind: model.OperatorKindIn, RequiresValue: true, ValueMode: model.OperatorValueList},
+		},
+	}
+	uc := usecase.NewSavedViews(store, engine, "/tmp/app.sqlite")
+
+	names, err := uc.List(context.Background(), "users")
+	if err != nil {
+		t.Fatalf("expected no list error, got %v", err)
+	}
+	view, err := uc.Load(context.Background(), "users", "active")
+
+	if err != nil {
+		t.Fatalf("expected no load error, got %v", err)
+	}
+	if fmt.Sprint(names) != "[active]" {
+		t.Fatalf("expected only users views, got %v", names)
+	}
+	condition := view.Filter.Conditions[0]
+	if view.Filter.Logic != dto.FilterLogicOr || condition.Operator.Name != "In" || condition.Operator.ValueMode != dto.OperatorValueList {
+		t.Fatalf("expected resolved In operator, got %+v", view.Filter)
+	}
+	if fmt.Sprint(condition.Values) != "[active trial]" {
+		t.Fatalf("expected list values, got %v", condition.Values)
+	}
+	if len(view.Sorts) != 1 || view.Sorts[0].Collation != dto.SortCollationNoCase {
+		t.Fatalf("expected sort with collation, got %+v", view.Sorts)
+	}
+}
+
+func TestSavedViews_LoadRejectsMissingAndOutdatedViews(t *testing.T) {
+	t.Parallel()
+
+	store := savedViewsConfigStoreForTest()
+	store.views = map[int][]port.ConfigView{1: {
+		{Name: "by-email", Table: "users", Sorts: []model.Sort{{Column: "email", Direction: model.SortDirectionAsc}}},
+	}}
+	engine := &engineStub{schema: model.Schema{Columns: []model.Column{{Name: "id", Type: "INTEGER"}}}}
+	uc := usecase.NewSavedViews(store, engine, "/tmp/app.sqlite")
+
+	_, missingErr := uc.Load(context.Background(), "users", "unknown")
+	_, outdatedErr := uc.Load(context.Background(), "users", "by-email")
+
+	if !errors.Is(missingErr, usecase.ErrSavedViewNotFound) {
+		t.Fatalf("expected not found error, got %v", missingErr)
+	}
+	if !errors.Is(outdatedErr, usecase.ErrSavedViewOutdated) {
+		t.Fatalf("expected outdated view error, got %v", outdatedErr)
+	}
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"strings"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+// foreignKeyDefaultAction is omitted from constraint labels.
+const foreignKeyDefaultAction = "NO ACTION"
+
+func projectedSchemaConstraints(schema model.Schema) []dto.SchemaConstraint {
+	total := len(schema.ForeignKeys) + len(schema.UniqueConstraints) + len(schema.CheckConstraints)
+	if total == 0 {
+		return nil
+	}
+
+	constraints := make([]dto.SchemaConstraint, 0, total)
+	for _, foreignKey := range schema.ForeignKeys {
+		constraints = append(constraints, dto.SchemaConstraint{
+			Kind:       dto.SchemaConstraintForeignKey,
+			Columns:    append([]string(nil), foreignKey.Columns...),
+			RefTable:   foreignKey.RefTable,
+			RefColumns: append([]string(nil), foreignKey.RefColumns...),
+			OnUpdate:   foreignKey.OnUpdate,
+			OnDelete:   foreignKey.OnDelete,
+			Label:      projectedForeignKeyConstraintLabel(foreignKey),
+		})
+	}
+	for _, unique := range schema.UniqueConstraints {
+		constraints = append(constraints, dto.SchemaConstraint{
+			Kind:    dto.SchemaConstraintUnique,
+			Columns: append([]string(nil), unique.Columns...),
+			Label:   "UNIQUE (" + strings.Join(unique.Columns, ", ") + ")",
+		})
+	}
+	for _, check := range schema.CheckConstraints {
+		label := "CHECK (" + check.Expression + ")"
+		if check.Name != "" {
+			label = "CONSTRAINT " + check.Name + " " + label
+		}
+		constraints = append(constraints, dto.SchemaConstraint{
+			Kind:       dto.SchemaConstraintCheck,
+			Name:       check.Name,
+			Expression: check.Expression,
+			Label:      label,
+		})
+	}
+	return constraints
+}
+
+func projectedForeignKeyConstraintLabel(foreignKey model.ForeignKeyConstraint) string {
+	var builder strings.Builder
+	builder.WriteString("FOREIGN KEY (")
+	builder.WriteString(strings.Join(foreignKey.Columns, ", "))
+	builder.WriteString(") REFERENCES ")
+	builder.WriteString(foreignKey.RefTable)
+	if len(foreignKey.RefColumns) > 0 {
+		builder.WriteString(" (")
+		builder.WriteString(strings.Join(foreignKey.RefColumns, ", "))
+		builder.WriteString(")")
+	}
+	if foreignKey.OnDelete != "" && foreignKey.OnDelete != foreignKeyDefaultAction {
+		builder.WriteString(" ON DELETE ")
+		builder.WriteString(foreignKey.OnDelete)
+	}
+	if foreignKey.OnUpdate != "" && foreignKey.OnUpdate != foreignKeyDefaultAction {
+		builder.WriteString(" ON UPDATE ")
+		builder.WriteString(foreignKey.OnUpdate)
+	}
+	return builder.String()
+}
@@ -27,6 +27,10 @@ func projectedSchemaMetadataBadges(column model.Column) []string {
 		badges = append(badges, "AUTOINCREMENT")
 	}
 
+	if column.Generated {
+		badges = append(badges, projectedGeneratedBadge(column))
+	}
+
 	for _, foreignKey := range column.ForeignKeys {
 		badges = append(badges, projectedForeignKeyBadge(foreignKey))
 	}
@@ -41,3 +45,15 @@ func projectedForeignKeyBadge(foreignKey model.ForeignKeyRef) string {
 
 	return "FK->" + foreignKey.Table + "." + foreignKey.Column
 }
+
+func projectedGeneratedBadge(column model.Column) string {
+	storage := "VIRTUAL"
+	if column.GeneratedStored {
+		storage = "STORED"
+	}
+	if column.GenerationExpression == "" {
+		return "GENERATED " + storage
+	}
+
+	return "GENERATED " + storage + " AS (" + column.GenerationExpression + ")"
+}
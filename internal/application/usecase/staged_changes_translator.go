@@ -60,16 +60,24 @@ func (uc *StagedChangesTranslator) BuildTableChanges(
 			return dto.TableChanges{}, fmt.Errorf("record identity missing")
 		}
 		updateChanges := make([]dto.ColumnValue, 0, len(edits.Changes))
+		var originals []dto.ColumnValue
 		for colIndex, change := range edits.Changes {
 			if colIndex < 0 || colIndex >= len(schema.Columns) {
 				return dto.TableChanges{}, fmt.Errorf("column index out of range")
 			}
 			column := schema.Columns[colIndex]
+			if column.Generated {
+				return dto.TableChanges{}, fmt.Errorf("%w: %s", ErrReadOnlyGenerated, column.Name)
+			}
 			updateChanges = append(updateChanges, dto.ColumnValue{Column: column.Name, Value: change.Value})
+			if original, ok := edits.Originals[colIndex]; ok {
+				originals = append(originals, dto.ColumnValue{Column: column.Name, Value: original})
+			}
 		}
 		changes.Updates = append(changes.Updates, dto.RecordUpdate{
-			Identity: edits.Identity,
-			Changes:  updateChanges,
+			Identity:  edits.Identity,
+			Changes:   updateChanges,
+			Originals: originals,
 		})
 	}
 
@@ -97,6 +105,9 @@ func primaryKeyColumns(columns []dto.SchemaColumn) []schemaPKColumn {
 func (uc *StagedChangesTranslator) buildInsertChange(columns []dto.SchemaColumn, row dto.PendingInsertRow) (dto.RecordInsert, error) {
 	insert := dto.RecordInsert{}
 	for colIndex, column := range columns {
+		if column.Generated {
+			continue
+		}
 		value, ok := row.Values[colIndex]
 		if !ok {
 			continue
@@ -314,3 +314,66 @@ func TestStagedChangesTranslator_BuildTableChanges_ExcludesImplicitAutoIncrement
 		t.Fatalf("expected no explicit auto values, got %#v", insert.ExplicitAutoValues)
 	}
 }
+
+func TestStagedChangesTranslator_BuildTableChanges_ExcludesGeneratedColumnsFromInserts(t *testing.T) {
+	// Arrange
+	translator := usecase.NewStagedChangesTranslator()
+	schema := dto.Schema{
+		Columns: []dto.SchemaColumn{
+			{Name: "qty", Type: "INTEGER", Nullable: false},
+			{Name: "double_qty", Type: "INTEGER", Nullable: false, Generated: true},
+		},
+	}
+
+	// Act
+	changes, err := translator.BuildTableChanges(
+		schema,
+		[]dto.PendingInsertRow{
+			{
+				Values: map[int]dto.StagedEdit{
+					0: {Value: dto.StagedValue{Text: "2", Raw: int64(2)}},
+					1: {Value: dto.StagedValue{}},
+				},
+			},
+		},
+		nil,
+		nil,
+	)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected generated column to skip required-value validation, got %v", err)
+	}
+	if len(changes.Inserts) != 1 || len(changes.Inserts[0].Values) != 1 || changes.Inserts[0].Values[0].Column != "qty" {
+		t.Fatalf("expected only qty in insert values, got %#v", changes.Inserts)
+	}
+}
+
+func TestStagedChangesTranslator_BuildTableChanges_RefusesGeneratedColumnUpdates(t *testing.T) {
+	// Arrange
+	translator := usecase.NewStagedChangesTranslator()
+	schema := dto.Schema{
+		Columns: []dto.SchemaColumn{
+			{Name: "id", Type: "INTEGER", PrimaryKey: true},
+			{Name: "double_id", Type: "INTEGER", Generated: true},
+		},
+	}
+
+	// Act
+	_, err := translator.BuildTableChanges(
+		schema,
+		nil,
+		map[string]dto.PendingRecordEdits{
+			"id=1": {
+				Identity: dto.RecordIdentity{Keys: []dto.RecordIdentityKey{{Column: "id", Value: dto.StagedValue{Text: "1", Raw: int64(1)}}}},
+				Changes:  map[int]dto.StagedEdit{1: {Value: dto.StagedValue{Text: "5", Raw: int64(5)}}},
+			},
+		},
+		nil,
+	)
+
+	// Assert
+	if !errors.Is(err, usecase.ErrReadOnlyGenerated) {
+		t.Fatalf("expected generated column error, got %v", err)
+	}
+}
@@ -1,6 +1,25 @@
 package usecase
 
-import "github.com/mgierok/dbc/internal/application/dto"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+)
+
+var (
+	ErrReadOnlyView      = errors.New("view is read-only")
+	ErrReadOnlyGenerated = errors.New("generated column is read-only")
+	ErrReadOnlySession   = errors.New("session is read-only")
+)
+
+type TableWriteOperation string
+
+const (
+	TableWriteInsert TableWriteOperation = "INSERT"
+	TableWriteUpdate TableWriteOperation = "UPDATE"
+	TableWriteDelete TableWriteOperation = "DELETE"
+)
 
 type StagingPolicy struct{}
 
@@ -9,6 +28,9 @@ func NewStagingPolicy() *StagingPolicy {
 }
 
 func (p *StagingPolicy) InitialInsertValue(column dto.SchemaColumn) dto.StagedValue {
+	if column.Generated {
+		return dto.StagedValue{}
+	}
 	if column.DefaultValue != nil {
 		return dto.StagedValue{Text: *column.DefaultValue, Raw: *column.DefaultValue}
 	}
@@ -18,6 +40,17 @@ func (p *StagingPolicy) InitialInsertValue(column dto.SchemaColumn) dto.StagedVa
 	return dto.StagedValue{Text: "", Raw: ""}
 }
 
+// DuplicateInsertValue returns the value a duplicated row starts with in
+// column and whether it was copied from source. Primary-key, auto-increment
+// and generated columns start from their insert default instead, so the copy
+// gets its own key.
+func (p *StagingPolicy) DuplicateInsertValue(column dto.SchemaColumn, source dto.StagedValue) (dto.StagedValue, bool) {
+	if column.PrimaryKey || column.AutoIncrement || column.Generated {
+		return p.InitialInsertValue(column), false
+	}
+	return source, true
+}
+
 func (p *StagingPolicy) DirtyEditCount(
 	pendingInserts []dto.PendingInsertRow,
 	pendingUpdates map[string]dto.PendingRecordEdits,
@@ -32,3 +65,34 @@ func (p *StagingPolicy) DirtyEditCount(
 	}
 	return count
 }
+
+// EnsureWritable refuses staging operation on a view unless the view defines a
+// matching INSTEAD OF trigger. Tables, including those of unknown kind, are
+// always writable.
+func (p *StagingPolicy) EnsureWritable(table dto.Table, operation TableWriteOperation) error {
+	if table.Kind != dto.TableKindView {
+		return nil
+	}
+	allowed := false
+	switch operation {
+	case TableWriteInsert:
+		allowed = table.InsteadOf.Insert
+	case TableWriteUpdate:
+		allowed = table.InsteadOf.Update
+	case TableWriteDelete:
+		allowed = table.InsteadOf.Delete
+	}
+	if allowed {
+		return nil
+	}
+	return fmt.Errorf("%w: no INSTEAD OF %s trigger on %s", ErrReadOnlyView, operation, table.Name)
+}
+
+// EnsureColumnWritable refuses staging a value for a generated column, whose
+// value the database always computes.
+func (p *StagingPolicy) EnsureColumnWritable(column dto.SchemaColumn) error {
+	if !column.Generated {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrReadOnlyGenerated, column.Name)
+}
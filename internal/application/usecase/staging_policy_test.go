@@ -1,6 +1,7 @@
 package usecase_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/mgierok/dbc/internal/application/dto"
@@ -70,6 +71,41 @@ func TestStagingPolicy_InitialInsertValue_UsesEmptyValueForRequiredColumnsWithou
 	}
 }
 
+func TestStagingPolicy_DuplicateInsertValue_ResetsKeyColumnsAndCopiesOthers(t *testing.T) {
+	// Arrange
+	policy := usecase.NewStagingPolicy()
+	source := dto.StagedValue{Text: "42", Raw: int64(42)}
+	testCases := []struct {
+		name   string
+		column dto.SchemaColumn
+		copied bool
+	}{
+		{name: "primary key", column: dto.SchemaColumn{Name: "code", Type: "TEXT", PrimaryKey: true}},
+		{name: "auto increment", column: dto.SchemaColumn{Name: "seq", Type: "INTEGER", AutoIncrement: true, Nullable: true}},
+		{name: "generated", column: dto.SchemaColumn{Name: "total", Type: "INTEGER", Generated: true}},
+		{name: "unique", column: dto.SchemaColumn{Name: "badge", Type: "INTEGER", Unique: true}, copied: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			value, copied := policy.DuplicateInsertValue(tc.column, source)
+
+			// Assert
+			if copied != tc.copied {
+				t.Fatalf("expected copied=%v, got %v", tc.copied, copied)
+			}
+			expected := policy.InitialInsertValue(tc.column)
+			if copied {
+				expected = source
+			}
+			if value != expected {
+				t.Fatalf("expected %+v, got %+v", expected, value)
+			}
+		})
+	}
+}
+
 func TestStagingPolicy_DirtyEditCount_CountsOneRowForMultipleEditedColumns(t *testing.T) {
 	// Arrange
 	policy := usecase.NewStagingPolicy()
@@ -156,3 +192,54 @@ func TestStagingPolicy_DirtyEditCount_ReturnsZeroWhenRemovedInsertNoLongerExists
 		t.Fatalf("expected dirty count 0, got %d", count)
 	}
 }
+
+func TestStagingPolicy_EnsureWritable_RefusesPlainViewsAndAllowsTriggeredOperations(t *testing.T) {
+	// Arrange
+	policy := usecase.NewStagingPolicy()
+	table := dto.Table{Name: "users"}
+	plainView := dto.Table{Name: "user_names", Kind: dto.TableKindView}
+	triggeredView := dto.Table{Name: "editable_users", Kind: dto.TableKindView, InsteadOf: dto.TableWriteTriggers{Insert: true}}
+
+	// Act
+	tableErr := policy.EnsureWritable(table, usecase.TableWriteDelete)
+	plainErr := policy.EnsureWritable(plainView, usecase.TableWriteUpdate)
+	insertErr := policy.EnsureWritable(triggeredView, usecase.TableWriteInsert)
+	deleteErr := policy.EnsureWritable(triggeredView, usecase.TableWriteDelete)
+
+	// Assert
+	if tableErr != nil {
+		t.Fatalf("expected tables to be writable, got %v", tableErr)
+	}
+	if !errors.Is(plainErr, usecase.ErrReadOnlyView) {
+		t.Fatalf("expected error %v for plain view, got %v", usecase.ErrReadOnlyView, plainErr)
+	}
+	if plainErr.Error() != "view is read-only: no INSTEAD OF UPDATE trigger on user_names" {
+		t.Fatalf("unexpected plain view error message %q", plainErr.Error())
+	}
+	if insertErr != nil {
+		t.Fatalf("expected INSTEAD OF INSERT to allow inserts, got %v", insertErr)
+	}
+	if !errors.Is(deleteErr, usecase.ErrReadOnlyView) {
+		t.Fatalf("expected error %v for view without delete trigger, got %v", usecase.ErrReadOnlyView, deleteErr)
+	}
+}
+
+func TestStagingPolicy_EnsureColumnWritable_RefusesGeneratedColumns(t *testing.T) {
+	// Arrange
+	policy := usecase.NewStagingPolicy()
+
+	// Act
+	plainErr := policy.EnsureColumnWritable(dto.SchemaColumn{Name: "qty"})
+	generatedErr := policy.EnsureColumnWritable(dto.SchemaColumn{Name: "total", Generated: true})
+
+	// Assert
+	if plainErr != nil {
+		t.Fatalf("expected ordinary column to be writable, got %v", plainErr)
+	}
+	if !errors.Is(generatedErr, usecase.ErrReadOnlyGenerated) {
+		t.Fatalf("expected generated column error, got %v", generatedErr)
+	}
+	if generatedErr.Error() != "generated column is read-only: total" {
+		t.Fatalf("unexpected error message %q", generatedErr.Error())
+	}
+}
@@ -15,6 +15,7 @@ const (
 	opInsertRemoved
 	opCellEdited
 	opDeleteToggled
+	opBatch
 )
 
 type cellEditTarget int
@@ -36,6 +37,7 @@ type cellEditOperation struct {
 	recordKey          string
 	identity           dto.RecordIdentity
 	columnIndex        int
+	original           dto.StagedValue
 	before             dto.StagedEdit
 	beforeExists       bool
 	after              dto.StagedEdit
@@ -51,11 +53,14 @@ type deleteToggleOperation struct {
 	afterMarked  bool
 }
 
+// stagedOperation is one undoable step. A batch applies its operations in
+// order and undoes them in reverse, so a bulk change is a single step.
 type stagedOperation struct {
 	kind   stagingOperationKind
 	insert insertOperation
 	cell   cellEditOperation
 	del    deleteToggleOperation
+	batch  []stagedOperation
 }
 
 type StagingSession struct {
@@ -123,8 +128,6 @@ func (s *StagingSession) AddInsert(schema dto.Schema) (dto.InsertDraftID, error)
 	if s == nil {
 		return "", fmt.Errorf("staging session unavailable")
 	}
-	id := dto.InsertDraftID(fmt.Sprintf("insert-%d", s.nextInsert))
-	s.nextInsert++
 	row := dto.PendingInsertRow{
 		Values:       make(map[int]dto.StagedEdit, len(schema.Columns)),
 		ExplicitAuto: make(map[int]bool),
@@ -132,6 +135,33 @@ func (s *StagingSession) AddInsert(schema dto.Schema) (dto.InsertDraftID, error)
 	for index, column := range schema.Columns {
 		row.Values[index] = dto.StagedEdit{Value: s.policy.InitialInsertValue(column)}
 	}
+	return s.addInsertRow(row)
+}
+
+// DuplicateInsert stages a pending insert on top of the existing ones that
+// starts from values, the effective values of another row by column index.
+// Columns the policy does not copy start from their insert default.
+func (s *StagingSession) DuplicateInsert(schema dto.Schema, values map[int]dto.StagedValue) (dto.InsertDraftID, error) {
+	if s == nil {
+		return "", fmt.Errorf("staging session unavailable")
+	}
+	row := dto.PendingInsertRow{
+		Values:       make(map[int]dto.StagedEdit, len(schema.Columns)),
+		ExplicitAuto: make(map[int]bool),
+	}
+	for index, column := range schema.Columns {
+		value := s.policy.InitialInsertValue(column)
+		if source, ok := values[index]; ok {
+			value, _ = s.policy.DuplicateInsertValue(column, source)
+		}
+		row.Values[index] = dto.StagedEdit{Value: value}
+	}
+	return s.addInsertRow(row)
+}
+
+func (s *StagingSession) addInsertRow(row dto.PendingInsertRow) (dto.InsertDraftID, error) {
+	id := dto.InsertDraftID(fmt.Sprintf("insert-%d", s.nextInsert))
+	s.nextInsert++
 	if err := s.insertPendingRowAt(0, id, row); err != nil {
 		return "", err
 	}
@@ -146,39 +176,88 @@ func (s *StagingSession) AddInsert(schema dto.Schema) (dto.InsertDraftID, error)
 	return id, nil
 }
 
+// AddInserts stages rows as pending inserts on top of the existing ones, in
+// the order given. Undo removes all of them at once; if one row fails, the
+// rows already staged are removed again.
+func (s *StagingSession) AddInserts(rows []dto.PendingInsertRow) ([]dto.InsertDraftID, error) {
+	if s == nil {
+		return nil, fmt.Errorf("staging session unavailable")
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	ids := make([]dto.InsertDraftID, 0, len(rows))
+	batch := make([]stagedOperation, 0, len(rows))
+	for index, row := range rows {
+		id := dto.InsertDraftID(fmt.Sprintf("insert-%d", s.nextInsert))
+		s.nextInsert++
+		if err := s.insertPendingRowAt(index, id, row); err != nil {
+			return nil, s.rollbackBatch(batch, err)
+		}
+		ids = append(ids, id)
+		batch = append(batch, stagedOperation{
+			kind: opInsertAdded,
+			insert: insertOperation{
+				index: index,
+				id:    id,
+				row:   clonePendingInsertRow(row),
+			},
+		})
+	}
+	s.recordBatch(batch)
+	return ids, nil
+}
+
 func (s *StagingSession) RemoveInsert(insertID dto.InsertDraftID) error {
 	if s == nil {
 		return fmt.Errorf("staging session unavailable")
 	}
+	op, err := s.removeInsert(insertID)
+	if err != nil {
+		return err
+	}
+	s.recordOperation(op)
+	return nil
+}
+
+func (s *StagingSession) removeInsert(insertID dto.InsertDraftID) (stagedOperation, error) {
 	index := s.indexOfInsert(insertID)
 	if index < 0 {
-		return fmt.Errorf("insert draft not found")
+		return stagedOperation{}, fmt.Errorf("insert draft not found")
 	}
 	removedID, removed, err := s.removePendingInsertAt(index)
 	if err != nil {
-		return err
+		return stagedOperation{}, err
 	}
-	s.recordOperation(stagedOperation{
+	return stagedOperation{
 		kind: opInsertRemoved,
 		insert: insertOperation{
 			index: index,
 			id:    removedID,
 			row:   removed,
 		},
-	})
-	return nil
+	}, nil
 }
 
 func (s *StagingSession) StageInsertEdit(insertID dto.InsertDraftID, columnIndex int, value dto.StagedValue) error {
 	if s == nil {
 		return fmt.Errorf("staging session unavailable")
 	}
+	op, changed, err := s.stageInsertEdit(insertID, columnIndex, value)
+	if err != nil || !changed {
+		return err
+	}
+	s.recordOperation(op)
+	return nil
+}
+
+func (s *StagingSession) stageInsertEdit(insertID dto.InsertDraftID, columnIndex int, value dto.StagedValue) (stagedOperation, bool, error) {
 	if columnIndex < 0 {
-		return fmt.Errorf("column index out of range")
+		return stagedOperation{}, false, fmt.Errorf("column index out of range")
 	}
 	row, ok := s.inserts[insertID]
 	if !ok {
-		return fmt.Errorf("insert draft not found")
+		return stagedOperation{}, false, fmt.Errorf("insert draft not found")
 	}
 	if row.Values == nil {
 		row.Values = make(map[int]dto.StagedEdit)
@@ -195,10 +274,7 @@ func (s *StagingSession) StageInsertEdit(insertID dto.InsertDraftID, columnIndex
 	s.inserts[insertID] = row
 
 	changed := !beforeExists || !stagedEditEqual(before, after) || beforeExplicitAuto != afterExplicitAuto
-	if !changed {
-		return nil
-	}
-	s.recordOperation(stagedOperation{
+	return stagedOperation{
 		kind: opCellEdited,
 		cell: cellEditOperation{
 			target:             cellEditInsert,
@@ -211,25 +287,39 @@ func (s *StagingSession) StageInsertEdit(insertID dto.InsertDraftID, columnIndex
 			beforeExplicitAuto: beforeExplicitAuto,
 			afterExplicitAuto:  afterExplicitAuto,
 		},
-	})
-	return nil
+	}, changed, nil
 }
 
 func (s *StagingSession) StagePersistedEdit(
 	recordKey string,
 	identity dto.RecordIdentity,
 	columnIndex int,
-	originalDisplayValue string,
+	original dto.StagedValue,
 	value dto.StagedValue,
 ) error {
 	if s == nil {
 		return fmt.Errorf("staging session unavailable")
 	}
+	op, changed, err := s.stagePersistedEdit(recordKey, identity, columnIndex, original, value)
+	if err != nil || !changed {
+		return err
+	}
+	s.recordOperation(op)
+	return nil
+}
+
+func (s *StagingSession) stagePersistedEdit(
+	recordKey string,
+	identity dto.RecordIdentity,
+	columnIndex int,
+	loaded dto.StagedValue,
+	value dto.StagedValue,
+) (stagedOperation, bool, error) {
 	if strings.TrimSpace(recordKey) == "" {
-		return fmt.Errorf("record key missing")
+		return stagedOperation{}, false, fmt.Errorf("record key missing")
 	}
 	if columnIndex < 0 {
-		return fmt.Errorf("column index out of range")
+		return stagedOperation{}, false, fmt.Errorf("column index out of range")
 	}
 	if s.updates == nil {
 		s.updates = make(map[string]dto.PendingRecordEdits)
@@ -238,12 +328,20 @@ func (s *StagingSession) StagePersistedEdit(
 	if edits.Changes == nil {
 		edits.Changes = make(map[int]dto.StagedEdit)
 	}
+	if edits.Originals == nil {
+		edits.Originals = make(map[int]dto.StagedValue)
+	}
 	edits.Identity = identity
 	before, beforeExists := edits.Changes[columnIndex]
 	after, afterExists := dto.StagedEdit{}, false
+	original, originalExists := edits.Originals[columnIndex]
+	if !originalExists {
+		original = loaded
+	}
 
-	if stagedDisplayValue(value) == originalDisplayValue {
+	if stagedValueMatchesLoaded(value, loaded) {
 		delete(edits.Changes, columnIndex)
+		delete(edits.Originals, columnIndex)
 		if len(edits.Changes) == 0 {
 			delete(s.updates, recordKey)
 		} else {
@@ -253,27 +351,25 @@ func (s *StagingSession) StagePersistedEdit(
 		after = dto.StagedEdit{Value: value}
 		afterExists = true
 		edits.Changes[columnIndex] = after
+		edits.Originals[columnIndex] = original
 		s.updates[recordKey] = edits
 	}
 
 	changed := beforeExists != afterExists || (beforeExists && afterExists && !stagedEditEqual(before, after))
-	if !changed {
-		return nil
-	}
-	s.recordOperation(stagedOperation{
+	return stagedOperation{
 		kind: opCellEdited,
 		cell: cellEditOperation{
 			target:       cellEditPersisted,
 			recordKey:    recordKey,
 			identity:     identity,
 			columnIndex:  columnIndex,
+			original:     original,
 			before:       before,
 			beforeExists: beforeExists,
 			after:        after,
 			afterExists:  afterExists,
 		},
-	})
-	return nil
+	}, changed, nil
 }
 
 func (s *StagingSession) SetDeleteMark(recordKey string, identity dto.RecordIdentity, marked bool) error {
@@ -283,11 +379,20 @@ func (s *StagingSession) SetDeleteMark(recordKey string, identity dto.RecordIden
 	if strings.TrimSpace(recordKey) == "" {
 		return fmt.Errorf("record key missing")
 	}
+	op, err := s.stageDeleteMark(recordKey, identity, marked)
+	if err != nil {
+		return err
+	}
+	s.recordOperation(op)
+	return nil
+}
+
+func (s *StagingSession) stageDeleteMark(recordKey string, identity dto.RecordIdentity, marked bool) (stagedOperation, error) {
 	_, exists := s.deletes[recordKey]
 	if err := s.setDeleteMark(recordKey, identity, marked); err != nil {
-		return err
+		return stagedOperation{}, err
 	}
-	s.recordOperation(stagedOperation{
+	return stagedOperation{
 		kind: opDeleteToggled,
 		del: deleteToggleOperation{
 			key:          recordKey,
@@ -295,7 +400,65 @@ func (s *StagingSession) SetDeleteMark(recordKey string, identity dto.RecordIden
 			beforeMarked: exists,
 			afterMarked:  marked,
 		},
-	})
+	}, nil
+}
+
+// StageBulkDelete removes the pending inserts insertIDs and sets the delete
+// mark of every record in records to marked. Undo restores all of them at
+// once; if one row fails, the rows already changed are restored.
+func (s *StagingSession) StageBulkDelete(insertIDs []dto.InsertDraftID, records []dto.PersistedRecordRef, marked bool) error {
+	if s == nil {
+		return fmt.Errorf("staging session unavailable")
+	}
+	batch := make([]stagedOperation, 0, len(insertIDs)+len(records))
+	for _, insertID := range insertIDs {
+		op, err := s.removeInsert(insertID)
+		if err != nil {
+			return s.rollbackBatch(batch, err)
+		}
+		batch = append(batch, op)
+	}
+	for _, record := range records {
+		if strings.TrimSpace(record.RowKey) == "" {
+			return s.rollbackBatch(batch, fmt.Errorf("record key missing"))
+		}
+		op, err := s.stageDeleteMark(record.RowKey, record.Identity, marked)
+		if err != nil {
+			return s.rollbackBatch(batch, err)
+		}
+		batch = append(batch, op)
+	}
+	s.recordBatch(batch)
+	return nil
+}
+
+// StageBulkEdit sets columnIndex to value in every row of targets as one
+// undoable step. Rows that already hold value are left out of the step; if
+// one row fails, the rows already changed are restored.
+func (s *StagingSession) StageBulkEdit(targets []dto.BulkEditTarget, columnIndex int, value dto.StagedValue) error {
+	if s == nil {
+		return fmt.Errorf("staging session unavailable")
+	}
+	batch := make([]stagedOperation, 0, len(targets))
+	for _, target := range targets {
+		var (
+			op      stagedOperation
+			changed bool
+			err     error
+		)
+		if target.InsertID != "" {
+			op, changed, err = s.stageInsertEdit(target.InsertID, columnIndex, value)
+		} else {
+			op, changed, err = s.stagePersistedEdit(target.Record.RowKey, target.Record.Identity, columnIndex, target.Original, value)
+		}
+		if err != nil {
+			return s.rollbackBatch(batch, err)
+		}
+		if changed {
+			batch = append(batch, op)
+		}
+	}
+	s.recordBatch(batch)
 	return nil
 }
 
@@ -356,6 +519,57 @@ func (s *StagingSession) HasDirtyEdits() bool {
 	return s.DirtyEditCount() > 0
 }
 
+// RebaseConflicts moves staged changes onto the current state of records a
+// save reported as conflicting. Edits and delete marks of removed records are
+// dropped; the originals of changed columns become their current values, so
+// the next save overwrites them. The undo history is cleared, since it refers
+// to the replaced originals. It returns the number of rebased records.
+func (s *StagingSession) RebaseConflicts(schema dto.Schema, conflicts []dto.RecordConflict) int {
+	if s == nil {
+		return 0
+	}
+	columnIndexes := make(map[string]int, len(schema.Columns))
+	for index, column := range schema.Columns {
+		columnIndexes[column.Name] = index
+	}
+	rebased := 0
+	for _, conflict := range conflicts {
+		matched := false
+		for key, edits := range s.updates {
+			if !recordIdentityEqual(edits.Identity, conflict.Identity) {
+				continue
+			}
+			matched = true
+			if conflict.Missing {
+				delete(s.updates, key)
+				continue
+			}
+			for _, column := range conflict.Columns {
+				index, ok := columnIndexes[column.Column]
+				if !ok {
+					continue
+				}
+				if _, staged := edits.Changes[index]; staged {
+					edits.Originals[index] = column.Current
+				}
+			}
+			s.updates[key] = edits
+		}
+		for key, deleteChange := range s.deletes {
+			if conflict.Missing && recordIdentityEqual(deleteChange.Identity, conflict.Identity) {
+				matched = true
+				delete(s.deletes, key)
+			}
+		}
+		if matched {
+			rebased++
+		}
+	}
+	s.history = nil
+	s.future = nil
+	return rebased
+}
+
 func (s *StagingSession) applyOperation(op stagedOperation) error {
 	switch op.kind {
 	case opInsertAdded:
@@ -367,6 +581,13 @@ func (s *StagingSession) applyOperation(op stagedOperation) error {
 		return s.applyCellEditState(op.cell, false)
 	case opDeleteToggled:
 		return s.setDeleteMark(op.del.key, op.del.identity, op.del.afterMarked)
+	case opBatch:
+		for _, batched := range op.batch {
+			if err := s.applyOperation(batched); err != nil {
+				return err
+			}
+		}
+		return nil
 	default:
 		return fmt.Errorf("unsupported staged operation")
 	}
@@ -383,6 +604,13 @@ func (s *StagingSession) applyInverseOperation(op stagedOperation) error {
 		return s.applyCellEditState(op.cell, true)
 	case opDeleteToggled:
 		return s.setDeleteMark(op.del.key, op.del.identity, op.del.beforeMarked)
+	case opBatch:
+		for i := len(op.batch) - 1; i >= 0; i-- {
+			if err := s.applyInverseOperation(op.batch[i]); err != nil {
+				return err
+			}
+		}
+		return nil
 	default:
 		return fmt.Errorf("unsupported staged operation")
 	}
@@ -432,13 +660,18 @@ func (s *StagingSession) applyCellEditState(op cellEditOperation, useBefore bool
 		if edits.Changes == nil {
 			edits.Changes = make(map[int]dto.StagedEdit)
 		}
+		if edits.Originals == nil {
+			edits.Originals = make(map[int]dto.StagedValue)
+		}
 		edits.Identity = op.identity
 		if exists {
 			edits.Changes[op.columnIndex] = edit
+			edits.Originals[op.columnIndex] = op.original
 			s.updates[op.recordKey] = edits
 			return nil
 		}
 		delete(edits.Changes, op.columnIndex)
+		delete(edits.Originals, op.columnIndex)
 		if len(edits.Changes) == 0 {
 			delete(s.updates, op.recordKey)
 			return nil
@@ -470,6 +703,18 @@ func (s *StagingSession) recordOperation(op stagedOperation) {
 	s.future = nil
 }
 
+func (s *StagingSession) recordBatch(batch []stagedOperation) {
+	if len(batch) == 0 {
+		return
+	}
+	s.recordOperation(stagedOperation{kind: opBatch, batch: batch})
+}
+
+func (s *StagingSession) rollbackBatch(batch []stagedOperation, err error) error {
+	_ = s.applyInverseOperation(stagedOperation{kind: opBatch, batch: batch})
+	return err
+}
+
 func (s *StagingSession) pendingInsertRows() []dto.PendingInsertRow {
 	rows := make([]dto.PendingInsertRow, 0, len(s.insertOrder))
 	for _, id := range s.insertOrder {
@@ -535,8 +780,9 @@ func clonePendingRecordEdits(source map[string]dto.PendingRecordEdits) map[strin
 	cloned := make(map[string]dto.PendingRecordEdits, len(source))
 	for key, edits := range source {
 		cloned[key] = dto.PendingRecordEdits{
-			Identity: edits.Identity,
-			Changes:  cloneStagedEdits(edits.Changes),
+			Identity:  edits.Identity,
+			Changes:   cloneStagedEdits(edits.Changes),
+			Originals: cloneStagedValues(edits.Originals),
 		}
 	}
 	return cloned
@@ -564,6 +810,17 @@ func cloneStagedEdits(source map[int]dto.StagedEdit) map[int]dto.StagedEdit {
 	return cloned
 }
 
+func cloneStagedValues(source map[int]dto.StagedValue) map[int]dto.StagedValue {
+	if len(source) == 0 {
+		return nil
+	}
+	cloned := make(map[int]dto.StagedValue, len(source))
+	for key, value := range source {
+		cloned[key] = value
+	}
+	return cloned
+}
+
 func cloneExplicitAuto(source map[int]bool) map[int]bool {
 	if len(source) == 0 {
 		return nil
@@ -575,6 +832,42 @@ func cloneExplicitAuto(source map[int]bool) map[int]bool {
 	return cloned
 }
 
+// PersistedOriginalValue returns the value column columnIndex of a loaded row
+// held: NULL only where the row marks the cell as NULL, otherwise its text.
+func PersistedOriginalValue(row dto.RecordRow, columnIndex int) dto.StagedValue {
+	if columnIndex < 0 || columnIndex >= len(row.Values) {
+		return dto.StagedValue{}
+	}
+	if columnIndex < len(row.Nulls) && row.Nulls[columnIndex] {
+		return dto.StagedValue{IsNull: true}
+	}
+	return dto.StagedValue{Text: row.Values[columnIndex]}
+}
+
+// stagedValueMatchesLoaded reports whether value puts a cell back to the
+// value it was loaded with.
+func stagedValueMatchesLoaded(value, loaded dto.StagedValue) bool {
+	if value.IsNull || loaded.IsNull {
+		return value.IsNull == loaded.IsNull
+	}
+	return stagedDisplayValue(value) == loaded.Text
+}
+
+func recordIdentityEqual(left, right dto.RecordIdentity) bool {
+	if len(left.Keys) != len(right.Keys) {
+		return false
+	}
+	for i := range left.Keys {
+		if left.Keys[i].Column != right.Keys[i].Column {
+			return false
+		}
+		if !stagedEditEqual(dto.StagedEdit{Value: left.Keys[i].Value}, dto.StagedEdit{Value: right.Keys[i].Value}) {
+			return false
+		}
+	}
+	return true
+}
+
 func stagedEditEqual(left, right dto.StagedEdit) bool {
 	if left.Value.IsNull != right.Value.IsNull || left.Value.Text != right.Value.Text {
 		return false
@@ -1,6 +1,8 @@
 package usecase_test
 
 import (
+	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/mgierok/dbc/internal/application/dto"
@@ -58,7 +60,7 @@ func TestStagingSession_StagePersistedEdit_RemovesEditWhenValueReturnsToOriginal
 		"id=1",
 		identity,
 		1,
-		"alice",
+		dto.StagedValue{Text: "alice"},
 		dto.StagedValue{Text: "alice", Raw: "alice"},
 	)
 
@@ -81,7 +83,7 @@ func TestStagingSession_DirtyEditCount_DeduplicatesUpdatedThenDeletedPersistedRo
 		"id=1",
 		identity,
 		1,
-		"alice",
+		dto.StagedValue{Text: "alice"},
 		dto.StagedValue{Text: "bob", Raw: "bob"},
 	); err != nil {
 		t.Fatalf("expected edit to stage, got %v", err)
@@ -122,7 +124,7 @@ func TestStagingSession_UndoRedo_PreservesInsertEditAndDeleteSemantics(t *testin
 		"id=1",
 		identity,
 		1,
-		"alice",
+		dto.StagedValue{Text: "alice"},
 		dto.StagedValue{Text: "bob", Raw: "bob"},
 	); err != nil {
 		t.Fatalf("expected persisted edit, got %v", err)
@@ -174,6 +176,198 @@ func TestStagingSession_UndoRedo_PreservesInsertEditAndDeleteSemantics(t *testin
 	}
 }
 
+func TestStagingSession_AddInserts_StagesRowsInOrderAsSingleUndoStep(t *testing.T) {
+	// Arrange
+	session := usecase.NewStagingSession(nil, nil)
+	schema := dto.Schema{Columns: []dto.SchemaColumn{{Name: "name", Type: "TEXT"}}}
+	existingID, err := session.AddInsert(schema)
+	if err != nil {
+		t.Fatalf("expected insert add, got %v", err)
+	}
+	rows := []dto.PendingInsertRow{
+		{Values: map[int]dto.StagedEdit{0: {Value: dto.StagedValue{Text: "alice", Raw: "alice"}}}},
+		{Values: map[int]dto.StagedEdit{0: {Value: dto.StagedValue{Text: "bob", Raw: "bob"}}}},
+	}
+
+	// Act
+	ids, err := session.AddInserts(rows)
+	if err != nil {
+		t.Fatalf("expected inserts add, got %v", err)
+	}
+	added := session.Snapshot()
+	if err := session.Undo(); err != nil {
+		t.Fatalf("expected undo to succeed, got %v", err)
+	}
+	undone := session.Snapshot()
+	if err := session.Redo(); err != nil {
+		t.Fatalf("expected redo to succeed, got %v", err)
+	}
+
+	// Assert
+	if len(ids) != 2 {
+		t.Fatalf("expected two insert IDs, got %v", ids)
+	}
+	if len(added.PendingInserts) != 3 {
+		t.Fatalf("expected three pending inserts, got %d", len(added.PendingInserts))
+	}
+	got := []string{
+		displayValueForTest(added.PendingInserts[0].Values[0].Value),
+		displayValueForTest(added.PendingInserts[1].Values[0].Value),
+	}
+	if !reflect.DeepEqual(got, []string{"alice", "bob"}) || added.PendingInserts[2].ID != existingID {
+		t.Fatalf("expected imported rows on top in order, got %+v", added.PendingInserts)
+	}
+	if len(undone.PendingInserts) != 1 || undone.PendingInserts[0].ID != existingID {
+		t.Fatalf("expected one undo to remove every added row, got %+v", undone.PendingInserts)
+	}
+	if !reflect.DeepEqual(session.Snapshot(), added) {
+		t.Fatalf("expected redo to restore added rows, got %+v", session.Snapshot())
+	}
+}
+
+func TestStagingSession_StageBulkDelete_MarksRecordsAndRemovesInsertsAsSingleUndoStep(t *testing.T) {
+	// Arrange
+	session := usecase.NewStagingSession(nil, nil)
+	schema := dto.Schema{Columns: []dto.SchemaColumn{{Name: "name", Type: "TEXT"}}}
+	insertID, err := session.AddInsert(schema)
+	if err != nil {
+		t.Fatalf("expected insert add, got %v", err)
+	}
+	before := session.Snapshot()
+	records := []dto.PersistedRecordRef{
+		{RowKey: "id=1", Identity: bulkIdentityForTest("1")},
+		{RowKey: "id=2", Identity: bulkIdentityForTest("2")},
+	}
+
+	// Act
+	if err := session.StageBulkDelete([]dto.InsertDraftID{insertID}, records, true); err != nil {
+		t.Fatalf("expected bulk delete, got %v", err)
+	}
+	staged := session.Snapshot()
+	if err := session.Undo(); err != nil {
+		t.Fatalf("expected undo to succeed, got %v", err)
+	}
+
+	// Assert
+	if len(staged.PendingInserts) != 0 || len(staged.PendingDeletes) != 2 {
+		t.Fatalf("expected insert removed and two delete marks, got %+v", staged)
+	}
+	if !reflect.DeepEqual(session.Snapshot(), before) {
+		t.Fatalf("expected one undo to restore every row, got %+v", session.Snapshot())
+	}
+}
+
+func TestStagingSession_StageBulkEdit_SetsColumnForEveryRowAsSingleUndoStep(t *testing.T) {
+	// Arrange
+	session := usecase.NewStagingSession(nil, nil)
+	schema := dto.Schema{Columns: []dto.SchemaColumn{{Name: "id", Type: "INTEGER"}, {Name: "status", Type: "TEXT"}}}
+	insertID, err := session.AddInsert(schema)
+	if err != nil {
+		t.Fatalf("expected insert add, got %v", err)
+	}
+	value := dto.StagedValue{Text: "archived", Raw: "archived"}
+	targets := []dto.BulkEditTarget{
+		{InsertID: insertID},
+		{Record: dto.PersistedRecordRef{RowKey: "id=1", Identity: bulkIdentityForTest("1")}, Original: dto.StagedValue{Text: "active"}},
+		{Record: dto.PersistedRecordRef{RowKey: "id=2", Identity: bulkIdentityForTest("2")}, Original: dto.StagedValue{Text: "archived"}},
+	}
+
+	// Act
+	if err := session.StageBulkEdit(targets, 1, value); err != nil {
+		t.Fatalf("expected bulk edit, got %v", err)
+	}
+	staged := session.Snapshot()
+	if err := session.Undo(); err != nil {
+		t.Fatalf("expected undo to succeed, got %v", err)
+	}
+	undone := session.Snapshot()
+
+	// Assert
+	if displayValueForTest(staged.PendingInserts[0].Values[1].Value) != "archived" {
+		t.Fatalf("expected insert to be edited, got %+v", staged.PendingInserts[0].Values)
+	}
+	if len(staged.PendingUpdates) != 1 || staged.PendingUpdates["id=1"].Originals[1].Text != "active" {
+		t.Fatalf("expected only the changed record to be staged, got %+v", staged.PendingUpdates)
+	}
+	if len(undone.PendingUpdates) != 0 || displayValueForTest(undone.PendingInserts[0].Values[1].Value) == "archived" {
+		t.Fatalf("expected one undo to revert every row, got %+v", undone)
+	}
+}
+
+func TestStagingSession_StageBulkEdit_RestoresRowsWhenTargetFails(t *testing.T) {
+	// Arrange
+	session := usecase.NewStagingSession(nil, nil)
+	targets := []dto.BulkEditTarget{
+		{Record: dto.PersistedRecordRef{RowKey: "id=1", Identity: bulkIdentityForTest("1")}, Original: dto.StagedValue{Text: "active"}},
+		{InsertID: "missing"},
+	}
+
+	// Act
+	err := session.StageBulkEdit(targets, 1, dto.StagedValue{Text: "archived", Raw: "archived"})
+
+	// Assert
+	if err == nil {
+		t.Fatal("expected error for missing insert")
+	}
+	if snapshot := session.Snapshot(); len(snapshot.PendingUpdates) != 0 {
+		t.Fatalf("expected changed rows to be restored, got %+v", snapshot.PendingUpdates)
+	}
+	if err := session.Undo(); err != nil || session.DirtyEditCount() != 0 {
+		t.Fatalf("expected no history entry for failed bulk edit, got %v", err)
+	}
+}
+
+func bulkIdentityForTest(id string) dto.RecordIdentity {
+	return dto.RecordIdentity{Keys: []dto.RecordIdentityKey{{Column: "id", Value: dto.StagedValue{Text: id, Raw: id}}}}
+}
+
+func TestStagingSession_DuplicateInsert_CopiesValuesAndResetsKeys(t *testing.T) {
+	// Arrange
+	session := usecase.NewStagingSession(nil, nil)
+	schema := dto.Schema{
+		Columns: []dto.SchemaColumn{
+			{Name: "id", Type: "INTEGER", PrimaryKey: true, AutoIncrement: true, Nullable: true},
+			{Name: "email", Type: "TEXT", Unique: true},
+			{Name: "name", Type: "TEXT"},
+			{Name: "upper_name", Type: "TEXT", Generated: true},
+		},
+	}
+	source := map[int]dto.StagedValue{
+		0: {Text: "7", Raw: int64(7)},
+		1: {Text: "ann@example.com", Raw: "ann@example.com"},
+		2: {Text: "Ann", Raw: "Ann"},
+		3: {Text: "ANN", Raw: "ANN"},
+	}
+
+	// Act
+	insertID, err := session.DuplicateInsert(schema, source)
+	if err != nil {
+		t.Fatalf("expected duplicate insert, got %v", err)
+	}
+	added := session.Snapshot()
+	if err := session.Undo(); err != nil {
+		t.Fatalf("expected undo to succeed, got %v", err)
+	}
+
+	// Assert
+	if len(added.PendingInserts) != 1 || added.PendingInserts[0].ID != insertID {
+		t.Fatalf("expected one pending insert, got %+v", added.PendingInserts)
+	}
+	row := added.PendingInserts[0]
+	if !row.Values[0].Value.IsNull || row.ExplicitAuto[0] {
+		t.Fatalf("expected key to start from its insert default, got %+v", row.Values[0])
+	}
+	if displayValueForTest(row.Values[1].Value) != "ann@example.com" || displayValueForTest(row.Values[2].Value) != "Ann" {
+		t.Fatalf("expected copied values, got %+v", row.Values)
+	}
+	if displayValueForTest(row.Values[3].Value) != "" {
+		t.Fatalf("expected generated column not to be copied, got %+v", row.Values[3])
+	}
+	if len(session.Snapshot().PendingInserts) != 0 {
+		t.Fatal("expected undo to remove the duplicated row")
+	}
+}
+
 func TestStagingSession_BuildTableChanges_MatchesSavePayloadSemantics(t *testing.T) {
 	// Arrange
 	session := usecase.NewStagingSession(nil, nil)
@@ -200,7 +394,7 @@ func TestStagingSession_BuildTableChanges_MatchesSavePayloadSemantics(t *testing
 		"id=1",
 		identity,
 		1,
-		"alice",
+		dto.StagedValue{Text: "alice"},
 		dto.StagedValue{Text: "bob", Raw: "bob"},
 	); err != nil {
 		t.Fatalf("expected persisted edit, got %v", err)
@@ -233,6 +427,146 @@ func TestStagingSession_BuildTableChanges_MatchesSavePayloadSemantics(t *testing
 	}
 }
 
+func TestStagingSession_BuildTableChanges_CarriesOriginalValuesOfEditedColumns(t *testing.T) {
+	// Arrange
+	session := usecase.NewStagingSession(nil, nil)
+	schema := dto.Schema{
+		Columns: []dto.SchemaColumn{
+			{Name: "id", Type: "INTEGER", PrimaryKey: true},
+			{Name: "name", Type: "TEXT"},
+			{Name: "email", Type: "TEXT", Nullable: true},
+		},
+	}
+	identity := dto.RecordIdentity{
+		Keys: []dto.RecordIdentityKey{{Column: "id", Value: dto.StagedValue{Text: "1", Raw: int64(1)}}},
+	}
+	if err := session.StagePersistedEdit("id=1", identity, 2, dto.StagedValue{IsNull: true}, dto.StagedValue{Text: "a@example.com", Raw: "a@example.com"}); err != nil {
+		t.Fatalf("expected persisted edit, got %v", err)
+	}
+	if err := session.StagePersistedEdit("id=1", identity, 2, dto.StagedValue{Text: "a@example.com"}, dto.StagedValue{Text: "b@example.com", Raw: "b@example.com"}); err != nil {
+		t.Fatalf("expected second persisted edit, got %v", err)
+	}
+
+	// Act
+	changes, err := session.BuildTableChanges(schema)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(changes.Updates) != 1 {
+		t.Fatalf("expected one update, got %+v", changes.Updates)
+	}
+	expected := []dto.ColumnValue{{Column: "email", Value: dto.StagedValue{IsNull: true}}}
+	if !reflect.DeepEqual(changes.Updates[0].Originals, expected) {
+		t.Fatalf("expected originals %+v to survive repeated edits, got %+v", expected, changes.Updates[0].Originals)
+	}
+}
+
+func TestStagingSession_BuildTableChanges_RecordsNULLOriginalOnlyForNULLCells(t *testing.T) {
+	// Arrange
+	session := usecase.NewStagingSession(nil, nil)
+	schema := dto.Schema{
+		Columns: []dto.SchemaColumn{
+			{Name: "id", Type: "INTEGER", PrimaryKey: true},
+			{Name: "nickname", Type: "TEXT", Nullable: true},
+			{Name: "email", Type: "TEXT", Nullable: true},
+		},
+	}
+	row := dto.RecordRow{
+		Values: []string{"1", "NULL", "NULL"},
+		Nulls:  []bool{false, false, true},
+		RowKey: "id=1",
+		Identity: dto.RecordIdentity{
+			Keys: []dto.RecordIdentityKey{{Column: "id", Value: dto.StagedValue{Text: "1", Raw: int64(1)}}},
+		},
+	}
+	for _, columnIndex := range []int{1, 2} {
+		original := usecase.PersistedOriginalValue(row, columnIndex)
+		if err := session.StagePersistedEdit(row.RowKey, row.Identity, columnIndex, original, dto.StagedValue{Text: "set", Raw: "set"}); err != nil {
+			t.Fatalf("expected persisted edit of column %d, got %v", columnIndex, err)
+		}
+	}
+
+	// Act
+	changes, err := session.BuildTableChanges(schema)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(changes.Updates) != 1 {
+		t.Fatalf("expected one update, got %+v", changes.Updates)
+	}
+	expected := []dto.ColumnValue{
+		{Column: "nickname", Value: dto.StagedValue{Text: "NULL"}},
+		{Column: "email", Value: dto.StagedValue{IsNull: true}},
+	}
+	if !reflect.DeepEqual(changes.Updates[0].Originals, expected) {
+		t.Fatalf("expected originals %+v, got %+v", expected, changes.Updates[0].Originals)
+	}
+}
+
+func TestStagingSession_RebaseConflicts_AdoptsCurrentValuesAndDropsRemovedRecords(t *testing.T) {
+	// Arrange
+	session := usecase.NewStagingSession(nil, nil)
+	schema := dto.Schema{
+		Columns: []dto.SchemaColumn{
+			{Name: "id", Type: "INTEGER", PrimaryKey: true},
+			{Name: "name", Type: "TEXT"},
+		},
+	}
+	userID := func(id int64) dto.RecordIdentity {
+		return dto.RecordIdentity{
+			Keys: []dto.RecordIdentityKey{{Column: "id", Value: dto.StagedValue{Text: fmt.Sprint(id), Raw: id}}},
+		}
+	}
+	if err := session.StagePersistedEdit("id=1", userID(1), 1, dto.StagedValue{Text: "alison"}, dto.StagedValue{Text: "alicia", Raw: "alicia"}); err != nil {
+		t.Fatalf("expected persisted edit, got %v", err)
+	}
+	if err := session.StagePersistedEdit("id=2", userID(2), 1, dto.StagedValue{Text: "bob"}, dto.StagedValue{Text: "robert", Raw: "robert"}); err != nil {
+		t.Fatalf("expected persisted edit, got %v", err)
+	}
+	if err := session.SetDeleteMark("id=3", userID(3), true); err != nil {
+		t.Fatalf("expected delete mark, got %v", err)
+	}
+	conflicts := []dto.RecordConflict{
+		{
+			Identity: userID(1),
+			Columns: []dto.ColumnConflict{{
+				Column:   "name",
+				Original: dto.StagedValue{Text: "alison"},
+				Current:  dto.StagedValue{Text: "alice"},
+				Staged:   dto.StagedValue{Text: "alicia", Raw: "alicia"},
+			}},
+		},
+		{Identity: userID(2), Missing: true},
+		{Identity: userID(3), Missing: true},
+	}
+
+	// Act
+	rebased := session.RebaseConflicts(schema, conflicts)
+
+	// Assert
+	if rebased != 3 {
+		t.Fatalf("expected three rebased records, got %d", rebased)
+	}
+	snapshot := session.Snapshot()
+	if len(snapshot.PendingDeletes) != 0 {
+		t.Fatalf("expected delete of removed record to be dropped, got %+v", snapshot.PendingDeletes)
+	}
+	if _, ok := snapshot.PendingUpdates["id=2"]; ok {
+		t.Fatal("expected edit of removed record to be dropped")
+	}
+	edits := snapshot.PendingUpdates["id=1"]
+	if got := edits.Originals[1]; got != (dto.StagedValue{Text: "alice"}) {
+		t.Fatalf("expected original to become current value, got %+v", got)
+	}
+	if got := displayValueForTest(edits.Changes[1].Value); got != "alicia" {
+		t.Fatalf("expected staged value to be kept, got %q", got)
+	}
+}
+
 func TestStagingSession_Reset_ClearsStateHistoryAndFuture(t *testing.T) {
 	// Arrange
 	session := usecase.NewStagingSession(nil, nil)
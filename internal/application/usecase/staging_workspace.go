@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+)
+
+// StagingWorkspace keeps an independent StagingSession per table for the
+// whole runtime, so changes staged in several tables survive table switches
+// and can be saved together.
+type StagingWorkspace struct {
+	policy     *StagingPolicy
+	translator *StagedChangesTranslator
+	sessions   map[string]*StagingSession
+	schemas    map[string]dto.Schema
+}
+
+func NewStagingWorkspace(policy *StagingPolicy, translator *StagedChangesTranslator) *StagingWorkspace {
+	if policy == nil {
+		policy = NewStagingPolicy()
+	}
+	if translator == nil {
+		translator = NewStagedChangesTranslator()
+	}
+	return &StagingWorkspace{
+		policy:     policy,
+		translator: translator,
+	}
+}
+
+// Session returns the staging session of a table, creating it on first use.
+func (w *StagingWorkspace) Session(tableName string) *StagingSession {
+	if w.sessions == nil {
+		w.sessions = make(map[string]*StagingSession)
+	}
+	session, ok := w.sessions[tableName]
+	if !ok {
+		session = NewStagingSession(w.policy, w.translator)
+		w.sessions[tableName] = session
+	}
+	return session
+}
+
+// SetSchema records the schema staged changes of a table are translated with.
+func (w *StagingWorkspace) SetSchema(tableName string, schema dto.Schema) {
+	if w.schemas == nil {
+		w.schemas = make(map[string]dto.Schema)
+	}
+	w.schemas[tableName] = schema
+}
+
+// Reset drops every staged change together with the schemas recorded for
+// them, so a later save never translates against a stale schema.
+func (w *StagingWorkspace) Reset() {
+	w.sessions = nil
+	w.schemas = nil
+}
+
+// ForgetSchemas drops the recorded schemas but keeps the staged changes, for
+// when the database schema may have changed under them. A table's changes
+// translate again once its schema is recorded anew.
+func (w *StagingWorkspace) ForgetSchemas() {
+	w.schemas = nil
+}
+
+func (w *StagingWorkspace) DirtyEditCount() int {
+	total := 0
+	for _, session := range w.sessions {
+		total += session.DirtyEditCount()
+	}
+	return total
+}
+
+func (w *StagingWorkspace) HasDirtyEdits() bool {
+	return w.DirtyEditCount() > 0
+}
+
+// DirtyEditCountForTable returns the dirty row count of one table.
+func (w *StagingWorkspace) DirtyEditCountForTable(tableName string) int {
+	session, ok := w.sessions[tableName]
+	if !ok {
+		return 0
+	}
+	return session.DirtyEditCount()
+}
+
+// BuildDatabaseChanges translates the staged changes of every dirty table,
+// in table name order.
+func (w *StagingWorkspace) BuildDatabaseChanges() ([]dto.TableChangeSet, error) {
+	var changeSets []dto.TableChangeSet
+	for _, tableName := range w.dirtyTables() {
+		schema, ok := w.schemas[tableName]
+		if !ok {
+			return nil, fmt.Errorf("schema for table %q unavailable", tableName)
+		}
+		changes, err := w.sessions[tableName].BuildTableChanges(schema)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", tableName, err)
+		}
+		if len(changes.Inserts) == 0 && len(changes.Updates) == 0 && len(changes.Deletes) == 0 {
+			continue
+		}
+		changeSets = append(changeSets, dto.TableChangeSet{Table: tableName, Changes: changes})
+	}
+	return changeSets, nil
+}
+
+// RebaseConflicts rebases each conflicting table's session onto the current
+// values reported by a save. It returns the number of rebased records.
+func (w *StagingWorkspace) RebaseConflicts(conflicts []dto.RecordConflict) int {
+	byTable := make(map[string][]dto.RecordConflict)
+	var tables []string
+	for _, conflict := range conflicts {
+		if _, ok := byTable[conflict.Table]; !ok {
+			tables = append(tables, conflict.Table)
+		}
+		byTable[conflict.Table] = append(byTable[conflict.Table], conflict)
+	}
+	rebased := 0
+	for _, tableName := range tables {
+		session, ok := w.sessions[tableName]
+		if !ok {
+			continue
+		}
+		rebased += session.RebaseConflicts(w.schemas[tableName], byTable[tableName])
+	}
+	return rebased
+}
+
+func (w *StagingWorkspace) dirtyTables() []string {
+	tables := make([]string, 0, len(w.sessions))
+	for tableName, session := range w.sessions {
+		if session.HasDirtyEdits() {
+			tables = append(tables, tableName)
+		}
+	}
+	sort.Strings(tables)
+	return tables
+}
@@ -0,0 +1,132 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/usecase"
+)
+
+func stagingWorkspaceSchemaForTest() dto.Schema {
+	return dto.Schema{
+		Columns: []dto.SchemaColumn{
+			{Name: "id", Type: "INTEGER", PrimaryKey: true},
+			{Name: "name", Type: "TEXT", Nullable: true},
+		},
+	}
+}
+
+func stagingWorkspaceIdentityForTest(id int64, text string) dto.RecordIdentity {
+	return dto.RecordIdentity{
+		Keys: []dto.RecordIdentityKey{{Column: "id", Value: dto.StagedValue{Text: text, Raw: id}}},
+	}
+}
+
+func TestStagingWorkspace_KeepsIndependentSessionsPerTable(t *testing.T) {
+	// Arrange
+	workspace := usecase.NewStagingWorkspace(nil, nil)
+
+	// Act
+	err := workspace.Session("users").SetDeleteMark("id=1", stagingWorkspaceIdentityForTest(1, "1"), true)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := workspace.DirtyEditCountForTable("users"); got != 1 {
+		t.Fatalf("expected users dirty count 1, got %d", got)
+	}
+	if got := workspace.DirtyEditCountForTable("orders"); got != 0 {
+		t.Fatalf("expected orders dirty count 0, got %d", got)
+	}
+	if workspace.Session("orders").HasDirtyEdits() {
+		t.Fatal("expected orders session to stay clean")
+	}
+	if got := workspace.DirtyEditCount(); got != 1 {
+		t.Fatalf("expected total dirty count 1, got %d", got)
+	}
+}
+
+func TestStagingWorkspace_BuildDatabaseChanges_ReturnsDirtyTablesInNameOrder(t *testing.T) {
+	// Arrange
+	workspace := usecase.NewStagingWorkspace(nil, nil)
+	for _, tableName := range []string{"users", "orders", "audit"} {
+		workspace.SetSchema(tableName, stagingWorkspaceSchemaForTest())
+	}
+	workspace.Session("audit")
+	if err := workspace.Session("users").SetDeleteMark("id=1", stagingWorkspaceIdentityForTest(1, "1"), true); err != nil {
+		t.Fatalf("failed to stage users delete: %v", err)
+	}
+	if err := workspace.Session("orders").SetDeleteMark("id=7", stagingWorkspaceIdentityForTest(7, "7"), true); err != nil {
+		t.Fatalf("failed to stage orders delete: %v", err)
+	}
+
+	// Act
+	changeSets, err := workspace.BuildDatabaseChanges()
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(changeSets) != 2 {
+		t.Fatalf("expected 2 change sets, got %d", len(changeSets))
+	}
+	if changeSets[0].Table != "orders" || changeSets[1].Table != "users" {
+		t.Fatalf("expected orders then users, got %q then %q", changeSets[0].Table, changeSets[1].Table)
+	}
+	if len(changeSets[0].Changes.Deletes) != 1 || len(changeSets[1].Changes.Deletes) != 1 {
+		t.Fatalf("expected one delete per table, got %+v", changeSets)
+	}
+}
+
+func TestStagingWorkspace_BuildDatabaseChanges_RequiresSchemaOfDirtyTables(t *testing.T) {
+	// Arrange
+	workspace := usecase.NewStagingWorkspace(nil, nil)
+	if err := workspace.Session("users").SetDeleteMark("id=1", stagingWorkspaceIdentityForTest(1, "1"), true); err != nil {
+		t.Fatalf("failed to stage users delete: %v", err)
+	}
+
+	// Act
+	_, err := workspace.BuildDatabaseChanges()
+
+	// Assert
+	if err == nil {
+		t.Fatal("expected missing schema error, got nil")
+	}
+}
+
+func TestStagingWorkspace_Reset_ClearsEveryTable(t *testing.T) {
+	// Arrange
+	workspace := usecase.NewStagingWorkspace(nil, nil)
+	for _, tableName := range []string{"users", "orders"} {
+		if err := workspace.Session(tableName).SetDeleteMark("id=1", stagingWorkspaceIdentityForTest(1, "1"), true); err != nil {
+			t.Fatalf("failed to stage %s delete: %v", tableName, err)
+		}
+	}
+
+	// Act
+	workspace.Reset()
+
+	// Assert
+	if workspace.HasDirtyEdits() {
+		t.Fatalf("expected clean workspace, got %d dirty rows", workspace.DirtyEditCount())
+	}
+}
+
+func TestStagingWorkspace_Reset_ClearsSchemas(t *testing.T) {
+	// Arrange
+	workspace := usecase.NewStagingWorkspace(nil, nil)
+	workspace.SetSchema("users", stagingWorkspaceSchemaForTest())
+	workspace.Reset()
+	if err := workspace.Session("users").SetDeleteMark("id=1", stagingWorkspaceIdentityForTest(1, "1"), true); err != nil {
+		t.Fatalf("failed to stage users delete: %v", err)
+	}
+
+	// Act
+	_, err := workspace.BuildDatabaseChanges()
+
+	// Assert
+	if err == nil {
+		t.Fatal("expected the schema recorded before reset to be dropped")
+	}
+}
@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/port"
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+const DefaultYankFormat = "tsv"
+
+var (
+	ErrYankRowsRequired     = errors.New("no rows to yank")
+	ErrUnknownYankFormat    = errors.New("unknown yank format")
+	ErrYankColumnOutOfRange = errors.New("yank column out of range")
+)
+
+// yankFormatWriters names the export format each yank format is written
+// with. A single JSON row is copied as one object instead of an array.
+var yankFormatWriters = map[string]string{
+	"tsv":  "tsv",
+	"json": "json",
+	"sql":  "sql",
+}
+
+// YankRecords turns rows of the records grid into clipboard text. Stored
+// values are read again from the engine, so copies hold the full values
+// rather than the capped browse rendering.
+type YankRecords struct {
+	engine  port.Engine
+	formats port.RecordWriterFactory
+}
+
+func NewYankRecords(engine port.Engine, formats port.RecordWriterFactory) *YankRecords {
+	return &YankRecords{engine: engine, formats: formats}
+}
+
+// Cell returns the text of one column of row. NULL is copied as NULL and
+// BLOBs as 0x-prefixed hex, the forms the edit popup accepts back.
+func (uc *YankRecords) Cell(ctx context.Context, table string, columns []dto.SchemaColumn, row dto.YankRow, column int) (string, error) {
+	if column < 0 || column >= len(columns) {
+		return "", ErrYankColumnOutOfRange
+	}
+	values, err := uc.rowValues(ctx, table, len(columns), row)
+	if err != nil {
+		return "", err
+	}
+	value := values[column]
+	if value.IsNull {
+		return "NULL", nil
+	}
+	if raw, ok := value.Raw.([]byte); ok {
+		return "0x" + hex.EncodeToString(raw), nil
+	}
+	if value.Text == "" && value.Raw != nil {
+		return fmt.Sprint(value.Raw), nil
+	}
+	return value.Text, nil
+}
+
+// Rows formats every row of yank in its format. SQL INSERTs leave out
+// generated columns, which cannot be written.
+func (uc *YankRecords) Rows(ctx context.Context, yank dto.RecordYank) (string, error) {
+	if len(yank.Rows) == 0 {
+		return "", ErrYankRowsRequired
+	}
+	format := strings.ToLower(strings.TrimSpace(yank.Format))
+	if format == "" {
+		format = DefaultYankFormat
+	}
+	if err := ValidateYankFormat(format); err != nil {
+		return "", err
+	}
+	writerFormat := yankFormatWriters[format]
+	if format == "json" && len(yank.Rows) == 1 {
+		writerFormat = "ndjson"
+	}
+	blob := yank.Blob
+	if blob == "" {
+		blob = DefaultExportBlobEncoding
+	}
+	if err := ValidateExportBlobEncoding(blob); err != nil {
+		return "", err
+	}
+
+	included := make([]int, 0, len(yank.Columns))
+	header := make([]model.Column, 0, len(yank.Columns))
+	for i, column := range yank.Columns {
+		if format == "sql" && column.Generated {
+			continue
+		}
+		included = append(included, i)
+		header = append(header, model.Column{Name: column.Name, Type: column.Type})
+	}
+
+	var out bytes.Buffer
+	writer, err := uc.formats.NewRecordWriter(writerFormat, &out, port.RecordFormatOptions{
+		Blob:     port.BlobEncoding(blob),
+		NullText: yank.NullText,
+	})
+	if err != nil {
+		return "", err
+	}
+	if err := writer.WriteHeader(yank.Table, header); err != nil {
+		return "", err
+	}
+	record := make([]model.Value, len(included))
+	for _, row := range yank.Rows {
+		values, err := uc.rowValues(ctx, yank.Table, len(yank.Columns), row)
+		if err != nil {
+			return "", err
+		}
+		for i, index := range included {
+			record[i] = values[index]
+		}
+		if err := writer.WriteRecord(record); err != nil {
+			return "", err
+		}
+	}
+	if err := writer.Finish(); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(out.String(), "\n"), nil
+}
+
+// ValidateYankFormat reports whether format names a supported yank format.
+func ValidateYankFormat(format string) error {
+	if _, ok := yankFormatWriters[format]; ok {
+		return nil
+	}
+	return fmt.Errorf("%w: %s (expected json, sql, or tsv)", ErrUnknownYankFormat, format)
+}
+
+// rowValues returns columnCount values of row: the stored ones when the row
+// has an identity, with its own values laid over them. Columns without a
+// value are NULL.
+func (uc *YankRecords) rowValues(ctx context.Context, table string, columnCount int, row dto.YankRow) ([]model.Value, error) {
+	values := make([]model.Value, columnCount)
+	for i := range values {
+		values[i] = model.Value{IsNull: true}
+	}
+	if len(row.Identity.Keys) > 0 {
+		stored, err := uc.engine.ReadRecord(ctx, table, toDomainRecordIdentity(row.Identity))
+		if err != nil {
+			return nil, err
+		}
+		copy(values, stored)
+	}
+	for index, value := range row.Values {
+		if index < 0 || index >= columnCount {
+			continue
+		}
+		values[index] = model.Value{IsNull: value.IsNull, Text: value.Text, Raw: value.Raw}
+	}
+	return values, nil
+}
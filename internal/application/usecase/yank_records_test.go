@@ -0,0 +1,178 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/port"
+	"github.com/mgierok/dbc/internal/application/usecase"
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+type yankFormatsStub struct {
+	format  string
+	options port.RecordFormatOptions
+}
+
+func (s *yankFormatsStub) Formats() []string {
+	return []string{"json", "ndjson", "sql", "tsv"}
+}
+
+func (s *yankFormatsStub) NewRecordWriter(format string, out io.Writer, options port.RecordFormatOptions) (port.RecordWriter, error) {
+	s.format = format
+	s.options = options
+	return &lineRecordWriter{out: out}, nil
+}
+
+func yankColumnsForTest() []dto.SchemaColumn {
+	return []dto.SchemaColumn{
+		{Name: "id", Type: "INTEGER", PrimaryKey: true},
+		{Name: "body", Type: "TEXT"},
+		{Name: "size", Type: "INTEGER", Generated: true},
+	}
+}
+
+func yankIdentityForTest(id int64) dto.RecordIdentity {
+	return dto.RecordIdentity{Keys: []dto.RecordIdentityKey{{
+		Column: "id",
+		Value:  dto.StagedValue{Text: "1", Raw: id},
+	}}}
+}
+
+func TestYankRecords_CellReadsFullStoredValueOverlaidWithStagedEdits(t *testing.T) {
+	t.Parallel()
+
+	engine := &engineStub{readRecordValues: []model.Value{
+		{Text: "1", Raw: int64(1)},
+		{Raw: []byte{0x00, 0xff}},
+		{Text: "2", Raw: int64(2)},
+	}}
+	uc := usecase.NewYankRecords(engine, &yankFormatsStub{})
+	row := dto.YankRow{
+		Identity: yankIdentityForTest(1),
+		Values:   map[int]dto.StagedValue{0: {Text: "7", Raw: int64(7)}},
+	}
+
+	blob, err := uc.Cell(context.Background(), "notes", yankColumnsForTest(), row, 1)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	staged, err := uc.Cell(context.Background(), "notes", yankColumnsForTest(), row, 0)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if blob != "0x00ff" {
+		t.Fatalf("expected hex BLOB text, got %q", blob)
+	}
+	if staged != "7" {
+		t.Fatalf("expected staged value to win over stored value, got %q", staged)
+	}
+	if len(engine.readRecordIdentity) != 2 || engine.lastRecordsTable != "notes" {
+		t.Fatalf("expected stored row to be read from notes, got %d reads of %q", len(engine.readRecordIdentity), engine.lastRecordsTable)
+	}
+}
+
+func TestYankRecords_CellUsesRowValuesWithoutIdentity(t *testing.T) {
+	t.Parallel()
+
+	engine := &engineStub{}
+	uc := usecase.NewYankRecords(engine, &yankFormatsStub{})
+	row := dto.YankRow{Values: map[int]dto.StagedValue{1: {IsNull: true}}}
+
+	text, err := uc.Cell(context.Background(), "notes", yankColumnsForTest(), row, 1)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if text != "NULL" {
+		t.Fatalf("expected NULL, got %q", text)
+	}
+	if len(engine.readRecordIdentity) != 0 {
+		t.Fatal("expected a row without identity not to be read from the engine")
+	}
+}
+
+func TestYankRecords_RowsWritesSQLWithoutGeneratedColumns(t *testing.T) {
+	t.Parallel()
+
+	engine := &engineStub{readRecordValues: []model.Value{
+		{Text: "1", Raw: int64(1)},
+		{Text: "stored", Raw: "stored"},
+		{Text: "6", Raw: int64(6)},
+	}}
+	formats := &yankFormatsStub{}
+	uc := usecase.NewYankRecords(engine, formats)
+
+	text, err := uc.Rows(context.Background(), dto.RecordYank{
+		Table:    "notes",
+		Columns:  yankColumnsForTest(),
+		Format:   "SQL",
+		Rows:     []dto.YankRow{{Identity: yankIdentityForTest(1)}, {Values: map[int]dto.StagedValue{1: {Text: "draft", Raw: "draft"}}}},
+		NullText: "-",
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	expected := "notes:2\n1,stored\n,draft\nend"
+	if text != expected {
+		t.Fatalf("expected %q, got %q", expected, text)
+	}
+	if formats.format != "sql" || formats.options.Blob != port.BlobEncodingBase64 || formats.options.NullText != "-" {
+		t.Fatalf("unexpected writer %q with options %+v", formats.format, formats.options)
+	}
+}
+
+func TestYankRecords_RowsWritesSingleJSONRowAsObject(t *testing.T) {
+	t.Parallel()
+
+	formats := &yankFormatsStub{}
+	uc := usecase.NewYankRecords(&engineStub{}, formats)
+	yank := dto.RecordYank{Table: "notes", Columns: yankColumnsForTest(), Format: "json", Rows: []dto.YankRow{{}}}
+
+	if _, err := uc.Rows(context.Background(), yank); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	single := formats.format
+	yank.Rows = append(yank.Rows, dto.YankRow{})
+	if _, err := uc.Rows(context.Background(), yank); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if single != "ndjson" || formats.format != "json" {
+		t.Fatalf("expected ndjson for one row and json for many, got %q and %q", single, formats.format)
+	}
+}
+
+func TestYankRecords_RowsRejectsUnknownFormatAndEmptySelection(t *testing.T) {
+	t.Parallel()
+
+	uc := usecase.NewYankRecords(&engineStub{}, &yankFormatsStub{})
+
+	_, formatErr := uc.Rows(context.Background(), dto.RecordYank{Table: "notes", Format: "xml", Rows: []dto.YankRow{{}}})
+	_, rowsErr := uc.Rows(context.Background(), dto.RecordYank{Table: "notes", Format: "tsv"})
+
+	if !errors.Is(formatErr, usecase.ErrUnknownYankFormat) {
+		t.Fatalf("expected unknown yank format error, got %v", formatErr)
+	}
+	if !errors.Is(rowsErr, usecase.ErrYankRowsRequired) {
+		t.Fatalf("expected no rows error, got %v", rowsErr)
+	}
+}
+
+func TestYankRecords_ReturnsReadError(t *testing.T) {
+	t.Parallel()
+
+	engine := &engineStub{readRecordErr: model.ErrRecordNotFound}
+	uc := usecase.NewYankRecords(engine, &yankFormatsStub{})
+
+	_, err := uc.Cell(context.Background(), "notes", yankColumnsForTest(), dto.YankRow{Identity: yankIdentityForTest(1)}, 0)
+
+	if !errors.Is(err, model.ErrRecordNotFound) {
+		t.Fatalf("expected missing record error, got %v", err)
+	}
+}
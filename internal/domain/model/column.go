@@ -8,5 +8,13 @@ type Column struct {
 	Unique        bool
 	DefaultValue  *string
 	AutoIncrement bool
-	ForeignKeys   []ForeignKeyRef
+	// ForeignKeys holds the single-column foreign keys of this column.
+	// Composite keys are table-level only, in Schema.ForeignKeys.
+	ForeignKeys []ForeignKeyRef
+	// Generated marks GENERATED ALWAYS AS columns, which SQLite computes and
+	// never accepts writes for. GenerationExpression is empty when the
+	// definition could not be read.
+	Generated            bool
+	GeneratedStored      bool
+	GenerationExpression string
 }
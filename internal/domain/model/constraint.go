@@ -0,0 +1,21 @@
+package model
+
+// ForeignKeyConstraint is one FOREIGN KEY clause. Columns and RefColumns are
+// paired by position; RefColumns is empty when the clause references the
+// parent's primary key implicitly.
+type ForeignKeyConstraint struct {
+	Columns    []string
+	RefTable   string
+	RefColumns []string
+	OnUpdate   string
+	OnDelete   string
+}
+
+type UniqueConstraint struct {
+	Columns []string
+}
+
+type CheckConstraint struct {
+	Name       string
+	Expression string
+}
@@ -0,0 +1,20 @@
+package model
+
+type IndexOrigin string
+
+const (
+	IndexOriginCreate     IndexOrigin = "c"
+	IndexOriginUnique     IndexOrigin = "u"
+	IndexOriginPrimaryKey IndexOrigin = "pk"
+)
+
+type Index struct {
+	Name    string
+	Columns []string
+	Unique  bool
+	Partial bool
+	Origin  IndexOrigin
+	// SQL is empty for indexes SQLite creates implicitly for UNIQUE and
+	// PRIMARY KEY constraints.
+	SQL string
+}
@@ -1,7 +1,12 @@
 package model
 
+// RecordPage is one page of table records. NextCursor and PrevCursor are
+// opaque keyset cursors for the pages after the last and before the first
+// record; they are empty when the page has no records. Total counts are read
+// separately so they can be deferred.
 type RecordPage struct {
 	Records    []Record
 	HasMore    bool
-	TotalCount int
+	NextCursor string
+	PrevCursor string
 }
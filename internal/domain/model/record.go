@@ -1,5 +1,9 @@
 package model
 
+import "errors"
+
+var ErrRecordNotFound = errors.New("record not found")
+
 type Value struct {
 	Text   string
 	IsNull bool
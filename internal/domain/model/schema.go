@@ -1,6 +1,17 @@
 package model
 
 type Schema struct {
-	Table   Table
-	Columns []Column
+	Table    Table
+	Columns  []Column
+	Indexes  []Index
+	Triggers []Trigger
+	// ForeignKeys, UniqueConstraints, and CheckConstraints hold table-level
+	// constraints, including composite ones that per-column metadata cannot
+	// describe.
+	ForeignKeys       []ForeignKeyConstraint
+	UniqueConstraints []UniqueConstraint
+	CheckConstraints  []CheckConstraint
+	// SQL is the CREATE statement stored in sqlite_master, empty when the
+	// engine does not expose one.
+	SQL string
 }
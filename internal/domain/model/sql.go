@@ -0,0 +1,35 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrMissingSQLStatement          = errors.New("sql statement is required")
+	ErrMultipleSQLStatements        = errors.New("only one sql statement can run at a time")
+	ErrSQLTransactionControl        = errors.New("transaction statements are not supported in the sql console")
+	ErrSQLStatementReturnsNoRecords = errors.New("sql statement does not return rows")
+)
+
+// SQLStatementKind tells how a console statement reports its outcome. Other
+// statements, such as schema changes, neither return nor count rows.
+type SQLStatementKind string
+
+const (
+	SQLStatementQuery  SQLStatementKind = "query"
+	SQLStatementModify SQLStatementKind = "modify"
+	SQLStatementOther  SQLStatementKind = "other"
+)
+
+// SQLResult is the outcome of one statement run from the SQL console. A query
+// carries one page of rows; a modifying statement reports the rows it
+// changed.
+type SQLResult struct {
+	Kind         SQLStatementKind
+	Columns      []string
+	Records      []Record
+	HasMore      bool
+	RowsAffected int
+	Elapsed      time.Duration
+}
@@ -1,5 +1,22 @@
 package model
 
+type TableKind string
+
+const (
+	TableKindTable TableKind = "table"
+	TableKindView  TableKind = "view"
+)
+
+// TableWriteTriggers records which INSTEAD OF triggers a view defines. Only
+// views consult it; tables are always writable.
+type TableWriteTriggers struct {
+	Insert bool
+	Update bool
+	Delete bool
+}
+
 type Table struct {
-	Name string
+	Name      string
+	Kind      TableKind
+	InsteadOf TableWriteTriggers
 }
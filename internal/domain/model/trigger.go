@@ -0,0 +1,6 @@
+package model
+
+type Trigger struct {
+	Name string
+	SQL  string
+}
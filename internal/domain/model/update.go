@@ -1,13 +1,19 @@
 package model
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	ErrMissingRecordIdentity = errors.New("record identity is required")
 	ErrMissingRecordChanges  = errors.New("record changes are required")
 	ErrMissingTableChanges   = errors.New("table changes are required")
+	ErrMissingTableName      = errors.New("table name is required")
 	ErrMissingInsertValues   = errors.New("insert values are required")
 	ErrMissingDeleteIdentity = errors.New("delete identity is required")
+	ErrRecordConflict        = errors.New("records changed since they were loaded")
+	ErrAmbiguousRecord       = errors.New("record identity matches more than one record")
 )
 
 type ColumnValue struct {
@@ -27,6 +33,9 @@ type RecordIdentity struct {
 type RecordUpdate struct {
 	Identity RecordIdentity
 	Changes  []ColumnValue
+	// Originals hold the loaded values of the changed columns. When present,
+	// the update only applies while the record still holds them.
+	Originals []ColumnValue
 }
 
 type RecordInsert struct {
@@ -42,4 +51,49 @@ type TableChanges struct {
 	Inserts []RecordInsert
 	Updates []RecordUpdate
 	Deletes []RecordDelete
+	// Force skips the concurrency checks and overwrites concurrent changes.
+	Force bool
+}
+
+// TableChangeSet pairs a table with the changes staged for it, so changes to
+// several tables can be saved together.
+type TableChangeSet struct {
+	Table   string
+	Changes TableChanges
+}
+
+// WriteStatement is one statement a save executes, with the values bound to
+// its placeholders in order.
+type WriteStatement struct {
+	Table string
+	SQL   string
+	Args  []Value
+}
+
+type ColumnConflict struct {
+	Column   string
+	Original Value
+	Current  Value
+	Staged   Value
+}
+
+// RecordConflict describes a record another session changed or removed after
+// it was loaded.
+type RecordConflict struct {
+	Table    string
+	Identity RecordIdentity
+	Missing  bool
+	Columns  []ColumnConflict
+}
+
+type RecordConflictError struct {
+	Conflicts []RecordConflict
+}
+
+func (e *RecordConflictError) Error() string {
+	return fmt.Sprintf("%s: %d conflicting record(s)", ErrRecordConflict, len(e.Conflicts))
+}
+
+func (e *RecordConflictError) Unwrap() error {
+	return ErrRecordConflict
 }
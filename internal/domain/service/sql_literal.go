@@ -0,0 +1,65 @@
+package service
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+var ErrValueHasNoSQLLiteral = errors.New("NaN has no SQL literal")
+
+// SQLLiteral writes value as the SQLite literal of the type it is bound with:
+// integers and reals as numbers, byte slices as BLOB literals, booleans as 1
+// or 0, and everything else as quoted text. Reals keep a decimal point and
+// infinities overflow to ±9e999; NaN binds as NULL in SQLite, so it is
+// refused rather than written as a different value.
+func SQLLiteral(value model.Value) (string, error) {
+	if value.IsNull {
+		return "NULL", nil
+	}
+	switch raw := value.Raw.(type) {
+	case nil:
+		return QuoteSQLText(value.Text), nil
+	case int64:
+		return strconv.FormatInt(raw, 10), nil
+	case float64:
+		return sqlRealLiteral(raw)
+	case []byte:
+		return "X'" + strings.ToUpper(hex.EncodeToString(raw)) + "'", nil
+	case bool:
+		if raw {
+			return "1", nil
+		}
+		return "0", nil
+	case string:
+		return QuoteSQLText(raw), nil
+	default:
+		return QuoteSQLText(fmt.Sprint(raw)), nil
+	}
+}
+
+// QuoteSQLText writes text as a single-quoted SQL string literal.
+func QuoteSQLText(text string) string {
+	return "'" + strings.ReplaceAll(text, "'", "''") + "'"
+}
+
+func sqlRealLiteral(value float64) (string, error) {
+	switch {
+	case math.IsNaN(value):
+		return "", ErrValueHasNoSQLLiteral
+	case math.IsInf(value, 1):
+		return "9e999", nil
+	case math.IsInf(value, -1):
+		return "-9e999", nil
+	}
+	literal := strconv.FormatFloat(value, 'g', -1, 64)
+	if !strings.ContainsAny(literal, ".e") {
+		literal += ".0"
+	}
+	return literal, nil
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+func TestSQLLiteral_RendersBoundValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    model.Value
+		expected string
+	}{
+		{name: "null", value: model.Value{IsNull: true, Text: "NULL"}, expected: "NULL"},
+		{name: "integer", value: model.Value{Text: "42", Raw: int64(42)}, expected: "42"},
+		{name: "real", value: model.Value{Text: "1.5", Raw: 1.5}, expected: "1.5"},
+		{name: "whole real keeps real type", value: model.Value{Text: "3", Raw: float64(3)}, expected: "3.0"},
+		{name: "infinity overflows", value: model.Value{Raw: math.Inf(-1)}, expected: "-9e999"},
+		{name: "text with quote", value: model.Value{Text: "O'Brien", Raw: "O'Brien"}, expected: "'O''Brien'"},
+		{name: "text without raw value", value: model.Value{Text: "plain"}, expected: "'plain'"},
+		{name: "blob", value: model.Value{Text: "0xcafe", Raw: []byte{0xca, 0xfe}}, expected: "X'CAFE'"},
+		{name: "empty blob", value: model.Value{Raw: []byte{}}, expected: "X''"},
+		{name: "bool", value: model.Value{Text: "true", Raw: true}, expected: "1"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			literal, err := SQLLiteral(tc.value)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if literal != tc.expected {
+				t.Fatalf("expected literal %q, got %q", tc.expected, literal)
+			}
+		})
+	}
+}
+
+func TestSQLLiteral_RefusesNaN(t *testing.T) {
+	// Act
+	_, err := SQLLiteral(model.Value{Text: "NaN", Raw: math.NaN()})
+
+	// Assert
+	if !errors.Is(err, ErrValueHasNoSQLLiteral) {
+		t.Fatalf("expected %v, got %v", ErrValueHasNoSQLLiteral, err)
+	}
+}
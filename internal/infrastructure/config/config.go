@@ -32,8 +32,20 @@ type Config struct {
 }
 
 type DatabaseConfig struct {
-	Name string `json:"name"`
-	Path string `json:"db_path"`
+	Name        string           `json:"name"`
+	Path        string           `json:"db_path"`
+	ReadOnly    bool             `json:"read_only,omitempty"`
+	ConfirmSave bool             `json:"confirm_save,omitempty"`
+	Pragmas     *DatabasePragmas `json:"pragmas,omitempty"`
+	Views       []ViewConfig     `json:"views,omitempty"`
+}
+
+// DatabasePragmas lists the SQLite pragmas an entry may set on connect.
+type DatabasePragmas struct {
+	BusyTimeout int    `json:"busy_timeout,omitempty"`
+	JournalMode string `json:"journal_mode,omitempty"`
+	ForeignKeys bool   `json:"foreign_keys,omitempty"`
+	Synchronous string `json:"synchronous,omitempty"`
 }
 
 type Store struct {
@@ -83,6 +95,9 @@ func (c Config) Validate() error {
 		if strings.TrimSpace(database.Path) == "" {
 			return ErrMissingDatabasePath
 		}
+		if err := validateViews(database.Views); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -133,8 +148,11 @@ func (s *Store) List(_ context.Context) ([]port.ConfigEntry, error) {
 	result := make([]port.ConfigEntry, len(cfg.Databases))
 	for i, database := range cfg.Databases {
 		result[i] = port.ConfigEntry{
-			Name:   database.Name,
-			DBPath: database.Path,
+			Name:        database.Name,
+			DBPath:      database.Path,
+			ReadOnly:    database.ReadOnly,
+			ConfirmSave: database.ConfirmSave,
+			Pragmas:     connectionPragmasFromConfig(database.Pragmas),
 		}
 	}
 	return result, nil
@@ -150,8 +168,11 @@ func (s *Store) Create(_ context.Context, entry port.ConfigEntry) error {
 		}
 	}
 	cfg.Databases = append(cfg.Databases, DatabaseConfig{
-		Name: entry.Name,
-		Path: entry.DBPath,
+		Name:        entry.Name,
+		Path:        entry.DBPath,
+		ReadOnly:    entry.ReadOnly,
+		ConfirmSave: entry.ConfirmSave,
+		Pragmas:     configPragmasFromConnection(entry.Pragmas),
 	})
 	return saveFile(s.path, cfg)
 }
@@ -165,8 +186,12 @@ func (s *Store) Update(_ context.Context, index int, entry port.ConfigEntry) err
 		return ErrDatabaseIndexOutOfRange
 	}
 	cfg.Databases[index] = DatabaseConfig{
-		Name: entry.Name,
-		Path: entry.DBPath,
+		Name:        entry.Name,
+		Path:        entry.DBPath,
+		ReadOnly:    entry.ReadOnly,
+		ConfirmSave: entry.ConfirmSave,
+		Pragmas:     configPragmasFromConnection(entry.Pragmas),
+		Views:       cfg.Databases[index].Views,
 	}
 	return saveFile(s.path, cfg)
 }
@@ -183,6 +208,23 @@ func (s *Store) Delete(_ context.Context, index int) error {
 	return saveFile(s.path, cfg)
 }
 
+func connectionPragmasFromConfig(pragmas *DatabasePragmas) port.ConnectionPragmas {
+	if pragmas == nil {
+		return port.ConnectionPragmas{}
+	}
+	return port.ConnectionPragmas(*pragmas)
+}
+
+// configPragmasFromConnection returns nil for unset pragmas so saved entries
+// omit the pragmas object.
+func configPragmasFromConnection(pragmas port.ConnectionPragmas) *DatabasePragmas {
+	if pragmas == (port.ConnectionPragmas{}) {
+		return nil
+	}
+	converted := DatabasePragmas(pragmas)
+	return &converted
+}
+
 func (s *Store) ActivePath(_ context.Context) (string, error) {
 	return s.path, nil
 }
@@ -6,10 +6,12 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
 	"github.com/mgierok/dbc/internal/application/port"
+	"github.com/mgierok/dbc/internal/domain/model"
 	"github.com/mgierok/dbc/internal/infrastructure/config"
 )
 
@@ -290,6 +292,69 @@ func TestStore_UpdatePersistsReplacementEntry(t *testing.T) {
 	})
 }
 
+func TestStore_UpdatePersistsReadOnlyMode(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "config.json")
+	writeConfigFile(t, path, `{"databases":[{"name":"prod","db_path":"/tmp/prod.sqlite"}]}`)
+	store := config.NewStore(path)
+
+	// Act
+	err := store.Update(context.Background(), 0, port.ConfigEntry{
+		Name:     "prod",
+		DBPath:   "/tmp/prod.sqlite",
+		ReadOnly: true,
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	assertStoredEntries(t, path, []port.ConfigEntry{
+		{Name: "prod", DBPath: "/tmp/prod.sqlite", ReadOnly: true},
+	})
+	entries, err := store.List(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !entries[0].ReadOnly {
+		t.Fatalf("expected listed entry to be read-only, got %#v", entries[0])
+	}
+}
+
+func TestStore_CreatePersistsConnectionPragmas(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "config.json")
+	store := config.NewStore(path)
+	entry := port.ConfigEntry{
+		Name:   "shared",
+		DBPath: "file:/tmp/shared.sqlite?cache=shared",
+		Pragmas: port.ConnectionPragmas{
+			BusyTimeout: 5000,
+			ForeignKeys: true,
+		},
+	}
+
+	// Act
+	err := store.Create(context.Background(), entry)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("expected stored config to be readable, got %v", err)
+	}
+	if !strings.Contains(string(content), `"pragmas": {`) || strings.Contains(string(content), "journal_mode") {
+		t.Fatalf("expected only set pragmas to be stored, got %s", content)
+	}
+	entries, err := store.List(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	assertConfigEntries(t, entries, []port.ConfigEntry{entry})
+}
+
 func TestStore_UpdateReturnsErrorForIndexOutOfRange(t *testing.T) {
 	testCases := []struct {
 		name  string
@@ -547,8 +612,10 @@ func assertStoredEntries(t *testing.T, path string, want []port.ConfigEntry) {
 	got := make([]port.ConfigEntry, len(cfg.Databases))
 	for index, database := range cfg.Databases {
 		got[index] = port.ConfigEntry{
-			Name:   database.Name,
-			DBPath: database.Path,
+			Name:        database.Name,
+			DBPath:      database.Path,
+			ReadOnly:    database.ReadOnly,
+			ConfirmSave: database.ConfirmSave,
 		}
 	}
 
@@ -567,3 +634,78 @@ func assertConfigEntries(t *testing.T, got []port.ConfigEntry, want []port.Confi
 		}
 	}
 }
+
+func TestStore_SaveViewReplacesViewWithSameTableAndName(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "config.json")
+	writeConfigFile(t, path, `{"databases":[{"name":"local","db_path":"/tmp/local.sqlite","views":[{"name":"recent","table":"users","sort":[{"column":"id","direction":"ASC"}]},{"name":"recent","table":"orders","sort":[{"column":"id","direction":"DESC"}]}]}]}`)
+	store := config.NewStore(path)
+	view := port.ConfigView{
+		Name:  "recent",
+		Table: "users",
+		Filter: &model.FilterGroup{Logic: model.FilterLogicAnd, Conditions: []model.Filter{
+			{Column: "id", Operator: model.Operator{Name: "Between", Kind: model.OperatorKindBetween}, Values: []string{"1", "9"}},
+		}},
+		Sorts: []model.Sort{{Column: "id", Direction: model.SortDirectionDesc, Collation: model.SortCollationRTrim}},
+	}
+
+	// Act
+	err := store.SaveView(context.Background(), 0, view)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	views, err := store.ListViews(context.Background(), 0)
+	if err != nil {
+		t.Fatalf("expected views to be readable, got %v", err)
+	}
+	if len(views) != 2 || views[1].Table != "orders" {
+		t.Fatalf("expected users view replaced in place, got %#v", views)
+	}
+	condition := views[0].Filter.Conditions[0]
+	if condition.Operator.Kind != model.OperatorKindBetween || condition.Operator.Name != "" || !reflect.DeepEqual(condition.Values, []string{"1", "9"}) {
+		t.Fatalf("expected operator kind and values to be stored, got %#v", condition)
+	}
+	if !reflect.DeepEqual(views[0].Sorts, view.Sorts) {
+		t.Fatalf("expected sorts %#v, got %#v", view.Sorts, views[0].Sorts)
+	}
+}
+
+func TestStore_UpdateKeepsSavedViews(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "config.json")
+	writeConfigFile(t, path, `{"databases":[{"name":"local","db_path":"/tmp/local.sqlite","views":[{"name":"recent","table":"users","sort":[{"column":"id","direction":"DESC"}]}]}]}`)
+	store := config.NewStore(path)
+
+	// Act
+	err := store.Update(context.Background(), 0, port.ConfigEntry{Name: "primary", DBPath: "/tmp/local.sqlite"})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	views, err := store.ListViews(context.Background(), 0)
+	if err != nil {
+		t.Fatalf("expected views to be readable, got %v", err)
+	}
+	if len(views) != 1 || views[0].Name != "recent" {
+		t.Fatalf("expected saved view to survive update, got %#v", views)
+	}
+}
+
+func TestStore_ViewMethodsRejectIndexOutOfRange(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "config.json")
+	writeConfigFile(t, path, `{"databases":[{"name":"local","db_path":"/tmp/local.sqlite"}]}`)
+	store := config.NewStore(path)
+
+	// Act
+	_, listErr := store.ListViews(context.Background(), 1)
+	saveErr := store.SaveView(context.Background(), -1, port.ConfigView{Name: "v", Table: "users"})
+
+	// Assert
+	if !errors.Is(listErr, config.ErrDatabaseIndexOutOfRange) || !errors.Is(saveErr, config.ErrDatabaseIndexOutOfRange) {
+		t.Fatalf("expected index out of range errors, got %v and %v", listErr, saveErr)
+	}
+}
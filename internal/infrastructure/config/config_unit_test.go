@@ -3,6 +3,7 @@ package config_test
 import (
 	"errors"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -40,6 +41,55 @@ func TestDecode_ReturnsConfigForValidDocuments(t *testing.T) {
 				{Name: "analytics", Path: "/tmp/analytics.sqlite"},
 			},
 		},
+		{
+			name:  "read-only database",
+			input: `{"databases":[{"name":"prod","db_path":"/tmp/prod.sqlite","read_only":true}]}`,
+			want: []config.DatabaseConfig{
+				{Name: "prod", Path: "/tmp/prod.sqlite", ReadOnly: true},
+			},
+		},
+		{
+			name:  "save confirmation database",
+			input: `{"databases":[{"name":"prod","db_path":"/tmp/prod.sqlite","confirm_save":true}]}`,
+			want: []config.DatabaseConfig{
+				{Name: "prod", Path: "/tmp/prod.sqlite", ConfirmSave: true},
+			},
+		},
+		{
+			name:  "uri database with pragmas",
+			input: `{"databases":[{"name":"shared","db_path":"file:/tmp/shared.sqlite?cache=shared","pragmas":{"busy_timeout":5000,"journal_mode":"WAL","foreign_keys":true,"synchronous":"NORMAL"}}]}`,
+			want: []config.DatabaseConfig{
+				{
+					Name: "shared",
+					Path: "file:/tmp/shared.sqlite?cache=shared",
+					Pragmas: &config.DatabasePragmas{
+						BusyTimeout: 5000,
+						JournalMode: "WAL",
+						ForeignKeys: true,
+						Synchronous: "NORMAL",
+					},
+				},
+			},
+		},
+		{
+			name:  "database with saved view",
+			input: `{"databases":[{"name":"local","db_path":"/tmp/example.sqlite","views":[{"name":"active","table":"users","filter":{"logic":"AND","conditions":[{"column":"status","operator":"eq","value":"active"}]},"sort":[{"column":"id","direction":"DESC","nulls":"LAST"}]}]}]}`,
+			want: []config.DatabaseConfig{
+				{
+					Name: "local",
+					Path: "/tmp/example.sqlite",
+					Views: []config.ViewConfig{{
+						Name:  "active",
+						Table: "users",
+						Filter: &config.ViewFilterGroup{
+							Logic:      "AND",
+							Conditions: []config.ViewFilter{{Column: "status", Operator: "eq", Value: "active"}},
+						},
+						Sort: []config.ViewSort{{Column: "id", Direction: "DESC", Nulls: "LAST"}},
+					}},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -81,6 +131,11 @@ func TestDecode_ReturnsErrorForInvalidDocuments(t *testing.T) {
 			input:           `{} {}`,
 			wantErrContains: "single JSON object",
 		},
+		{
+			name:            "unsupported pragma",
+			input:           `{"databases":[{"name":"local","db_path":"/tmp/example.sqlite","pragmas":{"cache_size":-2000}}]}`,
+			wantErrContains: `unknown field "cache_size"`,
+		},
 		{
 			name:    "missing database name",
 			input:   `{"databases":[{"db_path":"/tmp/example.sqlite"}]}`,
@@ -91,6 +146,31 @@ func TestDecode_ReturnsErrorForInvalidDocuments(t *testing.T) {
 			input:   `{"databases":[{"name":"local"}]}`,
 			wantErr: config.ErrMissingDatabasePath,
 		},
+		{
+			name:    "missing view name",
+			input:   `{"databases":[{"name":"local","db_path":"/tmp/example.sqlite","views":[{"table":"users","sort":[{"column":"id","direction":"ASC"}]}]}]}`,
+			wantErr: config.ErrMissingViewName,
+		},
+		{
+			name:    "missing view table",
+			input:   `{"databases":[{"name":"local","db_path":"/tmp/example.sqlite","views":[{"name":"by-id","sort":[{"column":"id","direction":"ASC"}]}]}]}`,
+			wantErr: config.ErrMissingViewTable,
+		},
+		{
+			name:    "duplicate view name on one table",
+			input:   `{"databases":[{"name":"local","db_path":"/tmp/example.sqlite","views":[{"name":"v","table":"users"},{"name":"v","table":"users"}]}]}`,
+			wantErr: config.ErrDuplicateViewName,
+		},
+		{
+			name:    "unknown view filter operator",
+			input:   `{"databases":[{"name":"local","db_path":"/tmp/example.sqlite","views":[{"name":"v","table":"users","filter":{"logic":"AND","conditions":[{"column":"id","operator":"regexp","value":"1"}]}}]}]}`,
+			wantErr: config.ErrInvalidViewFilter,
+		},
+		{
+			name:    "unknown view sort direction",
+			input:   `{"databases":[{"name":"local","db_path":"/tmp/example.sqlite","views":[{"name":"v","table":"users","sort":[{"column":"id","direction":"UP"}]}]}]}`,
+			wantErr: config.ErrInvalidViewSort,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -228,7 +308,7 @@ func assertDatabaseConfigs(t *testing.T, got []config.DatabaseConfig, want []con
 		t.Fatalf("expected %d databases, got %d", len(want), len(got))
 	}
 	for index := range want {
-		if got[index] != want[index] {
+		if !reflect.DeepEqual(got[index], want[index]) {
 			t.Fatalf("expected database at index %d to be %#v, got %#v", index, want[index], got[index])
 		}
 	}
@@ -0,0 +1,272 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mgierok/dbc/internal/application/port"
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+var (
+	ErrMissingViewName   = errors.New("view name is required")
+	ErrMissingViewTable  = errors.New("view table is required")
+	ErrDuplicateViewName = errors.New("duplicate view name")
+	ErrInvalidViewFilter = errors.New("invalid view filter")
+	ErrInvalidViewSort   = errors.New("invalid view sort")
+)
+
+// ViewConfig is a named filter and sort of one table. View names are unique
+// per table within a database entry.
+type ViewConfig struct {
+	Name   string           `json:"name"`
+	Table  string           `json:"table"`
+	Filter *ViewFilterGroup `json:"filter,omitempty"`
+	Sort   []ViewSort       `json:"sort,omitempty"`
+}
+
+type ViewFilterGroup struct {
+	Logic      string            `json:"logic"`
+	Conditions []ViewFilter      `json:"conditions,omitempty"`
+	Groups     []ViewFilterGroup `json:"groups,omitempty"`
+}
+
+type ViewFilter struct {
+	Column   string   `json:"column"`
+	Operator string   `json:"operator"`
+	Value    string   `json:"value,omitempty"`
+	Values   []string `json:"values,omitempty"`
+}
+
+type ViewSort struct {
+	Column    string `json:"column"`
+	Direction string `json:"direction"`
+	Nulls     string `json:"nulls,omitempty"`
+	Collation string `json:"collation,omitempty"`
+}
+
+var viewOperatorKinds = map[model.OperatorKind]struct{}{
+	model.OperatorKindEq:        {},
+	model.OperatorKindNeq:       {},
+	model.OperatorKindLt:        {},
+	model.OperatorKindLte:       {},
+	model.OperatorKindGt:        {},
+	model.OperatorKindGte:       {},
+	model.OperatorKindLike:      {},
+	model.OperatorKindIsNull:    {},
+	model.OperatorKindIsNotNull: {},
+	model.OperatorKindEqNoCase:  {},
+	model.OperatorKindNotLike:   {},
+	model.OperatorKindGlob:      {},
+	model.OperatorKindStarts:    {},
+	model.OperatorKindEnds:      {},
+	model.OperatorKindContains:  {},
+	model.OperatorKindBetween:   {},
+	model.OperatorKindIn:        {},
+	model.OperatorKindNotIn:     {},
+}
+
+func (s *Store) ListViews(_ context.Context, index int) ([]port.ConfigView, error) {
+	cfg, err := LoadFile(s.path)
+	if err != nil {
+		return nil, err
+	}
+	if index < 0 || index >= len(cfg.Databases) {
+		return nil, ErrDatabaseIndexOutOfRange
+	}
+	views := cfg.Databases[index].Views
+	result := make([]port.ConfigView, len(views))
+	for i, view := range views {
+		result[i] = port.ConfigView{
+			Name:   view.Name,
+			Table:  view.Table,
+			Filter: domainFilterFromView(view.Filter),
+			Sorts:  domainSortsFromView(view.Sort),
+		}
+	}
+	return result, nil
+}
+
+// SaveView replaces the view with the same table and name, or adds it.
+func (s *Store) SaveView(_ context.Context, index int, view port.ConfigView) error {
+	cfg, err := LoadFile(s.path)
+	if err != nil {
+		return err
+	}
+	if index < 0 || index >= len(cfg.Databases) {
+		return ErrDatabaseIndexOutOfRange
+	}
+	saved := ViewConfig{
+		Name:   view.Name,
+		Table:  view.Table,
+		Filter: viewFilterFromDomain(view.Filter),
+		Sort:   viewSortsFromDomain(view.Sorts),
+	}
+	database := &cfg.Databases[index]
+	replaced := false
+	for i, existing := range database.Views {
+		if existing.Table == saved.Table && existing.Name == saved.Name {
+			database.Views[i] = saved
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		database.Views = append(database.Views, saved)
+	}
+	return saveFile(s.path, cfg)
+}
+
+func validateViews(views []ViewConfig) error {
+	seen := make(map[[2]string]struct{}, len(views))
+	for _, view := range views {
+		if strings.TrimSpace(view.Name) == "" {
+			return ErrMissingViewName
+		}
+		if strings.TrimSpace(view.Table) == "" {
+			return ErrMissingViewTable
+		}
+		key := [2]string{view.Table, view.Name}
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("%w: %s on %s", ErrDuplicateViewName, view.Name, view.Table)
+		}
+		seen[key] = struct{}{}
+		if view.Filter != nil {
+			if err := validateViewFilterGroup(*view.Filter); err != nil {
+				return fmt.Errorf("%w in view %s: %s", ErrInvalidViewFilter, view.Name, err.Error())
+			}
+		}
+		for _, sort := range view.Sort {
+			if err := validateViewSort(sort); err != nil {
+				return fmt.Errorf("%w in view %s: %s", ErrInvalidViewSort, view.Name, err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+func validateViewFilterGroup(group ViewFilterGroup) error {
+	switch model.FilterLogic(group.Logic) {
+	case model.FilterLogicAnd, model.FilterLogicOr:
+	default:
+		return fmt.Errorf("unknown logic %q", group.Logic)
+	}
+	for _, condition := range group.Conditions {
+		if strings.TrimSpace(condition.Column) == "" {
+			return errors.New("condition column is required")
+		}
+		if _, ok := viewOperatorKinds[model.OperatorKind(condition.Operator)]; !ok {
+			return fmt.Errorf("unknown operator %q", condition.Operator)
+		}
+	}
+	for _, nested := range group.Groups {
+		if err := validateViewFilterGroup(nested); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateViewSort(sort ViewSort) error {
+	if strings.TrimSpace(sort.Column) == "" {
+		return errors.New("sort column is required")
+	}
+	switch model.SortDirection(sort.Direction) {
+	case model.SortDirectionAsc, model.SortDirectionDesc:
+	default:
+		return fmt.Errorf("unknown direction %q", sort.Direction)
+	}
+	switch model.SortNulls(sort.Nulls) {
+	case model.SortNullsDefault, model.SortNullsFirst, model.SortNullsLast:
+	default:
+		return fmt.Errorf("unknown nulls placement %q", sort.Nulls)
+	}
+	switch model.SortCollation(sort.Collation) {
+	case model.SortCollationDefault, model.SortCollationNoCase, model.SortCollationRTrim:
+	default:
+		return fmt.Errorf("unknown collation %q", sort.Collation)
+	}
+	return nil
+}
+
+func domainFilterFromView(group *ViewFilterGroup) *model.FilterGroup {
+	if group == nil {
+		return nil
+	}
+	converted := domainFilterGroupFromView(*group)
+	return &converted
+}
+
+func domainFilterGroupFromView(group ViewFilterGroup) model.FilterGroup {
+	converted := model.FilterGroup{Logic: model.FilterLogic(group.Logic)}
+	for _, condition := range group.Conditions {
+		converted.Conditions = append(converted.Conditions, model.Filter{
+			Column:   condition.Column,
+			Operator: model.Operator{Kind: model.OperatorKind(condition.Operator)},
+			Value:    condition.Value,
+			Values:   append([]string(nil), condition.Values...),
+		})
+	}
+	for _, nested := range group.Groups {
+		converted.Groups = append(converted.Groups, domainFilterGroupFromView(nested))
+	}
+	return converted
+}
+
+func viewFilterFromDomain(group *model.FilterGroup) *ViewFilterGroup {
+	if group == nil {
+		return nil
+	}
+	converted := viewFilterGroupFromDomain(*group)
+	return &converted
+}
+
+func viewFilterGroupFromDomain(group model.FilterGroup) ViewFilterGroup {
+	converted := ViewFilterGroup{Logic: string(group.Logic)}
+	for _, condition := range group.Conditions {
+		converted.Conditions = append(converted.Conditions, ViewFilter{
+			Column:   condition.Column,
+			Operator: string(condition.Operator.Kind),
+			Value:    condition.Value,
+			Values:   append([]string(nil), condition.Values...),
+		})
+	}
+	for _, nested := range group.Groups {
+		converted.Groups = append(converted.Groups, viewFilterGroupFromDomain(nested))
+	}
+	return converted
+}
+
+func domainSortsFromView(sorts []ViewSort) []model.Sort {
+	if len(sorts) == 0 {
+		return nil
+	}
+	converted := make([]model.Sort, len(sorts))
+	for i, sort := range sorts {
+		converted[i] = model.Sort{
+			Column:    sort.Column,
+			Direction: model.SortDirection(sort.Direction),
+			Nulls:     model.SortNulls(sort.Nulls),
+			Collation: model.SortCollation(sort.Collation),
+		}
+	}
+	return converted
+}
+
+func viewSortsFromDomain(sorts []model.Sort) []ViewSort {
+	if len(sorts) == 0 {
+		return nil
+	}
+	converted := make([]ViewSort, len(sorts))
+	for i, sort := range sorts {
+		converted[i] = ViewSort{
+			Column:    sort.Column,
+			Direction: string(sort.Direction),
+			Nulls:     string(sort.Nulls),
+			Collation: string(sort.Collation),
+		}
+	}
+	return converted
+}
@@ -0,0 +1,308 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+type consoleStatement struct {
+	sql  string
+	kind model.SQLStatementKind
+	// direct marks queries that cannot be wrapped in a subquery, such as
+	// PRAGMA and EXPLAIN, so their pages are read from the statement itself.
+	direct bool
+}
+
+// RunSQL runs one statement typed into the SQL console. Queries return their
+// first page of at most limit rows; other statements run exactly once.
+func (e *SQLiteEngine) RunSQL(ctx context.Context, statement string, limit int) (model.SQLResult, error) {
+	parsed, err := parseConsoleStatement(statement)
+	if err != nil {
+		return model.SQLResult{}, err
+	}
+	if parsed.direct {
+		return e.queryConsoleDirectPage(ctx, parsed.sql, 0, limit)
+	}
+	if parsed.kind == model.SQLStatementQuery {
+		return e.queryConsolePage(ctx, parsed.sql, 0, limit)
+	}
+
+	started := time.Now()
+	result, err := e.db.ExecContext(ctx, parsed.sql)
+	if err != nil {
+		return model.SQLResult{}, err
+	}
+	elapsed := time.Since(started)
+	if parsed.kind != model.SQLStatementModify {
+		return model.SQLResult{Kind: parsed.kind, Elapsed: elapsed}, nil
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return model.SQLResult{}, err
+	}
+	return model.SQLResult{Kind: parsed.kind, RowsAffected: int(affected), Elapsed: elapsed}, nil
+}
+
+// QuerySQLPage reads another page of a console query. Statements that do not
+// return rows are refused rather than run again.
+func (e *SQLiteEngine) QuerySQLPage(ctx context.Context, statement string, offset, limit int) (model.SQLResult, error) {
+	parsed, err := parseConsoleStatement(statement)
+	if err != nil {
+		return model.SQLResult{}, err
+	}
+	if parsed.kind != model.SQLStatementQuery {
+		return model.SQLResult{}, model.ErrSQLStatementReturnsNoRecords
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	if parsed.direct {
+		return e.queryConsoleDirectPage(ctx, parsed.sql, offset, limit)
+	}
+	return e.queryConsolePage(ctx, parsed.sql, offset, limit)
+}
+
+// queryConsolePage wraps the query in a subquery so every cell goes through
+// the same size cap and BLOB placeholders as table browsing. SQLite makes
+// duplicate result column names unique inside the subquery, so each column
+// can be addressed by name; the result keeps the names the query itself
+// returns.
+func (e *SQLiteEngine) queryConsolePage(ctx context.Context, query string, offset, limit int) (model.SQLResult, error) {
+	started := time.Now()
+	columns, err := e.consoleQueryColumns(ctx, fmt.Sprintf("SELECT * FROM (%s) LIMIT 0", query))
+	if err != nil {
+		return model.SQLResult{}, err
+	}
+	labels, err := e.consoleQueryColumns(ctx, query)
+	if err != nil {
+		return model.SQLResult{}, err
+	}
+	result := model.SQLResult{Kind: model.SQLStatementQuery, Columns: labels}
+	if limit <= 0 {
+		result.Elapsed = time.Since(started)
+		return result, nil
+	}
+
+	selectParts := make([]string, len(columns))
+	for i, column := range columns {
+		selectParts[i] = consoleDisplayProjection(column, i)
+	}
+	pageQuery := fmt.Sprintf(
+		"SELECT %s FROM (%s) LIMIT ? OFFSET ?",
+		strings.Join(selectParts, ", "),
+		query,
+	)
+	records, err := e.scanConsoleRecords(ctx, pageQuery, len(columns), limit+1, offset)
+	if err != nil {
+		return model.SQLResult{}, err
+	}
+	if len(records) > limit {
+		records = records[:limit]
+		result.HasMore = true
+	}
+	result.Records = records
+	result.Elapsed = time.Since(started)
+	return result, nil
+}
+
+// queryConsoleDirectPage reads one page of a statement that cannot be
+// wrapped, skipping offset rows and capping cells in Go the way
+// consoleDisplayProjection does in SQL. A statement that returns no columns,
+// such as a PRAGMA assignment, is reported as an other statement.
+func (e *SQLiteEngine) queryConsoleDirectPage(ctx context.Context, query string, offset, limit int) (result model.SQLResult, err error) {
+	started := time.Now()
+	rows, err := e.db.QueryContext(ctx, query)
+	if err != nil {
+		return model.SQLResult{}, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}()
+	columns, err := rows.Columns()
+	if err != nil {
+		return model.SQLResult{}, err
+	}
+	if len(columns) == 0 {
+		return model.SQLResult{Kind: model.SQLStatementOther, Elapsed: time.Since(started)}, nil
+	}
+
+	result = model.SQLResult{Kind: model.SQLStatementQuery, Columns: columns}
+	for index := 0; limit > 0 && rows.Next(); index++ {
+		if index < offset {
+			continue
+		}
+		if len(result.Records) == limit {
+			result.HasMore = true
+			break
+		}
+		values := make([]any, len(columns))
+		dest := make([]any, len(columns))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return model.SQLResult{}, err
+		}
+		record := model.Record{Values: make([]model.Value, len(columns))}
+		for i, value := range values {
+			record.Values[i] = consoleDisplayValue(value)
+		}
+		result.Records = append(result.Records, record)
+	}
+	if err := rows.Err(); err != nil {
+		return model.SQLResult{}, err
+	}
+	result.Elapsed = time.Since(started)
+	return result, nil
+}
+
+// consoleDisplayValue mirrors consoleDisplayProjection for a scanned value.
+func consoleDisplayValue(raw any) model.Value {
+	switch typed := raw.(type) {
+	case nil:
+		return model.Value{IsNull: true}
+	case []byte:
+		if len(typed) > maxMaterializedRecordCellBytes {
+			return model.Value{Text: fmt.Sprintf("<blob truncated %d bytes>", len(typed))}
+		}
+		return model.Value{Text: fmt.Sprintf("<blob %d bytes>", len(typed))}
+	case string:
+		if len(typed) > maxMaterializedRecordCellBytes {
+			return model.Value{Text: fmt.Sprintf("<truncated %d bytes>", len(typed))}
+		}
+		return model.Value{Text: typed}
+	default:
+		return model.Value{Text: fmt.Sprint(typed)}
+	}
+}
+
+func (e *SQLiteEngine) consoleQueryColumns(ctx context.Context, query string) (columns []string, err error) {
+	rows, err := e.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}()
+	return rows.Columns()
+}
+
+func (e *SQLiteEngine) scanConsoleRecords(ctx context.Context, query string, columnCount int, args ...any) (records []model.Record, err error) {
+	rows, err := e.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}()
+
+	for rows.Next() {
+		values := make([]sql.NullString, columnCount)
+		dest := make([]any, columnCount)
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		record := model.Record{Values: make([]model.Value, columnCount)}
+		for i, value := range values {
+			if !value.Valid {
+				record.Values[i] = model.Value{IsNull: true}
+				continue
+			}
+			record.Values[i] = model.Value{Text: value.String}
+		}
+		records = append(records, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// consoleDisplayProjection mirrors displayProjectionForColumn. Query results
+// have no declared column types, so BLOBs are recognised by their value type.
+func consoleDisplayProjection(column string, index int) string {
+	columnRef := quoteIdentifier(column)
+	byteLength := fmt.Sprintf("length(CAST(%s AS BLOB))", columnRef)
+	return fmt.Sprintf(
+		"CASE WHEN %s IS NULL THEN NULL "+
+			"WHEN typeof(%s) = 'blob' AND %s > %d THEN printf('<blob truncated %%d bytes>', %s) "+
+			"WHEN typeof(%s) = 'blob' THEN printf('<blob %%d bytes>', %s) "+
+			"WHEN %s > %d THEN printf('<truncated %%d bytes>', %s) "+
+			"ELSE CAST(%s AS TEXT) END AS %s",
+		columnRef,
+		columnRef, byteLength, maxMaterializedRecordCellBytes, byteLength,
+		columnRef, byteLength,
+		byteLength, maxMaterializedRecordCellBytes, byteLength,
+		columnRef,
+		quoteIdentifier(displayColumnAlias(index)),
+	)
+}
+
+// parseConsoleStatement trims trailing semicolons, rejects scripts and
+// transaction control, and classifies the statement by its leading keyword.
+func parseConsoleStatement(statement string) (consoleStatement, error) {
+	tokens := tokenizeSQL(statement)
+	for len(tokens) > 0 && tokens[len(tokens)-1].text == ";" {
+		tokens = tokens[:len(tokens)-1]
+	}
+	if len(tokens) == 0 {
+		return consoleStatement{}, model.ErrMissingSQLStatement
+	}
+	for _, token := range tokens {
+		if token.kind == sqlTokenPunct && token.text == ";" {
+			return consoleStatement{}, model.ErrMultipleSQLStatements
+		}
+	}
+
+	trimmed := strings.TrimSpace(statement[tokens[0].start:tokens[len(tokens)-1].end])
+	switch strings.ToUpper(tokens[0].text) {
+	case "BEGIN", "COMMIT", "END", "ROLLBACK", "SAVEPOINT", "RELEASE":
+		return consoleStatement{}, model.ErrSQLTransactionControl
+	case "SELECT", "VALUES":
+		return consoleStatement{sql: trimmed, kind: model.SQLStatementQuery}, nil
+	case "PRAGMA", "EXPLAIN":
+		return consoleStatement{sql: trimmed, kind: model.SQLStatementQuery, direct: true}, nil
+	case "INSERT", "UPDATE", "DELETE", "REPLACE":
+		return consoleStatement{sql: trimmed, kind: model.SQLStatementModify}, nil
+	case "WITH":
+		return consoleStatement{sql: trimmed, kind: commonTableExpressionKind(tokens)}, nil
+	default:
+		return consoleStatement{sql: trimmed, kind: model.SQLStatementOther}, nil
+	}
+}
+
+// commonTableExpressionKind classifies a WITH statement by the first
+// top-level statement keyword after its common table expressions.
+func commonTableExpressionKind(tokens []sqlToken) model.SQLStatementKind {
+	depth := 0
+	for _, token := range tokens[1:] {
+		switch {
+		case token.text == "(":
+			depth++
+		case token.text == ")":
+			depth--
+		case depth == 0 && token.kind == sqlTokenWord:
+			switch strings.ToUpper(token.text) {
+			case "SELECT", "VALUES":
+				return model.SQLStatementQuery
+			case "INSERT", "UPDATE", "DELETE", "REPLACE":
+				return model.SQLStatementModify
+			}
+		}
+	}
+	return model.SQLStatementOther
+}
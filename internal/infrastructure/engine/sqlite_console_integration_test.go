@@ -0,0 +1,206 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+func setupSQLiteConsoleEngine(t *testing.T) *SQLiteEngine {
+	t.Helper()
+	db := setupSQLiteUpdateDB(t, `
+		CREATE TABLE users (
+			id INTEGER PRIMARY KEY,
+			name TEXT,
+			avatar BLOB
+		);
+		INSERT INTO users (id, name, avatar) VALUES
+			(1, 'alice', x'0102'),
+			(2, NULL, NULL),
+			(3, 'carol', NULL);
+	`)
+	return NewSQLiteEngine(db)
+}
+
+func TestSQLiteEngine_RunSQL_ReturnsFirstQueryPage(t *testing.T) {
+	// Arrange
+	engine := setupSQLiteConsoleEngine(t)
+
+	// Act
+	result, err := engine.RunSQL(context.Background(), "SELECT u.id, u.name, u.avatar, u.id FROM users u ORDER BY u.id;", 2)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Kind != model.SQLStatementQuery {
+		t.Fatalf("expected query result, got %q", result.Kind)
+	}
+	if !reflect.DeepEqual(result.Columns, []string{"id", "name", "avatar", "id"}) {
+		t.Fatalf("expected the query's own column names, got %v", result.Columns)
+	}
+	if !result.HasMore || len(result.Records) != 2 {
+		t.Fatalf("expected 2 rows with more available, got %d rows hasMore=%t", len(result.Records), result.HasMore)
+	}
+	first := result.Records[0].Values
+	if first[0].Text != "1" || first[1].Text != "alice" || first[2].Text != "<blob 2 bytes>" || first[3].Text != "1" {
+		t.Fatalf("unexpected first row %+v", first)
+	}
+	if !result.Records[1].Values[1].IsNull {
+		t.Fatalf("expected NULL name in second row, got %+v", result.Records[1].Values[1])
+	}
+}
+
+func TestSQLiteEngine_QuerySQLPage_ReadsLaterPage(t *testing.T) {
+	// Arrange
+	engine := setupSQLiteConsoleEngine(t)
+
+	// Act
+	result, err := engine.QuerySQLPage(context.Background(), "SELECT id FROM users ORDER BY id", 2, 2)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.HasMore || len(result.Records) != 1 || result.Records[0].Values[0].Text != "3" {
+		t.Fatalf("expected last row only, got %+v", result)
+	}
+}
+
+func TestSQLiteEngine_RunSQL_ReturnsPragmaAndExplainRowsWithDirectPaging(t *testing.T) {
+	// Arrange
+	engine := setupSQLiteConsoleEngine(t)
+
+	// Act
+	first, firstErr := engine.RunSQL(context.Background(), "PRAGMA table_info(users)", 2)
+	next, nextErr := engine.QuerySQLPage(context.Background(), "PRAGMA table_info(users)", 2, 2)
+	plan, planErr := engine.RunSQL(context.Background(), "EXPLAIN QUERY PLAN SELECT * FROM users WHERE id = 1", 20)
+
+	// Assert
+	for _, err := range []error{firstErr, nextErr, planErr} {
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	if first.Kind != model.SQLStatementQuery || !first.HasMore || len(first.Records) != 2 {
+		t.Fatalf("expected first pragma page of 2 rows with more, got %+v", first)
+	}
+	if first.Columns[1] != "name" || first.Records[1].Values[1].Text != "name" {
+		t.Fatalf("expected pragma columns and rows, got %v %+v", first.Columns, first.Records)
+	}
+	if next.HasMore || len(next.Records) != 1 || next.Records[0].Values[1].Text != "avatar" {
+		t.Fatalf("expected last pragma row on the next page, got %+v", next)
+	}
+	if plan.Kind != model.SQLStatementQuery || len(plan.Records) == 0 {
+		t.Fatalf("expected query plan rows, got %+v", plan)
+	}
+}
+
+func TestSQLiteEngine_RunSQL_RunsPragmaAssignmentOnce(t *testing.T) {
+	// Arrange
+	engine := setupSQLiteConsoleEngine(t)
+
+	// Act
+	result, err := engine.RunSQL(context.Background(), "PRAGMA user_version = 7", 20)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Kind != model.SQLStatementOther {
+		t.Fatalf("expected pragma assignment to report an other statement, got %+v", result)
+	}
+	var version int
+	if err := engine.db.QueryRow("PRAGMA user_version").Scan(&version); err != nil {
+		t.Fatalf("failed to read user_version: %v", err)
+	}
+	if version != 7 {
+		t.Fatalf("expected user_version 7, got %d", version)
+	}
+}
+
+func TestSQLiteEngine_QuerySQLPage_RefusesStatementsWithoutRows(t *testing.T) {
+	// Arrange
+	engine := setupSQLiteConsoleEngine(t)
+
+	// Act
+	_, err := engine.QuerySQLPage(context.Background(), "DELETE FROM users", 20, 20)
+
+	// Assert
+	if !errors.Is(err, model.ErrSQLStatementReturnsNoRecords) {
+		t.Fatalf("expected no-rows error, got %v", err)
+	}
+	var count int
+	if err := engine.db.QueryRow("SELECT COUNT(*) FROM users").Scan(&count); err != nil {
+		t.Fatalf("failed to count rows: %v", err)
+	}
+	if count != 3 {
+		t.Fatalf("expected delete not to run, got %d rows", count)
+	}
+}
+
+func TestSQLiteEngine_RunSQL_TruncatesOversizedCells(t *testing.T) {
+	// Arrange
+	engine := setupSQLiteConsoleEngine(t)
+	query := fmt.Sprintf("SELECT zeroblob(%[1]d) AS big_blob, printf('%%.*c', %[1]d, 'x') AS big_text", maxMaterializedRecordCellBytes+1)
+
+	// Act
+	result, err := engine.RunSQL(context.Background(), query, 20)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	values := result.Records[0].Values
+	expectedBlob := fmt.Sprintf("<blob truncated %d bytes>", maxMaterializedRecordCellBytes+1)
+	expectedText := fmt.Sprintf("<truncated %d bytes>", maxMaterializedRecordCellBytes+1)
+	if values[0].Text != expectedBlob || values[1].Text != expectedText {
+		t.Fatalf("expected truncation placeholders, got %q and %q", values[0].Text, truncateForTest(values[1].Text))
+	}
+}
+
+func TestSQLiteEngine_RunSQL_ReportsAffectedRows(t *testing.T) {
+	// Arrange
+	engine := setupSQLiteConsoleEngine(t)
+
+	// Act
+	result, err := engine.RunSQL(context.Background(), "UPDATE users SET name = 'x' WHERE id < 3", 20)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Kind != model.SQLStatementModify || result.RowsAffected != 2 {
+		t.Fatalf("expected 2 modified rows, got %+v", result)
+	}
+}
+
+func TestSQLiteEngine_RunSQL_RunsSchemaStatements(t *testing.T) {
+	// Arrange
+	engine := setupSQLiteConsoleEngine(t)
+
+	// Act
+	result, err := engine.RunSQL(context.Background(), "CREATE TABLE notes (id INTEGER PRIMARY KEY)", 20)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Kind != model.SQLStatementOther || result.RowsAffected != 0 {
+		t.Fatalf("expected other statement without row count, got %+v", result)
+	}
+	if _, err := engine.db.Exec("INSERT INTO notes (id) VALUES (1)"); err != nil {
+		t.Fatalf("expected created table to exist, got %v", err)
+	}
+}
+
+func truncateForTest(value string) string {
+	if len(value) <= 40 {
+		return value
+	}
+	return value[:40] + "… (" + fmt.Sprint(len(value)) + " bytes)"
+}
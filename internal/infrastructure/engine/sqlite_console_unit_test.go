@@ -0,0 +1,74 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+func TestParseConsoleStatement_ClassifiesStatements(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+		sql       string
+		kind      model.SQLStatementKind
+	}{
+		{name: "select", statement: "select * from users", sql: "select * from users", kind: model.SQLStatementQuery},
+		{name: "values", statement: "VALUES (1), (2)", sql: "VALUES (1), (2)", kind: model.SQLStatementQuery},
+		{name: "trailing semicolons and comments", statement: "-- lead\nSELECT 1;; -- done\n", sql: "SELECT 1", kind: model.SQLStatementQuery},
+		{name: "semicolon inside string", statement: "SELECT ';' AS s", sql: "SELECT ';' AS s", kind: model.SQLStatementQuery},
+		{name: "update", statement: "UPDATE users SET name = 'x'", sql: "UPDATE users SET name = 'x'", kind: model.SQLStatementModify},
+		{name: "cte query", statement: "WITH t(x) AS (SELECT 1) SELECT x FROM t", sql: "WITH t(x) AS (SELECT 1) SELECT x FROM t", kind: model.SQLStatementQuery},
+		{name: "cte delete", statement: "WITH old AS (SELECT id FROM users) DELETE FROM users WHERE id IN old", sql: "WITH old AS (SELECT id FROM users) DELETE FROM users WHERE id IN old", kind: model.SQLStatementModify},
+		{name: "pragma", statement: "PRAGMA table_info(users);", sql: "PRAGMA table_info(users)", kind: model.SQLStatementQuery},
+		{name: "schema change", statement: "CREATE TABLE t (id INTEGER)", sql: "CREATE TABLE t (id INTEGER)", kind: model.SQLStatementOther},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+
+			// Act
+			parsed, err := parseConsoleStatement(tc.statement)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if parsed.sql != tc.sql {
+				t.Fatalf("expected sql %q, got %q", tc.sql, parsed.sql)
+			}
+			if parsed.kind != tc.kind {
+				t.Fatalf("expected kind %q, got %q", tc.kind, parsed.kind)
+			}
+		})
+	}
+}
+
+func TestParseConsoleStatement_RejectsUnsupportedInput(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+		err       error
+	}{
+		{name: "empty", statement: " ;\n-- nothing", err: model.ErrMissingSQLStatement},
+		{name: "several statements", statement: "DELETE FROM a; DELETE FROM b", err: model.ErrMultipleSQLStatements},
+		{name: "begin", statement: "BEGIN TRANSACTION", err: model.ErrSQLTransactionControl},
+		{name: "rollback", statement: "rollback;", err: model.ErrSQLTransactionControl},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+
+			// Act
+			_, err := parseConsoleStatement(tc.statement)
+
+			// Assert
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("expected %v, got %v", tc.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+func (e *SQLiteEngine) foreignKeyConstraints(ctx context.Context, tableName string) (constraints []model.ForeignKeyConstraint, err error) {
+	query := fmt.Sprintf("PRAGMA foreign_key_list(%s)", quoteIdentifier(tableName))
+	rows, err := e.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}()
+
+	byID := make(map[int]*model.ForeignKeyConstraint)
+	var ids []int
+	for rows.Next() {
+		var (
+			id       int
+			seq      int
+			refTable string
+			from     string
+			to       sql.NullString
+			onUpdate string
+			onDelete string
+			match    string
+		)
+		if err := rows.Scan(&id, &seq, &refTable, &from, &to, &onUpdate, &onDelete, &match); err != nil {
+			return nil, err
+		}
+		constraint, ok := byID[id]
+		if !ok {
+			constraint = &model.ForeignKeyConstraint{
+				RefTable: refTable,
+				OnUpdate: onUpdate,
+				OnDelete: onDelete,
+			}
+			byID[id] = constraint
+			ids = append(ids, id)
+		}
+		constraint.Columns = append(constraint.Columns, from)
+		if to.Valid {
+			constraint.RefColumns = append(constraint.RefColumns, to.String)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// SQLite numbers foreign keys from the last declared clause; list them
+	// in declaration order.
+	sort.Sort(sort.Reverse(sort.IntSlice(ids)))
+	constraints = make([]model.ForeignKeyConstraint, 0, len(ids))
+	for _, id := range ids {
+		constraints = append(constraints, *byID[id])
+	}
+	return constraints, nil
+}
+
+// uniqueConstraints returns the UNIQUE constraints declared in the table
+// definition, which SQLite backs with automatic indexes.
+func uniqueConstraints(indexes []model.Index) []model.UniqueConstraint {
+	var constraints []model.UniqueConstraint
+	for _, index := range indexes {
+		if index.Origin != model.IndexOriginUnique {
+			continue
+		}
+		constraints = append(constraints, model.UniqueConstraint{
+			Columns: append([]string(nil), index.Columns...),
+		})
+	}
+	return constraints
+}
+
+// checkConstraints extracts column and table CHECK constraints from a stored
+// CREATE TABLE statement, since SQLite exposes no pragma for them.
+func checkConstraints(tableSQL string) []model.CheckConstraint {
+	tokens := tokenizeSQL(tableSQL)
+	var constraints []model.CheckConstraint
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		if token.kind != sqlTokenWord || !strings.EqualFold(token.text, "CHECK") {
+			continue
+		}
+		if i+1 >= len(tokens) || tokens[i+1].text != "(" {
+			continue
+		}
+		closing := matchingParenthesis(tokens, i+1)
+		if closing < 0 {
+			break
+		}
+		constraint := model.CheckConstraint{
+			Expression: strings.TrimSpace(tableSQL[tokens[i+1].end:tokens[closing].start]),
+		}
+		if i >= 2 && tokens[i-2].kind == sqlTokenWord && strings.EqualFold(tokens[i-2].text, "CONSTRAINT") {
+			constraint.Name = identifierText(tokens[i-1])
+		}
+		constraints = append(constraints, constraint)
+		i = closing
+	}
+	return constraints
+}
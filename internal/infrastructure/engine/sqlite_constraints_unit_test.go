@@ -0,0 +1,31 @@
+package engine
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+func TestCheckConstraints_SkipsCommentsLiteralsAndQuotedIdentifiers(t *testing.T) {
+	// Arrange
+	tableSQL := `CREATE TABLE t (
+		-- CHECK (ignored_comment)
+		"check" INTEGER /* CHECK (ignored_block) */,
+		[a] TEXT CONSTRAINT [a_len] CHECK (length([a]) BETWEEN 1 AND (2 + 3)),
+		b TEXT DEFAULT 'CHECK (ignored_literal)',
+		CONSTRAINT ` + "`b``s`" + ` CHECK(b IN ('x', ')'))
+	)`
+
+	// Act
+	checks := checkConstraints(tableSQL)
+
+	// Assert
+	expected := []model.CheckConstraint{
+		{Name: "a_len", Expression: "length([a]) BETWEEN 1 AND (2 + 3)"},
+		{Name: "b`s", Expression: "b IN ('x', ')')"},
+	}
+	if !reflect.DeepEqual(checks, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, checks)
+	}
+}
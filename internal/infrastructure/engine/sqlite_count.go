@@ -0,0 +1,80 @@
+package engine
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+// CountRecords returns the exact number of rows matching filter. It is kept
+// apart from ListRecords so callers can defer it and cancel it through ctx.
+func (e *SQLiteEngine) CountRecords(ctx context.Context, tableName string, filter *model.FilterGroup) (int, error) {
+	clause, args, err := buildFilterClause(filter)
+	if err != nil {
+		return 0, err
+	}
+	query := "SELECT COUNT(*) FROM " + quoteIdentifier(tableName)
+	if clause != "" {
+		query = query + " " + clause
+	}
+	var count int
+	if err := e.db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// EstimateRecordCount reads the table row estimate recorded by ANALYZE in
+// sqlite_stat1. It reports false when no statistics exist for the table.
+func (e *SQLiteEngine) EstimateRecordCount(ctx context.Context, tableName string) (estimate int, ok bool, err error) {
+	const statTableQuery = `
+		SELECT COUNT(*)
+		FROM sqlite_master
+		WHERE type = 'table'
+		  AND name = 'sqlite_stat1'
+	`
+	var statTables int
+	if err := e.db.QueryRowContext(ctx, statTableQuery).Scan(&statTables); err != nil {
+		return 0, false, err
+	}
+	if statTables == 0 {
+		return 0, false, nil
+	}
+
+	rows, err := e.db.QueryContext(ctx, "SELECT stat FROM sqlite_stat1 WHERE tbl = ?", tableName)
+	if err != nil {
+		return 0, false, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}()
+
+	for rows.Next() {
+		var stat string
+		if err := rows.Scan(&stat); err != nil {
+			return 0, false, err
+		}
+		// The first stat field is the row count of the table or index; partial
+		// indexes undercount, so the largest value wins.
+		fields := strings.Fields(stat)
+		if len(fields) == 0 {
+			continue
+		}
+		value, parseErr := strconv.Atoi(fields[0])
+		if parseErr != nil {
+			continue
+		}
+		if !ok || value > estimate {
+			estimate = value
+			ok = true
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, false, err
+	}
+	return estimate, ok, nil
+}
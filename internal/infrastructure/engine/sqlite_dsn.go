@@ -0,0 +1,126 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/mgierok/dbc/internal/sqliteidentity"
+)
+
+var ErrInvalidSQLitePragma = errors.New("invalid sqlite pragma")
+
+// SQLiteOpenOptions adjust how OpenSQLiteDatabaseWithOptions opens a
+// connection string.
+type SQLiteOpenOptions struct {
+	ReadOnly bool
+	Pragmas  SQLitePragmas
+}
+
+// SQLitePragmas are applied to every pooled connection as it connects. Zero
+// values leave SQLite's defaults in place.
+type SQLitePragmas struct {
+	BusyTimeout int
+	JournalMode string
+	ForeignKeys bool
+	Synchronous string
+}
+
+var sqliteJournalModes = map[string]struct{}{
+	"DELETE":   {},
+	"TRUNCATE": {},
+	"PERSIST":  {},
+	"MEMORY":   {},
+	"WAL":      {},
+	"OFF":      {},
+}
+
+var sqliteSynchronousLevels = map[string]struct{}{
+	"OFF":    {},
+	"NORMAL": {},
+	"FULL":   {},
+	"EXTRA":  {},
+	"0":      {},
+	"1":      {},
+	"2":      {},
+	"3":      {},
+}
+
+// sqliteURIPathEscaper escapes the characters that would end the path part
+// of a SQLite file: URI.
+var sqliteURIPathEscaper = strings.NewReplacer("%", "%25", "?", "%3f", "#", "%23")
+
+// sqliteDSN builds the driver data source name for a plain path or file: URI.
+// Plain paths without options are passed through unchanged; otherwise the
+// options are added as URI query parameters, which the driver applies as
+// _pragma statements on every new connection.
+func sqliteDSN(connString string, options SQLiteOpenOptions) (string, error) {
+	pragmas, err := sqlitePragmaStatements(options)
+	if err != nil {
+		return "", err
+	}
+	connString = strings.TrimSpace(connString)
+	isURI := sqliteidentity.IsURI(connString)
+	if !isURI && !options.ReadOnly && len(pragmas) == 0 {
+		return connString, nil
+	}
+
+	base := "file:" + sqliteURIPathEscaper.Replace(connString)
+	query := ""
+	if isURI {
+		base, query = sqliteidentity.SplitURI(connString)
+	}
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return "", fmt.Errorf("parse sqlite uri query: %w", err)
+	}
+	if options.ReadOnly && values.Get("mode") != "memory" {
+		values.Set("mode", "ro")
+	}
+	for _, pragma := range pragmas {
+		values.Add("_pragma", pragma)
+	}
+	if len(values) == 0 {
+		return base, nil
+	}
+	return base + "?" + values.Encode(), nil
+}
+
+// sqlitePragmaStatements validates the configured pragmas and renders them
+// as name(value) statements. Read-only sessions add query_only and skip
+// journal_mode, since switching the journal mode writes to the database.
+func sqlitePragmaStatements(options SQLiteOpenOptions) ([]string, error) {
+	pragmas := options.Pragmas
+	var statements []string
+	if pragmas.BusyTimeout < 0 {
+		return nil, fmt.Errorf("%w: busy_timeout must not be negative, got %d", ErrInvalidSQLitePragma, pragmas.BusyTimeout)
+	}
+	if pragmas.BusyTimeout > 0 {
+		statements = append(statements, "busy_timeout("+strconv.Itoa(pragmas.BusyTimeout)+")")
+	}
+	if pragmas.JournalMode != "" {
+		mode := strings.ToUpper(strings.TrimSpace(pragmas.JournalMode))
+		if _, ok := sqliteJournalModes[mode]; !ok {
+			return nil, fmt.Errorf("%w: unknown journal_mode %q", ErrInvalidSQLitePragma, pragmas.JournalMode)
+		}
+		if !options.ReadOnly {
+			statements = append(statements, "journal_mode("+mode+")")
+		}
+	}
+	if pragmas.ForeignKeys {
+		statements = append(statements, "foreign_keys(1)")
+	}
+	if pragmas.Synchronous != "" {
+		level := strings.ToUpper(strings.TrimSpace(pragmas.Synchronous))
+		if _, ok := sqliteSynchronousLevels[level]; !ok {
+			return nil, fmt.Errorf("%w: unknown synchronous %q", ErrInvalidSQLitePragma, pragmas.Synchronous)
+		}
+		statements = append(statements, "synchronous("+level+")")
+	}
+	if options.ReadOnly {
+		statements = append(statements, "query_only(1)")
+	}
+	return statements, nil
+}
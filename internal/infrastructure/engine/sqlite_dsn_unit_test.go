@@ -0,0 +1,95 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSQLiteDSN(t *testing.T) {
+	tests := []struct {
+		name       string
+		connString string
+		options    SQLiteOpenOptions
+		want       string
+	}{
+		{
+			name:       "plain path without options",
+			connString: "/data/app.sqlite",
+			want:       "/data/app.sqlite",
+		},
+		{
+			name:       "uri keeps its parameters",
+			connString: "file:/data/app.sqlite?immutable=1&cache=shared",
+			want:       "file:/data/app.sqlite?cache=shared&immutable=1",
+		},
+		{
+			name:       "read-only plain path becomes escaped uri",
+			connString: "/data/app #1.sqlite",
+			options:    SQLiteOpenOptions{ReadOnly: true},
+			want:       "file:/data/app %231.sqlite?_pragma=query_only%281%29&mode=ro",
+		},
+		{
+			name:       "read-only overrides uri mode",
+			connString: "file:/data/app.sqlite?mode=rw",
+			options:    SQLiteOpenOptions{ReadOnly: true},
+			want:       "file:/data/app.sqlite?_pragma=query_only%281%29&mode=ro",
+		},
+		{
+			name:       "pragmas are added in declaration order",
+			connString: "/data/app.sqlite",
+			options: SQLiteOpenOptions{Pragmas: SQLitePragmas{
+				BusyTimeout: 5000,
+				JournalMode: "wal",
+				ForeignKeys: true,
+				Synchronous: "normal",
+			}},
+			want: "file:/data/app.sqlite?_pragma=busy_timeout%285000%29&_pragma=journal_mode%28WAL%29&_pragma=foreign_keys%281%29&_pragma=synchronous%28NORMAL%29",
+		},
+		{
+			name:       "read-only skips journal mode",
+			connString: "file:/data/app.sqlite",
+			options: SQLiteOpenOptions{ReadOnly: true, Pragmas: SQLitePragmas{
+				JournalMode: "WAL",
+			}},
+			want: "file:/data/app.sqlite?_pragma=query_only%281%29&mode=ro",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			got, err := sqliteDSN(tt.connString, tt.options)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("expected dsn %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSQLiteDSN_RejectsInvalidPragmas(t *testing.T) {
+	tests := []struct {
+		name    string
+		pragmas SQLitePragmas
+	}{
+		{name: "negative busy timeout", pragmas: SQLitePragmas{BusyTimeout: -1}},
+		{name: "unknown journal mode", pragmas: SQLitePragmas{JournalMode: "WAL); DROP TABLE users; --"}},
+		{name: "unknown synchronous level", pragmas: SQLitePragmas{Synchronous: "sometimes"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			_, err := sqliteDSN("/data/app.sqlite", SQLiteOpenOptions{Pragmas: tt.pragmas})
+
+			// Assert
+			if !errors.Is(err, ErrInvalidSQLitePragma) {
+				t.Fatalf("expected ErrInvalidSQLitePragma, got %v", err)
+			}
+		})
+	}
+}
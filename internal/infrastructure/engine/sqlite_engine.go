@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/mgierok/dbc/internal/application/port"
@@ -23,9 +24,9 @@ func NewSQLiteEngine(db *sql.DB) *SQLiteEngine {
 
 func (e *SQLiteEngine) ListTables(ctx context.Context) (tables []model.Table, err error) {
 	const query = `
-		SELECT name
+		SELECT name, type
 		FROM sqlite_master
-		WHERE type = 'table'
+		WHERE type IN ('table', 'view')
 		  AND name NOT LIKE 'sqlite_%'
 	`
 	rows, err := e.db.QueryContext(ctx, query)
@@ -39,15 +40,25 @@ func (e *SQLiteEngine) ListTables(ctx context.Context) (tables []model.Table, er
 	}()
 
 	for rows.Next() {
-		var name string
-		if err := rows.Scan(&name); err != nil {
+		var name, kind string
+		if err := rows.Scan(&name, &kind); err != nil {
 			return nil, err
 		}
-		tables = append(tables, model.Table{Name: name})
+		tables = append(tables, model.Table{Name: name, Kind: model.TableKind(kind)})
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
+
+	triggers, err := e.viewWriteTriggers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range tables {
+		if tables[i].Kind == model.TableKindView {
+			tables[i].InsteadOf = triggers[strings.ToLower(tables[i].Name)]
+		}
+	}
 	return tables, nil
 }
 
@@ -62,31 +73,119 @@ func (e *SQLiteEngine) GetSchema(ctx context.Context, tableName string) (schema
 		columns[i] = column.toModelColumn()
 	}
 
+	kind, err := e.tableKind(ctx, tableName)
+	if err != nil {
+		return model.Schema{}, err
+	}
+	indexes, err := e.tableIndexes(ctx, tableName)
+	if err != nil {
+		return model.Schema{}, err
+	}
+	triggers, err := e.tableTriggers(ctx, tableName)
+	if err != nil {
+		return model.Schema{}, err
+	}
+	definition, err := e.schemaObjectSQL(ctx, string(kind), tableName)
+	if err != nil {
+		return model.Schema{}, err
+	}
+	foreignKeys, err := e.foreignKeyConstraints(ctx, tableName)
+	if err != nil {
+		return model.Schema{}, err
+	}
+	var checks []model.CheckConstraint
+	if kind == model.TableKindTable {
+		checks = checkConstraints(definition)
+	}
+
 	return model.Schema{
-		Table:   model.Table{Name: tableName},
-		Columns: columns,
+		Table:             model.Table{Name: tableName, Kind: kind},
+		Columns:           columns,
+		Indexes:           indexes,
+		Triggers:          triggers,
+		ForeignKeys:       foreignKeys,
+		UniqueConstraints: uniqueConstraints(indexes),
+		CheckConstraints:  checks,
+		SQL:               definition,
 	}, nil
 }
 
-func (e *SQLiteEngine) ListRecords(ctx context.Context, tableName string, offset, limit int, filter *model.Filter, sort *model.Sort) (page model.RecordPage, err error) {
-	if limit <= 0 {
-		return model.RecordPage{}, nil
-	}
+func (e *SQLiteEngine) ListRecords(ctx context.Context, tableName string, offset, limit int, filter *model.FilterGroup, sorts []model.Sort) (model.RecordPage, error) {
 	if offset < 0 {
 		offset = 0
 	}
+	return e.listRecords(ctx, tableName, offset, "", limit, filter, sorts)
+}
+
+// ListRecordsFromCursor reads the page next to or before the row encoded in
+// cursor, seeking on the ORDER BY keys instead of scanning past an OFFSET.
+// An empty cursor reads the first page.
+func (e *SQLiteEngine) ListRecordsFromCursor(ctx context.Context, tableName, cursor string, limit int, filter *model.FilterGroup, sorts []model.Sort) (model.RecordPage, error) {
+	return e.listRecords(ctx, tableName, 0, cursor, limit, filter, sorts)
+}
+
+func (e *SQLiteEngine) listRecords(ctx context.Context, tableName string, offset int, cursor string, limit int, filter *model.FilterGroup, sorts []model.Sort) (page model.RecordPage, err error) {
+	if limit <= 0 {
+		return model.RecordPage{}, nil
+	}
 
 	columnInfos, err := e.tableColumnInfos(ctx, tableName)
 	if err != nil {
 		return model.RecordPage{}, err
 	}
-	pkColumns := primaryKeyColumnsInOrder(columnInfos)
-	selectParts := make([]string, 0, len(columnInfos)*2+len(pkColumns)+1)
+	kind, err := e.tableKind(ctx, tableName)
+	if err != nil {
+		return model.RecordPage{}, err
+	}
+	identityKeyColumns, err := e.identityColumns(ctx, tableName, kind, columnInfos)
+	if err != nil {
+		return model.RecordPage{}, err
+	}
+	// Views have neither rowid nor primary key: their identity orders by every
+	// column, and they page by OFFSET only, since identical rows would make
+	// seeking skip rows.
+	keyset := kind != model.TableKindView
+	terms, err := e.buildSortTerms(ctx, tableName, sorts, identityKeyColumns)
+	if err != nil {
+		return model.RecordPage{}, err
+	}
+	queryTerms := terms
+	backward := false
+	var seekClause string
+	var seekArgs []any
+	if cursor != "" && !keyset {
+		return model.RecordPage{}, ErrInvalidRecordCursor
+	}
+	if cursor != "" {
+		decoded, values, err := decodeRecordCursor(cursor, terms)
+		if err != nil {
+			return model.RecordPage{}, err
+		}
+		backward = decoded.Backward
+		if backward {
+			queryTerms = make([]sqliteSortTerm, len(terms))
+			for i, term := range terms {
+				queryTerms[i] = term.reversed()
+			}
+		}
+		seekClause, seekArgs = buildSeekExpression(queryTerms, values)
+	}
+
+	selectParts := make([]string, 0, len(columnInfos)*2+len(identityKeyColumns)+len(terms)+1)
 	for index, column := range columnInfos {
 		selectParts = append(selectParts, displayProjectionForColumn(column, index))
 	}
 	selectParts = appendEditableProjection(selectParts, columnInfos)
-	selectParts = appendIdentityProjection(selectParts, pkColumns)
+	uniqueIn := ""
+	if kind == model.TableKindView {
+		uniqueIn = tableName
+	}
+	selectParts = appendIdentityProjection(selectParts, identityKeyColumns, uniqueIn)
+	seekTerms := terms
+	if !keyset {
+		seekTerms = nil
+	}
+	selectParts = appendSeekProjection(selectParts, seekTerms)
 
 	var queryBuilder strings.Builder
 	queryBuilder.WriteString("SELECT ")
@@ -98,29 +197,20 @@ func (e *SQLiteEngine) ListRecords(ctx context.Context, tableName string, offset
 	if err != nil {
 		return model.RecordPage{}, err
 	}
-	sortClause, err := e.buildSortClause(ctx, tableName, sort)
-	if err != nil {
-		return model.RecordPage{}, err
-	}
 
-	var countQueryBuilder strings.Builder
-	countQueryBuilder.WriteString("SELECT COUNT(*) FROM ")
-	countQueryBuilder.WriteString(quoteIdentifier(tableName))
-	countQuery := countQueryBuilder.String()
-	if clause != "" {
+	queryArgs := append([]any{}, args...)
+	switch {
+	case clause != "" && seekClause != "":
+		query = query + " WHERE (" + strings.TrimPrefix(clause, "WHERE ") + ") AND " + seekClause
+		queryArgs = append(queryArgs, seekArgs...)
+	case seekClause != "":
+		query = query + " WHERE " + seekClause
+		queryArgs = append(queryArgs, seekArgs...)
+	case clause != "":
 		query = query + " " + clause
-		countQuery = countQuery + " " + clause
-	}
-	var totalCount int
-	if err := e.db.QueryRowContext(ctx, countQuery, args...).Scan(&totalCount); err != nil {
-		return model.RecordPage{}, err
-	}
-
-	if sortClause != "" {
-		query = query + " " + sortClause
 	}
-	query += " LIMIT ? OFFSET ?"
-	queryArgs := append(append([]any{}, args...), limit+1, offset)
+	query = query + " " + sortClause(queryTerms) + " LIMIT ? OFFSET ?"
+	queryArgs = append(queryArgs, limit+1, offset)
 
 	rows, err := e.db.QueryContext(ctx, query, queryArgs...)
 	if err != nil {
@@ -133,13 +223,16 @@ func (e *SQLiteEngine) ListRecords(ctx context.Context, tableName string, offset
 	}()
 
 	records := make([]model.Record, 0, limit)
+	seekRows := make([][]any, 0, limit)
 	displayColumnCount := len(columnInfos)
 	editableColumnCount := len(columnInfos)
 	identityColumnOffset := displayColumnCount + editableColumnCount
-	scanValues := make([]any, identityColumnOffset+len(pkColumns))
-	if len(pkColumns) > 0 {
+	scanValues := make([]any, identityColumnOffset+len(identityKeyColumns))
+	if len(identityKeyColumns) > 0 {
 		scanValues = append(scanValues, nil)
 	}
+	seekColumnOffset := len(scanValues)
+	scanValues = append(scanValues, make([]any, len(seekTerms))...)
 	destinations := make([]any, len(scanValues))
 	for i := range scanValues {
 		destinations[i] = &scanValues[i]
@@ -157,14 +250,18 @@ func (e *SQLiteEngine) ListRecords(ctx context.Context, tableName string, offset
 			record.Values[i] = materializeDisplayValue(scanValues[i])
 			record.EditableFromBrowse[i] = projectedFlagEnabled(scanValues[displayColumnCount+i])
 		}
-		if len(pkColumns) > 0 {
-			identityFlagIndex := identityColumnOffset + len(pkColumns)
+		if len(identityKeyColumns) > 0 {
+			identityFlagIndex := identityColumnOffset + len(identityKeyColumns)
 			if identityAvailable(scanValues[identityFlagIndex]) {
-				keys := make([]model.RecordIdentityKey, 0, len(pkColumns))
-				for i, column := range pkColumns {
-					value, err := materializeIdentityValue(column.typ, scanValues[identityColumnOffset+i])
-					if err != nil {
-						return model.RecordPage{}, err
+				keys := make([]model.RecordIdentityKey, 0, len(identityKeyColumns))
+				for i, column := range identityKeyColumns {
+					raw := scanValues[identityColumnOffset+i]
+					value := materializeViewIdentityValue(raw)
+					if keyset {
+						value, err = materializeIdentityValue(column.typ, raw)
+						if err != nil {
+							return model.RecordPage{}, err
+						}
 					}
 					keys = append(keys, model.RecordIdentityKey{
 						Column: column.name,
@@ -178,6 +275,7 @@ func (e *SQLiteEngine) ListRecords(ctx context.Context, tableName string, offset
 			}
 		}
 		records = append(records, record)
+		seekRows = append(seekRows, append([]any(nil), scanValues[seekColumnOffset:]...))
 	}
 	if err := rows.Err(); err != nil {
 		return model.RecordPage{}, err
@@ -187,13 +285,29 @@ func (e *SQLiteEngine) ListRecords(ctx context.Context, tableName string, offset
 	if len(records) > limit {
 		hasMore = true
 		records = records[:limit]
+		seekRows = seekRows[:limit]
+	}
+	if backward {
+		// Rows were read in reversed order; restore display order. A backward
+		// page always has the cursor row after it.
+		slices.Reverse(records)
+		slices.Reverse(seekRows)
+		hasMore = true
 	}
 
-	return model.RecordPage{
-		Records:    records,
-		HasMore:    hasMore,
-		TotalCount: totalCount,
-	}, nil
+	page = model.RecordPage{
+		Records: records,
+		HasMore: hasMore,
+	}
+	if keyset && len(records) > 0 {
+		if page.NextCursor, err = buildRecordCursor(terms, seekRows[len(seekRows)-1], false); err != nil {
+			return model.RecordPage{}, err
+		}
+		if page.PrevCursor, err = buildRecordCursor(terms, seekRows[0], true); err != nil {
+			return model.RecordPage{}, err
+		}
+	}
+	return page, nil
 }
 
 func (e *SQLiteEngine) ListOperators(ctx context.Context, columnType string) ([]model.Operator, error) {
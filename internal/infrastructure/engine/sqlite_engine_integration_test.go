@@ -52,6 +52,52 @@ func TestSQLiteEngine_ListTables_ReturnsUserTablesWithoutSQLiteInternals(t *test
 	}
 }
 
+func TestSQLiteEngine_ListTables_ReturnsViewsWithInsteadOfTriggers(t *testing.T) {
+	// Arrange
+	db := setupSQLiteSchemaDB(t, `
+		CREATE TABLE users (
+			id INTEGER PRIMARY KEY,
+			name TEXT NOT NULL
+		);
+		CREATE VIEW user_names AS SELECT name FROM users;
+		CREATE VIEW editable_users AS SELECT id, name FROM users;
+		CREATE TRIGGER editable_users_insert INSTEAD OF INSERT ON editable_users
+		BEGIN
+			INSERT INTO users (id, name) VALUES (NEW.id, NEW.name);
+		END;
+		CREATE TRIGGER editable_users_delete
+		instead   of DELETE ON editable_users
+		BEGIN
+			DELETE FROM users WHERE id = OLD.id;
+		END;
+		CREATE TRIGGER users_audit AFTER INSERT ON users
+		BEGIN
+			SELECT 1;
+		END;
+	`)
+	engine := NewSQLiteEngine(db)
+
+	// Act
+	tables, err := engine.ListTables(context.Background())
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	byName := make(map[string]model.Table, len(tables))
+	for _, table := range tables {
+		byName[table.Name] = table
+	}
+	expected := map[string]model.Table{
+		"users":          {Name: "users", Kind: model.TableKindTable},
+		"user_names":     {Name: "user_names", Kind: model.TableKindView},
+		"editable_users": {Name: "editable_users", Kind: model.TableKindView, InsteadOf: model.TableWriteTriggers{Insert: true, Delete: true}},
+	}
+	if !reflect.DeepEqual(byName, expected) {
+		t.Fatalf("expected tables %+v, got %+v", expected, byName)
+	}
+}
+
 func TestSQLiteEngine_ListOperators_ReturnsSQLiteOperatorContract(t *testing.T) {
 	// Arrange
 	db := setupSQLiteSchemaDB(t, `
@@ -200,7 +246,80 @@ func TestSQLiteEngine_GetSchema_DoesNotMarkPartialUniqueIndexAsPerColumnUnique(t
 	}
 }
 
-func TestSQLiteEngine_GetSchema_MapsCompositeForeignKeysPerSourceColumn(t *testing.T) {
+func TestSQLiteEngine_GetSchema_MapsIndexesTriggersAndDefinition(t *testing.T) {
+	// Arrange
+	db := setupSQLiteSchemaDB(t, `
+		CREATE TABLE users (
+			id INTEGER PRIMARY KEY,
+			email TEXT NOT NULL UNIQUE,
+			name TEXT,
+			deleted_at TEXT
+		);
+		CREATE INDEX users_name_email_idx ON users(name, email DESC);
+		CREATE INDEX users_active_lower_name_idx ON users(lower(name)) WHERE deleted_at IS NULL;
+		CREATE TABLE audit (user_id INTEGER);
+		CREATE TRIGGER users_audit AFTER DELETE ON users BEGIN
+			INSERT INTO audit(user_id) VALUES (OLD.id);
+		END;
+	`)
+	engine := NewSQLiteEngine(db)
+
+	// Act
+	schema, err := engine.GetSchema(context.Background(), "users")
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !strings.HasPrefix(schema.SQL, "CREATE TABLE users") {
+		t.Fatalf("expected table DDL, got %q", schema.SQL)
+	}
+	if schema.Table.Kind != model.TableKindTable {
+		t.Fatalf("expected table kind, got %q", schema.Table.Kind)
+	}
+	expectedIndexes := []model.Index{
+		{Name: "sqlite_autoindex_users_1", Columns: []string{"email"}, Unique: true, Origin: model.IndexOriginUnique},
+		{Name: "users_name_email_idx", Columns: []string{"name", "email DESC"}, Origin: model.IndexOriginCreate, SQL: "CREATE INDEX users_name_email_idx ON users(name, email DESC)"},
+		{Name: "users_active_lower_name_idx", Columns: []string{"<expr>"}, Partial: true, Origin: model.IndexOriginCreate, SQL: "CREATE INDEX users_active_lower_name_idx ON users(lower(name)) WHERE deleted_at IS NULL"},
+	}
+	if !reflect.DeepEqual(schema.Indexes, expectedIndexes) {
+		t.Fatalf("expected indexes %+v, got %+v", expectedIndexes, schema.Indexes)
+	}
+	if len(schema.Triggers) != 1 || schema.Triggers[0].Name != "users_audit" {
+		t.Fatalf("expected users_audit trigger, got %+v", schema.Triggers)
+	}
+	if !strings.Contains(schema.Triggers[0].SQL, "AFTER DELETE ON users") {
+		t.Fatalf("expected trigger DDL, got %q", schema.Triggers[0].SQL)
+	}
+}
+
+func TestSQLiteEngine_GetSchema_MapsViewDefinition(t *testing.T) {
+	// Arrange
+	db := setupSQLiteSchemaDB(t, `
+		CREATE TABLE users (id INTEGER PRIMARY KEY, name TEXT);
+		CREATE VIEW user_names AS SELECT name FROM users;
+	`)
+	engine := NewSQLiteEngine(db)
+
+	// Act
+	schema, err := engine.GetSchema(context.Background(), "user_names")
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if schema.Table.Kind != model.TableKindView {
+		t.Fatalf("expected view kind, got %q", schema.Table.Kind)
+	}
+	if schema.SQL != "CREATE VIEW user_names AS SELECT name FROM users" {
+		t.Fatalf("expected view DDL, got %q", schema.SQL)
+	}
+	if len(schema.Indexes) != 0 {
+		t.Fatalf("expected no indexes on view, got %+v", schema.Indexes)
+	}
+}
+
+func TestSQLiteEngine_GetSchema_KeepsCompositeForeignKeysTableLevel(t *testing.T) {
 	// Arrange
 	db := setupSQLiteSchemaDB(t, `
 		PRAGMA foreign_keys = ON;
@@ -213,7 +332,7 @@ func TestSQLiteEngine_GetSchema_MapsCompositeForeignKeysPerSourceColumn(t *testi
 
 		CREATE TABLE children (
 			child_parent_id INTEGER NOT NULL,
-			child_parent_code TEXT NOT NULL,
+			child_parent_code TEXT NOT NULL REFERENCES parents(parent_code),
 			FOREIGN KEY (child_parent_id, child_parent_code)
 				REFERENCES parents(parent_id, parent_code)
 		);
@@ -227,11 +346,138 @@ func TestSQLiteEngine_GetSchema_MapsCompositeForeignKeysPerSourceColumn(t *testi
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
-	if got := schema.Columns[0].ForeignKeys; !reflect.DeepEqual(got, []model.ForeignKeyRef{{Table: "parents", Column: "parent_id"}}) {
-		t.Fatalf("expected child_parent_id foreign key mapping, got %v", got)
+	if got := schema.Columns[0].ForeignKeys; got != nil {
+		t.Fatalf("expected no column reference for a composite key member, got %v", got)
 	}
 	if got := schema.Columns[1].ForeignKeys; !reflect.DeepEqual(got, []model.ForeignKeyRef{{Table: "parents", Column: "parent_code"}}) {
-		t.Fatalf("expected child_parent_code foreign key mapping, got %v", got)
+		t.Fatalf("expected only the single-column reference of child_parent_code, got %v", got)
+	}
+	if len(schema.ForeignKeys) != 2 {
+		t.Fatalf("expected both foreign keys as table-level constraints, got %+v", schema.ForeignKeys)
+	}
+}
+
+func TestSQLiteEngine_GetSchema_MapsTableLevelConstraints(t *testing.T) {
+	// Arrange
+	db := setupSQLiteSchemaDB(t, `
+		CREATE TABLE parents (
+			parent_id INTEGER NOT NULL,
+			parent_code TEXT NOT NULL,
+			PRIMARY KEY (parent_id, parent_code)
+		);
+		CREATE TABLE owners (id INTEGER PRIMARY KEY);
+
+		CREATE TABLE children (
+			child_parent_id INTEGER NOT NULL,
+			child_parent_code TEXT NOT NULL,
+			owner_id INTEGER REFERENCES owners ON DELETE SET NULL,
+			qty INTEGER CHECK (qty > 0),
+			label TEXT,
+			CONSTRAINT "label check" CHECK (label <> 'CHECK (x)' AND length(label) < 10),
+			UNIQUE (child_parent_id, label),
+			FOREIGN KEY (child_parent_id, child_parent_code)
+				REFERENCES parents(parent_id, parent_code)
+				ON DELETE CASCADE ON UPDATE RESTRICT
+		);
+	`)
+	engine := NewSQLiteEngine(db)
+
+	// Act
+	schema, err := engine.GetSchema(context.Background(), "children")
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	expectedForeignKeys := []model.ForeignKeyConstraint{
+		{Columns: []string{"owner_id"}, RefTable: "owners", OnUpdate: "NO ACTION", OnDelete: "SET NULL"},
+		{
+			Columns:    []string{"child_parent_id", "child_parent_code"},
+			RefTable:   "parents",
+			RefColumns: []string{"parent_id", "parent_code"},
+			OnUpdate:   "RESTRICT",
+			OnDelete:   "CASCADE",
+		},
+	}
+	if !reflect.DeepEqual(schema.ForeignKeys, expectedForeignKeys) {
+		t.Fatalf("expected foreign keys %+v, got %+v", expectedForeignKeys, schema.ForeignKeys)
+	}
+	expectedUnique := []model.UniqueConstraint{{Columns: []string{"child_parent_id", "label"}}}
+	if !reflect.DeepEqual(schema.UniqueConstraints, expectedUnique) {
+		t.Fatalf("expected unique constraints %+v, got %+v", expectedUnique, schema.UniqueConstraints)
+	}
+	expectedChecks := []model.CheckConstraint{
+		{Expression: "qty > 0"},
+		{Name: "label check", Expression: "label <> 'CHECK (x)' AND length(label) < 10"},
+	}
+	if !reflect.DeepEqual(schema.CheckConstraints, expectedChecks) {
+		t.Fatalf("expected check constraints %+v, got %+v", expectedChecks, schema.CheckConstraints)
+	}
+}
+
+func TestSQLiteEngine_GetSchema_MapsGeneratedColumns(t *testing.T) {
+	// Arrange
+	db := setupSQLiteSchemaDB(t, `
+		CREATE TABLE items (
+			id INTEGER PRIMARY KEY,
+			price REAL NOT NULL,
+			qty INTEGER NOT NULL,
+			total REAL GENERATED ALWAYS AS (price * qty) VIRTUAL,
+			label TEXT AS (upper(substr('item,' || id, 1, 10))) STORED
+		);
+	`)
+	engine := NewSQLiteEngine(db)
+
+	// Act
+	schema, err := engine.GetSchema(context.Background(), "items")
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(schema.Columns) != 5 {
+		t.Fatalf("expected generated columns in schema, got %d columns", len(schema.Columns))
+	}
+	total := schema.Columns[3]
+	if !total.Generated || total.GeneratedStored || total.GenerationExpression != "price * qty" {
+		t.Fatalf("expected virtual generated total, got %+v", total)
+	}
+	label := schema.Columns[4]
+	if !label.Generated || !label.GeneratedStored || label.GenerationExpression != "upper(substr('item,' || id, 1, 10))" {
+		t.Fatalf("expected stored generated label, got %+v", label)
+	}
+	if schema.Columns[1].Generated {
+		t.Fatalf("expected ordinary column not to be generated")
+	}
+}
+
+func TestSQLiteEngine_ListRecords_ShowsAndSortsByGeneratedColumns(t *testing.T) {
+	// Arrange
+	db := setupSQLiteSchemaDB(t, `
+		CREATE TABLE items (
+			id INTEGER PRIMARY KEY,
+			price REAL NOT NULL,
+			qty INTEGER NOT NULL,
+			total REAL GENERATED ALWAYS AS (price * qty) VIRTUAL
+		);
+		INSERT INTO items (id, price, qty) VALUES (1, 2.5, 4), (2, 1, 3), (3, 10, 1);
+	`)
+	engine := NewSQLiteEngine(db)
+
+	// Act
+	page, err := engine.ListRecords(context.Background(), "items", 0, 10, nil, []model.Sort{{Column: "total", Direction: model.SortDirectionDesc}})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	got := make([]string, len(page.Records))
+	for i, record := range page.Records {
+		got[i] = record.Values[0].Text + ":" + record.Values[3].Text
+	}
+	expected := []string{"1:10.0", "3:10.0", "2:3.0"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected records %v, got %v", expected, got)
 	}
 }
 
@@ -248,14 +494,14 @@ func TestSQLiteEngine_ListRecords_AppliesFilterAndPagination(t *testing.T) {
 		       (3, 'alice');
 	`)
 	engine := NewSQLiteEngine(db)
-	filter := &model.Filter{
+	filter := singleConditionFilter(model.Filter{
 		Column: "name",
 		Operator: model.Operator{
 			Kind:          model.OperatorKindEq,
 			RequiresValue: true,
 		},
 		Value: "alice",
-	}
+	})
 
 	// Act
 	page, err := engine.ListRecords(context.Background(), "users", 0, 1, filter, nil)
@@ -270,14 +516,154 @@ func TestSQLiteEngine_ListRecords_AppliesFilterAndPagination(t *testing.T) {
 	if !page.HasMore {
 		t.Fatal("expected hasMore to be true when filtered result exceeds page size")
 	}
-	if page.TotalCount != 2 {
-		t.Fatalf("expected total count 2 for filtered result, got %d", page.TotalCount)
-	}
 	if got := page.Records[0].Values[1].Text; got != "alice" {
 		t.Fatalf("expected name alice, got %q", got)
 	}
 }
 
+func TestSQLiteEngine_ListRecords_AppliesCompoundFilterGroups(t *testing.T) {
+	// Arrange
+	db := setupSQLiteSchemaDB(t, `
+		CREATE TABLE jobs (
+			id INTEGER PRIMARY KEY,
+			status TEXT NOT NULL,
+			created_at TEXT NOT NULL,
+			owner TEXT
+		);
+		INSERT INTO jobs (id, status, created_at, owner)
+		VALUES (1, 'failed', '2025-12-31', 'ops'),
+		       (2, 'failed', '2026-02-01', 'ops'),
+		       (3, 'failed', '2026-03-01', NULL),
+		       (4, 'done', '2026-03-01', 'ops'),
+		       (5, 'failed', '2026-04-01', 'dev');
+	`)
+	engine := NewSQLiteEngine(db)
+	filter := &model.FilterGroup{
+		Logic: model.FilterLogicAnd,
+		Conditions: []model.Filter{
+			{
+				Column:   "status",
+				Operator: model.Operator{Kind: model.OperatorKindEq, RequiresValue: true},
+				Value:    "failed",
+			},
+			{
+				Column:   "created_at",
+				Operator: model.Operator{Kind: model.OperatorKindGt, RequiresValue: true},
+				Value:    "2026-01-01",
+			},
+		},
+		Groups: []model.FilterGroup{
+			{
+				Logic: model.FilterLogicOr,
+				Conditions: []model.Filter{
+					{
+						Column:   "owner",
+						Operator: model.Operator{Kind: model.OperatorKindEq, RequiresValue: true},
+						Value:    "ops",
+					},
+					{
+						Column:   "owner",
+						Operator: model.Operator{Kind: model.OperatorKindIsNull},
+					},
+				},
+			},
+		},
+	}
+
+	// Act
+	page, err := engine.ListRecords(context.Background(), "jobs", 0, 10, filter, nil)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	ids := make([]string, len(page.Records))
+	for i, record := range page.Records {
+		ids[i] = record.Values[0].Text
+	}
+	if !reflect.DeepEqual(ids, []string{"2", "3"}) {
+		t.Fatalf("expected records [2 3], got %v", ids)
+	}
+}
+
+func TestSQLiteEngine_ListRecords_AppliesTypeAwareOperators(t *testing.T) {
+	// Arrange
+	db := setupSQLiteSchemaDB(t, `
+		CREATE TABLE items (
+			id INTEGER PRIMARY KEY,
+			name TEXT NOT NULL,
+			price INTEGER NOT NULL
+		);
+		INSERT INTO items (id, name, price)
+		VALUES (1, 'Apple', 5),
+		       (2, 'apple pie', 12),
+		       (3, 'Banana', 8),
+		       (4, '50% off', 20),
+		       (5, 'cherry', 30);
+	`)
+	engine := NewSQLiteEngine(db)
+	testCases := []struct {
+		name        string
+		filter      model.Filter
+		expectedIDs []string
+	}{
+		{
+			name:        "equals ignore case",
+			filter:      model.Filter{Column: "name", Operator: model.Operator{Kind: model.OperatorKindEqNoCase, RequiresValue: true}, Value: "APPLE"},
+			expectedIDs: []string{"1"},
+		},
+		{
+			name:        "starts with",
+			filter:      model.Filter{Column: "name", Operator: model.Operator{Kind: model.OperatorKindStarts, RequiresValue: true}, Value: "apple"},
+			expectedIDs: []string{"1", "2"},
+		},
+		{
+			name:        "contains literal percent",
+			filter:      model.Filter{Column: "name", Operator: model.Operator{Kind: model.OperatorKindContains, RequiresValue: true}, Value: "0%"},
+			expectedIDs: []string{"4"},
+		},
+		{
+			name:        "glob",
+			filter:      model.Filter{Column: "name", Operator: model.Operator{Kind: model.OperatorKindGlob, RequiresValue: true}, Value: "[A-Z]*"},
+			expectedIDs: []string{"1", "3"},
+		},
+		{
+			name:        "between",
+			filter:      model.Filter{Column: "price", Operator: model.Operator{Kind: model.OperatorKindBetween, RequiresValue: true, ValueMode: model.OperatorValueRange}, Values: []string{"8", "20"}},
+			expectedIDs: []string{"2", "3", "4"},
+		},
+		{
+			name:        "in",
+			filter:      model.Filter{Column: "id", Operator: model.Operator{Kind: model.OperatorKindIn, RequiresValue: true, ValueMode: model.OperatorValueList}, Values: []string{"2", "5"}},
+			expectedIDs: []string{"2", "5"},
+		},
+		{
+			name:        "not like",
+			filter:      model.Filter{Column: "name", Operator: model.Operator{Kind: model.OperatorKindNotLike, RequiresValue: true}, Value: "%a%"},
+			expectedIDs: []string{"4", "5"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			page, err := engine.ListRecords(context.Background(), "items", 0, 10, singleConditionFilter(tc.filter), nil)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			ids := make([]string, len(page.Records))
+			for i, record := range page.Records {
+				ids[i] = record.Values[0].Text
+			}
+			if !reflect.DeepEqual(ids, tc.expectedIDs) {
+				t.Fatalf("expected records %v, got %v", tc.expectedIDs, ids)
+			}
+		})
+	}
+}
+
 func TestSQLiteEngine_ListRecords_SupportsQuotedTableName(t *testing.T) {
 	// Arrange
 	db := setupSQLiteSchemaDB(t, `
@@ -303,9 +689,6 @@ func TestSQLiteEngine_ListRecords_SupportsQuotedTableName(t *testing.T) {
 	if page.HasMore {
 		t.Fatal("expected hasMore to be false for single row result")
 	}
-	if page.TotalCount != 1 {
-		t.Fatalf("expected total count 1, got %d", page.TotalCount)
-	}
 	if got := page.Records[0].Values[1].Text; got != "entry" {
 		t.Fatalf("expected note entry, got %q", got)
 	}
@@ -326,14 +709,14 @@ func TestSQLiteEngine_ListRecords_AppliesSortAscAndDesc(t *testing.T) {
 	engine := NewSQLiteEngine(db)
 
 	// Act
-	ascPage, ascErr := engine.ListRecords(context.Background(), "users", 0, 10, nil, &model.Sort{
+	ascPage, ascErr := engine.ListRecords(context.Background(), "users", 0, 10, nil, []model.Sort{{
 		Column:    "name",
 		Direction: model.SortDirectionAsc,
-	})
-	descPage, descErr := engine.ListRecords(context.Background(), "users", 0, 10, nil, &model.Sort{
+	}})
+	descPage, descErr := engine.ListRecords(context.Background(), "users", 0, 10, nil, []model.Sort{{
 		Column:    "name",
 		Direction: model.SortDirectionDesc,
-	})
+	}})
 
 	// Assert
 	if ascErr != nil {
@@ -364,10 +747,10 @@ func TestSQLiteEngine_ListRecords_SortsIntegersByStoredValue(t *testing.T) {
 	engine := NewSQLiteEngine(db)
 
 	// Act
-	page, err := engine.ListRecords(context.Background(), "scores", 0, 10, nil, &model.Sort{
+	page, err := engine.ListRecords(context.Background(), "scores", 0, 10, nil, []model.Sort{{
 		Column:    "score",
 		Direction: model.SortDirectionAsc,
-	})
+	}})
 
 	// Assert
 	if err != nil {
@@ -392,10 +775,10 @@ func TestSQLiteEngine_ListRecords_SortsRealsByStoredValue(t *testing.T) {
 	engine := NewSQLiteEngine(db)
 
 	// Act
-	page, err := engine.ListRecords(context.Background(), "metrics", 0, 10, nil, &model.Sort{
+	page, err := engine.ListRecords(context.Background(), "metrics", 0, 10, nil, []model.Sort{{
 		Column:    "score",
 		Direction: model.SortDirectionAsc,
-	})
+	}})
 
 	// Assert
 	if err != nil {
@@ -420,10 +803,10 @@ func TestSQLiteEngine_ListRecords_SortsBlobsByStoredValue(t *testing.T) {
 	engine := NewSQLiteEngine(db)
 
 	// Act
-	page, err := engine.ListRecords(context.Background(), "files", 0, 10, nil, &model.Sort{
+	page, err := engine.ListRecords(context.Background(), "files", 0, 10, nil, []model.Sort{{
 		Column:    "payload",
 		Direction: model.SortDirectionAsc,
-	})
+	}})
 
 	// Assert
 	if err != nil {
@@ -453,10 +836,10 @@ func TestSQLiteEngine_ListRecords_SortsOversizedTextByStoredValue(t *testing.T)
 	engine := NewSQLiteEngine(db)
 
 	// Act
-	page, err := engine.ListRecords(context.Background(), "notes", 0, 10, nil, &model.Sort{
+	page, err := engine.ListRecords(context.Background(), "notes", 0, 10, nil, []model.Sort{{
 		Column:    "note",
 		Direction: model.SortDirectionAsc,
-	})
+	}})
 
 	// Assert
 	if err != nil {
@@ -478,10 +861,10 @@ func TestSQLiteEngine_ListRecords_RejectsUnknownSortColumn(t *testing.T) {
 	engine := NewSQLiteEngine(db)
 
 	// Act
-	_, err := engine.ListRecords(context.Background(), "users", 0, 10, nil, &model.Sort{
+	_, err := engine.ListRecords(context.Background(), "users", 0, 10, nil, []model.Sort{{
 		Column:    "missing",
 		Direction: model.SortDirectionAsc,
-	})
+	}})
 
 	// Assert
 	if !errors.Is(err, ErrUnknownSortColumn) {
@@ -500,10 +883,10 @@ func TestSQLiteEngine_ListRecords_RejectsUnknownSortDirection(t *testing.T) {
 	engine := NewSQLiteEngine(db)
 
 	// Act
-	_, err := engine.ListRecords(context.Background(), "users", 0, 10, nil, &model.Sort{
+	_, err := engine.ListRecords(context.Background(), "users", 0, 10, nil, []model.Sort{{
 		Column:    "name",
 		Direction: model.SortDirection("SIDEWAYS"),
-	})
+	}})
 
 	// Assert
 	if !errors.Is(err, ErrUnknownSortDirection) {
@@ -511,6 +894,66 @@ func TestSQLiteEngine_ListRecords_RejectsUnknownSortDirection(t *testing.T) {
 	}
 }
 
+func TestSQLiteEngine_ListRecords_AppliesMultiKeySortWithNullsAndCollation(t *testing.T) {
+	// Arrange
+	db := setupSQLiteSchemaDB(t, `
+		CREATE TABLE people (
+			id INTEGER PRIMARY KEY,
+			team TEXT,
+			name TEXT NOT NULL
+		);
+		INSERT INTO people (id, team, name)
+		VALUES (1, 'red', 'bob'),
+		       (2, NULL, 'zed'),
+		       (3, 'blue', 'Carol'),
+		       (4, 'red', 'Alice'),
+		       (5, 'blue', 'adam');
+	`)
+	engine := NewSQLiteEngine(db)
+	sorts := []model.Sort{
+		{Column: "team", Direction: model.SortDirectionAsc, Nulls: model.SortNullsLast},
+		{Column: "name", Direction: model.SortDirectionAsc, Collation: model.SortCollationNoCase},
+	}
+
+	// Act
+	page, err := engine.ListRecords(context.Background(), "people", 0, 10, nil, sorts)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	ids := make([]string, len(page.Records))
+	for i, record := range page.Records {
+		ids[i] = record.Values[0].Text
+	}
+	if !reflect.DeepEqual(ids, []string{"5", "3", "4", "1", "2"}) {
+		t.Fatalf("expected records [5 3 4 1 2], got %v", ids)
+	}
+}
+
+func TestSQLiteEngine_ListRecords_RejectsUnknownSortCollation(t *testing.T) {
+	// Arrange
+	db := setupSQLiteSchemaDB(t, `
+		CREATE TABLE users (
+			id INTEGER PRIMARY KEY,
+			name TEXT NOT NULL
+		);
+	`)
+	engine := NewSQLiteEngine(db)
+
+	// Act
+	_, err := engine.ListRecords(context.Background(), "users", 0, 10, nil, []model.Sort{{
+		Column:    "name",
+		Direction: model.SortDirectionAsc,
+		Collation: model.SortCollation("BINARY; DROP TABLE users"),
+	}})
+
+	// Assert
+	if !errors.Is(err, ErrUnknownSortCollation) {
+		t.Fatalf("expected error %v, got %v", ErrUnknownSortCollation, err)
+	}
+}
+
 func TestSQLiteEngine_ListRecords_FilterSortAndPagination(t *testing.T) {
 	// Arrange
 	db := setupSQLiteSchemaDB(t, `
@@ -526,18 +969,18 @@ func TestSQLiteEngine_ListRecords_FilterSortAndPagination(t *testing.T) {
 		       (4, 'b', 5);
 	`)
 	engine := NewSQLiteEngine(db)
-	filter := &model.Filter{
+	filter := singleConditionFilter(model.Filter{
 		Column: "name",
 		Operator: model.Operator{
 			Kind:          model.OperatorKindEq,
 			RequiresValue: true,
 		},
 		Value: "a",
-	}
-	sort := &model.Sort{
+	})
+	sort := []model.Sort{{
 		Column:    "score",
 		Direction: model.SortDirectionAsc,
-	}
+	}}
 
 	// Act
 	page, err := engine.ListRecords(context.Background(), "users", 1, 1, filter, sort)
@@ -555,12 +998,288 @@ func TestSQLiteEngine_ListRecords_FilterSortAndPagination(t *testing.T) {
 	if !page.HasMore {
 		t.Fatal("expected hasMore for remaining filtered sorted rows")
 	}
-	if page.TotalCount != 3 {
-		t.Fatalf("expected total count 3 for filtered result, got %d", page.TotalCount)
+}
+
+func TestSQLiteEngine_ListRecords_PagesDuplicateSortValuesByPrimaryKeyTiebreaker(t *testing.T) {
+	// Arrange
+	db := setupSQLiteSchemaDB(t, `
+		CREATE TABLE tasks (
+			project TEXT NOT NULL,
+			code TEXT NOT NULL,
+			status TEXT NOT NULL,
+			PRIMARY KEY (project, code)
+		);
+		INSERT INTO tasks (project, code, status)
+		VALUES ('b', '2', 'open'),
+		       ('a', '9', 'open'),
+		       ('b', '1', 'done'),
+		       ('a', '3', 'open'),
+		       ('a', '1', 'open');
+	`)
+	engine := NewSQLiteEngine(db)
+	sorts := []model.Sort{{Column: "status", Direction: model.SortDirectionDesc}}
+
+	// Act
+	keys := make([]string, 0, 5)
+	for offset := 0; offset < 5; offset += 2 {
+		page, err := engine.ListRecords(context.Background(), "tasks", offset, 2, nil, sorts)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		for _, record := range page.Records {
+			keys = append(keys, record.Values[0].Text+record.Values[1].Text)
+		}
+	}
+
+	// Assert
+	expected := []string{"a1", "a3", "a9", "b2", "b1"}
+	if !reflect.DeepEqual(keys, expected) {
+		t.Fatalf("expected pages %v, got %v", expected, keys)
+	}
+}
+
+func TestSQLiteEngine_ListRecords_PagesDuplicateSortValuesByRowIDWithoutPrimaryKey(t *testing.T) {
+	// Arrange
+	db := setupSQLiteSchemaDB(t, `
+		CREATE TABLE events (
+			label TEXT NOT NULL,
+			status TEXT NOT NULL
+		);
+		INSERT INTO events (label, status)
+		VALUES ('first', 'open'),
+		       ('second', 'open'),
+		       ('third', 'closed'),
+		       ('fourth', 'open'),
+		       ('fifth', 'open');
+	`)
+	engine := NewSQLiteEngine(db)
+	sorts := []model.Sort{{Column: "status", Direction: model.SortDirectionDesc}}
+
+	// Act
+	labels := make([]string, 0, 5)
+	for offset := 0; offset < 5; offset += 2 {
+		page, err := engine.ListRecords(context.Background(), "events", offset, 2, nil, sorts)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		for _, record := range page.Records {
+			labels = append(labels, record.Values[0].Text)
+		}
+	}
+
+	// Assert
+	expected := []string{"first", "second", "fourth", "fifth", "third"}
+	if !reflect.DeepEqual(labels, expected) {
+		t.Fatalf("expected pages %v, got %v", expected, labels)
 	}
 }
 
-func TestSQLiteEngine_ListRecords_OffsetBeyondFilteredRange_ReturnsEmptyPageWithTotalCount(t *testing.T) {
+func TestSQLiteEngine_ListRecordsFromCursor_BreaksTiesByRowIDAliasWhenColumnShadowsRowID(t *testing.T) {
+	// Arrange
+	db := setupSQLiteSchemaDB(t, `
+		CREATE TABLE events (
+			rowid TEXT NOT NULL,
+			label TEXT NOT NULL,
+			status TEXT NOT NULL
+		);
+		INSERT INTO events (rowid, label, status)
+		VALUES ('e', 'first', 'open'),
+		       ('d', 'second', 'open'),
+		       ('c', 'third', 'closed'),
+		       ('b', 'fourth', 'open'),
+		       ('a', 'fifth', 'open');
+	`)
+	engine := NewSQLiteEngine(db)
+	sorts := []model.Sort{{Column: "status", Direction: model.SortDirectionDesc}}
+
+	// Act
+	labels := make([]string, 0, 5)
+	cursor := ""
+	for range 3 {
+		page, err := engine.ListRecordsFromCursor(context.Background(), "events", cursor, 2, nil, sorts)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		for _, record := range page.Records {
+			labels = append(labels, record.Values[1].Text)
+		}
+		cursor = page.NextCursor
+	}
+
+	// Assert
+	expected := []string{"first", "second", "fourth", "fifth", "third"}
+	if !reflect.DeepEqual(labels, expected) {
+		t.Fatalf("expected pages in insertion order %v, got %v", expected, labels)
+	}
+}
+
+func TestSQLiteEngine_ListRecordsFromCursor_WalksPagesMatchingOffsetOrder(t *testing.T) {
+	// Arrange
+	db := setupSQLiteSchemaDB(t, `
+		CREATE TABLE audit (
+			id INTEGER PRIMARY KEY,
+			status TEXT,
+			score REAL,
+			actor TEXT
+		);
+		INSERT INTO audit (id, status, score, actor)
+		VALUES (1, 'open', 2.5, 'Bob'),
+		       (2, NULL, 1.0, 'alice'),
+		       (3, 'done', NULL, 'carol'),
+		       (4, 'open', 2.5, 'ALICE'),
+		       (5, 'open', NULL, 'bob'),
+		       (6, NULL, 3.0, NULL),
+		       (7, 'done', 1.0, 'Dave'),
+		       (8, 'open', 0.5, 'eve');
+	`)
+	engine := NewSQLiteEngine(db)
+	filter := singleConditionFilter(model.Filter{
+		Column:   "id",
+		Operator: model.Operator{Kind: model.OperatorKindNeq, RequiresValue: true},
+		Value:    "8",
+	})
+	testCases := []struct {
+		name  string
+		sorts []model.Sort
+	}{
+		{name: "tiebreaker only"},
+		{name: "duplicates with default nulls", sorts: []model.Sort{{Column: "status", Direction: model.SortDirectionAsc}}},
+		{name: "descending nulls first", sorts: []model.Sort{
+			{Column: "score", Direction: model.SortDirectionDesc, Nulls: model.SortNullsFirst},
+			{Column: "status", Direction: model.SortDirectionAsc, Nulls: model.SortNullsLast},
+		}},
+		{name: "collated", sorts: []model.Sort{{Column: "actor", Direction: model.SortDirectionAsc, Collation: model.SortCollationNoCase}}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			full, err := engine.ListRecords(context.Background(), "audit", 0, 100, filter, tc.sorts)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			expected := recordIDs(full)
+
+			// Act
+			var forward []string
+			var pages []model.RecordPage
+			cursor := ""
+			for {
+				page, err := engine.ListRecordsFromCursor(context.Background(), "audit", cursor, 2, filter, tc.sorts)
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				pages = append(pages, page)
+				forward = append(forward, recordIDs(page)...)
+				if !page.HasMore {
+					break
+				}
+				cursor = page.NextCursor
+			}
+			var backward []string
+			for i := len(pages) - 1; i > 0; i-- {
+				page, err := engine.ListRecordsFromCursor(context.Background(), "audit", pages[i].PrevCursor, 2, filter, tc.sorts)
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				backward = append(recordIDs(page), backward...)
+			}
+
+			// Assert
+			if !reflect.DeepEqual(forward, expected) {
+				t.Fatalf("expected forward pages %v, got %v", expected, forward)
+			}
+			if !reflect.DeepEqual(backward, expected[:len(expected)-len(recordIDs(pages[len(pages)-1]))]) {
+				t.Fatalf("expected backward pages to match %v, got %v", expected, backward)
+			}
+		})
+	}
+}
+
+func TestSQLiteEngine_ListRecordsFromCursor_RejectsCursorForDifferentSort(t *testing.T) {
+	// Arrange
+	db := setupSQLiteSchemaDB(t, `
+		CREATE TABLE users (
+			id INTEGER PRIMARY KEY,
+			name TEXT NOT NULL
+		);
+		INSERT INTO users (id, name) VALUES (1, 'a'), (2, 'b'), (3, 'c');
+	`)
+	engine := NewSQLiteEngine(db)
+	page, err := engine.ListRecordsFromCursor(context.Background(), "users", "", 1, nil, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// Act
+	_, staleErr := engine.ListRecordsFromCursor(context.Background(), "users", page.NextCursor, 1, nil, []model.Sort{{
+		Column:    "name",
+		Direction: model.SortDirectionDesc,
+	}})
+	_, garbageErr := engine.ListRecordsFromCursor(context.Background(), "users", "not-a-cursor", 1, nil, nil)
+
+	// Assert
+	if !errors.Is(staleErr, ErrInvalidRecordCursor) {
+		t.Fatalf("expected error %v for stale cursor, got %v", ErrInvalidRecordCursor, staleErr)
+	}
+	if !errors.Is(garbageErr, ErrInvalidRecordCursor) {
+		t.Fatalf("expected error %v for malformed cursor, got %v", ErrInvalidRecordCursor, garbageErr)
+	}
+}
+
+func TestSQLiteEngine_ListRecords_BrowsesViewWithFilterSortAndStablePages(t *testing.T) {
+	// Arrange
+	db := setupSQLiteSchemaDB(t, `
+		CREATE TABLE orders (
+			id INTEGER PRIMARY KEY,
+			region TEXT NOT NULL,
+			amount INTEGER NOT NULL
+		);
+		INSERT INTO orders (id, region, amount)
+		VALUES (1, 'north', 10),
+		       (2, 'south', 30),
+		       (3, 'north', 20),
+		       (4, 'east', 20),
+		       (5, 'west', 20);
+		CREATE VIEW big_orders AS SELECT region, amount FROM orders WHERE amount >= 20;
+	`)
+	engine := NewSQLiteEngine(db)
+	filter := singleConditionFilter(model.Filter{
+		Column:   "region",
+		Operator: model.Operator{Kind: model.OperatorKindNeq, RequiresValue: true},
+		Value:    "south",
+	})
+	sorts := []model.Sort{{Column: "amount", Direction: model.SortDirectionDesc}}
+
+	// Act
+	var regions []string
+	var cursors []string
+	for offset := 0; offset < 3; offset += 2 {
+		page, err := engine.ListRecords(context.Background(), "big_orders", offset, 2, filter, sorts)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		for _, record := range page.Records {
+			regions = append(regions, record.Values[0].Text)
+		}
+		cursors = append(cursors, page.NextCursor, page.PrevCursor)
+	}
+	_, cursorErr := engine.ListRecordsFromCursor(context.Background(), "big_orders", "not-a-cursor", 2, nil, nil)
+
+	// Assert
+	if !reflect.DeepEqual(regions, []string{"east", "north", "west"}) {
+		t.Fatalf("expected view rows ordered by all columns after sort, got %v", regions)
+	}
+	for _, cursor := range cursors {
+		if cursor != "" {
+			t.Fatalf("expected views to page without keyset cursors, got %q", cursor)
+		}
+	}
+	if !errors.Is(cursorErr, ErrInvalidRecordCursor) {
+		t.Fatalf("expected error %v for view cursor, got %v", ErrInvalidRecordCursor, cursorErr)
+	}
+}
+
+func TestSQLiteEngine_ListRecords_OffsetBeyondFilteredRange_ReturnsEmptyPage(t *testing.T) {
 	// Arrange
 	db := setupSQLiteSchemaDB(t, `
 		CREATE TABLE users (
@@ -573,14 +1292,14 @@ func TestSQLiteEngine_ListRecords_OffsetBeyondFilteredRange_ReturnsEmptyPageWith
 		       (3, 'bob');
 	`)
 	engine := NewSQLiteEngine(db)
-	filter := &model.Filter{
+	filter := singleConditionFilter(model.Filter{
 		Column: "name",
 		Operator: model.Operator{
 			Kind:          model.OperatorKindEq,
 			RequiresValue: true,
 		},
 		Value: "alice",
-	}
+	})
 
 	// Act
 	page, err := engine.ListRecords(context.Background(), "users", 20, 10, filter, nil)
@@ -595,9 +1314,6 @@ func TestSQLiteEngine_ListRecords_OffsetBeyondFilteredRange_ReturnsEmptyPageWith
 	if page.HasMore {
 		t.Fatal("expected hasMore to be false for empty out-of-range page")
 	}
-	if page.TotalCount != 2 {
-		t.Fatalf("expected total count 2 for filtered result, got %d", page.TotalCount)
-	}
 }
 
 func TestSQLiteEngine_ListRecords_UsesSafeDisplayMaterialization(t *testing.T) {
@@ -726,22 +1442,115 @@ func TestSQLiteEngine_ListRecords_MarksIdentityUnavailableWhenPrimaryKeyExceedsS
 	}
 }
 
+func TestSQLiteEngine_CountRecords_AppliesFilter(t *testing.T) {
+	// Arrange
+	db := setupSQLiteSchemaDB(t, `
+		CREATE TABLE users (
+			id INTEGER PRIMARY KEY,
+			name TEXT NOT NULL
+		);
+		INSERT INTO users (id, name)
+		VALUES (1, 'alice'), (2, 'bob'), (3, 'alice');
+	`)
+	engine := NewSQLiteEngine(db)
+	filter := singleConditionFilter(model.Filter{
+		Column:   "name",
+		Operator: model.Operator{Kind: model.OperatorKindEq, RequiresValue: true},
+		Value:    "alice",
+	})
+
+	// Act
+	total, totalErr := engine.CountRecords(context.Background(), "users", nil)
+	filtered, filteredErr := engine.CountRecords(context.Background(), "users", filter)
+
+	// Assert
+	if totalErr != nil || filteredErr != nil {
+		t.Fatalf("expected no errors, got %v and %v", totalErr, filteredErr)
+	}
+	if total != 3 {
+		t.Fatalf("expected total count 3, got %d", total)
+	}
+	if filtered != 2 {
+		t.Fatalf("expected filtered count 2, got %d", filtered)
+	}
+}
+
+func TestSQLiteEngine_CountRecords_StopsOnCancelledContext(t *testing.T) {
+	// Arrange
+	db := setupSQLiteSchemaDB(t, `
+		CREATE TABLE users (
+			id INTEGER PRIMARY KEY
+		);
+		INSERT INTO users (id) VALUES (1);
+	`)
+	engine := NewSQLiteEngine(db)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Act
+	_, err := engine.CountRecords(ctx, "users", nil)
+
+	// Assert
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected error %v, got %v", context.Canceled, err)
+	}
+}
+
+func TestSQLiteEngine_EstimateRecordCount_ReadsSQLiteStat1(t *testing.T) {
+	// Arrange
+	db := setupSQLiteSchemaDB(t, `
+		CREATE TABLE users (
+			id INTEGER PRIMARY KEY,
+			email TEXT NOT NULL
+		);
+		CREATE INDEX idx_users_email ON users (email);
+		CREATE TABLE notes (
+			id INTEGER PRIMARY KEY
+		);
+		INSERT INTO users (id, email)
+		VALUES (1, 'a@example.com'), (2, 'b@example.com'), (3, 'c@example.com');
+	`)
+	engine := NewSQLiteEngine(db)
+	_, beforeOK, beforeErr := engine.EstimateRecordCount(context.Background(), "users")
+	if _, err := db.Exec("ANALYZE"); err != nil {
+		t.Fatalf("failed to analyze: %v", err)
+	}
+
+	// Act
+	estimate, ok, err := engine.EstimateRecordCount(context.Background(), "users")
+	_, notesOK, notesErr := engine.EstimateRecordCount(context.Background(), "notes")
+
+	// Assert
+	if beforeErr != nil || err != nil || notesErr != nil {
+		t.Fatalf("expected no errors, got %v, %v, %v", beforeErr, err, notesErr)
+	}
+	if beforeOK {
+		t.Fatal("expected no estimate before ANALYZE")
+	}
+	if !ok || estimate != 3 {
+		t.Fatalf("expected estimate 3, got %d (ok=%t)", estimate, ok)
+	}
+	if notesOK {
+		t.Fatal("expected no estimate for a table without statistics")
+	}
+}
+
 func BenchmarkSQLiteEngine_ListRecords_PaginatedFilteredSorted(b *testing.B) {
 	const rowCount = 10000
 	db := setupSQLiteBenchmarkDB(b, rowCount)
 	engine := NewSQLiteEngine(db)
-	filter := &model.Filter{
+	filter := singleConditionFilter(model.Filter{
 		Column: "group_name",
 		Operator: model.Operator{
 			Kind:          model.OperatorKindEq,
 			RequiresValue: true,
 		},
 		Value: "group-07",
-	}
-	sort := &model.Sort{
+	})
+	sort := []model.Sort{{
 		Column:    "score",
 		Direction: model.SortDirectionDesc,
-	}
+	}}
 	const (
 		offset = 40
 		limit  = 20
@@ -754,8 +1563,8 @@ func BenchmarkSQLiteEngine_ListRecords_PaginatedFilteredSorted(b *testing.B) {
 		if err != nil {
 			b.Fatalf("expected no error, got %v", err)
 		}
-		if page.TotalCount <= 0 {
-			b.Fatalf("expected positive total count, got %d", page.TotalCount)
+		if len(page.Records) != limit {
+			b.Fatalf("expected %d records, got %d", limit, len(page.Records))
 		}
 	}
 }
@@ -811,6 +1620,14 @@ func setupSQLiteBenchmarkDB(b *testing.B, rowCount int) *sql.DB {
 	return db
 }
 
+func recordIDs(page model.RecordPage) []string {
+	ids := make([]string, len(page.Records))
+	for i, record := range page.Records {
+		ids[i] = record.Values[0].Text
+	}
+	return ids
+}
+
 func recordPageKeys(page model.RecordPage) []string {
 	keys := make([]string, len(page.Records))
 	for i, record := range page.Records {
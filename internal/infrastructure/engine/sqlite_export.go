@@ -0,0 +1,133 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/mgierok/dbc/internal/application/port"
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+// StreamRecords writes every row of tableName matching filter to writer in
+// the order ListRecords pages them, without a row limit and without the
+// browse cell cap. Rows are read one at a time, so memory use does not grow
+// with the table.
+func (e *SQLiteEngine) StreamRecords(ctx context.Context, tableName string, filter *model.FilterGroup, sorts []model.Sort, writer port.RecordWriter) (err error) {
+	columnInfos, err := e.tableColumnInfos(ctx, tableName)
+	if err != nil {
+		return err
+	}
+	kind, err := e.tableKind(ctx, tableName)
+	if err != nil {
+		return err
+	}
+	keyColumns, err := e.identityColumns(ctx, tableName, kind, columnInfos)
+	if err != nil {
+		return err
+	}
+	terms, err := e.buildSortTerms(ctx, tableName, sorts, keyColumns)
+	if err != nil {
+		return err
+	}
+	clause, args, err := buildFilterClause(filter)
+	if err != nil {
+		return err
+	}
+
+	columns := make([]model.Column, len(columnInfos))
+	selectParts := make([]string, len(columnInfos))
+	for i, column := range columnInfos {
+		columns[i] = column.toModelColumn()
+		// Unary plus drops the declared type, so the driver returns the
+		// stored value instead of parsing date-typed text into time values.
+		selectParts[i] = "+" + quoteIdentifier(column.name)
+	}
+	query := "SELECT " + strings.Join(selectParts, ", ") + " FROM " + quoteIdentifier(tableName)
+	if clause != "" {
+		query = query + " " + clause
+	}
+	query = query + " " + sortClause(terms)
+
+	if err := writer.WriteHeader(tableName, columns); err != nil {
+		return err
+	}
+	rows, err := e.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}()
+
+	scanValues := make([]any, len(columnInfos))
+	destinations := make([]any, len(scanValues))
+	for i := range scanValues {
+		destinations[i] = &scanValues[i]
+	}
+	values := make([]model.Value, len(columnInfos))
+	for rows.Next() {
+		if err := rows.Scan(destinations...); err != nil {
+			return err
+		}
+		for i, raw := range scanValues {
+			values[i] = exportValue(raw)
+		}
+		if err := writer.WriteRecord(values); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// ReadRecord returns the stored values of the row of tableName matching
+// identity, in column order and without the browse cell cap.
+func (e *SQLiteEngine) ReadRecord(ctx context.Context, tableName string, identity model.RecordIdentity) ([]model.Value, error) {
+	columnInfos, err := e.tableColumnInfos(ctx, tableName)
+	if err != nil {
+		return nil, err
+	}
+	whereClause, whereArgs, err := buildRecordIdentityClause(identity)
+	if err != nil {
+		return nil, err
+	}
+	selectParts := make([]string, len(columnInfos))
+	for i, column := range columnInfos {
+		selectParts[i] = "+" + quoteIdentifier(column.name)
+	}
+	query := "SELECT " + strings.Join(selectParts, ", ") + " FROM " + quoteIdentifier(tableName) + " " + whereClause + " LIMIT 1"
+
+	scanValues := make([]any, len(columnInfos))
+	destinations := make([]any, len(scanValues))
+	for i := range scanValues {
+		destinations[i] = &scanValues[i]
+	}
+	err = e.db.QueryRowContext(ctx, query, bindValues(whereArgs)...).Scan(destinations...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, model.ErrRecordNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	values := make([]model.Value, len(scanValues))
+	for i, raw := range scanValues {
+		values[i] = exportValue(raw)
+	}
+	return values, nil
+}
+
+func exportValue(raw any) model.Value {
+	switch typed := raw.(type) {
+	case nil:
+		return model.Value{IsNull: true}
+	case []byte:
+		return model.Value{Raw: typed}
+	case string:
+		return model.Value{Text: typed, Raw: typed}
+	default:
+		return model.Value{Text: materializeDisplayValue(typed).Text, Raw: typed}
+	}
+}
@@ -0,0 +1,147 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+type recordWriterSpy struct {
+	table   string
+	columns []string
+	rows    []string
+}
+
+func (w *recordWriterSpy) WriteHeader(table string, columns []model.Column) error {
+	w.table = table
+	for _, column := range columns {
+		w.columns = append(w.columns, column.Name)
+	}
+	return nil
+}
+
+func (w *recordWriterSpy) WriteRecord(values []model.Value) error {
+	cells := make([]string, len(values))
+	for i, value := range values {
+		if value.IsNull {
+			cells[i] = "NULL"
+			continue
+		}
+		cells[i] = fmt.Sprintf("%T:%v", value.Raw, value.Raw)
+	}
+	w.rows = append(w.rows, strings.Join(cells, " "))
+	return nil
+}
+
+func (w *recordWriterSpy) Finish() error {
+	return nil
+}
+
+func TestSQLiteEngine_StreamRecords_WritesEveryMatchingRowWithStoredValues(t *testing.T) {
+	// Arrange
+	db := setupSQLiteUpdateDB(t, `
+		CREATE TABLE events (
+			id INTEGER PRIMARY KEY,
+			kind TEXT,
+			at DATETIME,
+			payload BLOB,
+			score REAL
+		);
+		WITH RECURSIVE seq(n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM seq WHERE n < 30)
+		INSERT INTO events (id, kind, at, payload, score)
+		SELECT n, CASE WHEN n % 2 = 0 THEN 'even' ELSE 'odd' END, '2026-01-02 03:04:05', x'00ff', 1.0 FROM seq;
+		UPDATE events SET payload = NULL WHERE id = 30;
+	`)
+	engine := NewSQLiteEngine(db)
+	writer := &recordWriterSpy{}
+	filter := &model.FilterGroup{
+		Logic: model.FilterLogicAnd,
+		Conditions: []model.Filter{{
+			Column:   "kind",
+			Operator: model.Operator{Kind: model.OperatorKindEq, RequiresValue: true},
+			Value:    "even",
+		}},
+	}
+	sorts := []model.Sort{{Column: "id", Direction: model.SortDirectionDesc}}
+
+	// Act
+	err := engine.StreamRecords(context.Background(), "events", filter, sorts, writer)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if writer.table != "events" || !reflect.DeepEqual(writer.columns, []string{"id", "kind", "at", "payload", "score"}) {
+		t.Fatalf("unexpected header %q %v", writer.table, writer.columns)
+	}
+	if len(writer.rows) != 15 {
+		t.Fatalf("expected all 15 matching rows past the page limit, got %d", len(writer.rows))
+	}
+	expectedFirst := "int64:30 string:even string:2026-01-02 03:04:05 NULL float64:1"
+	if writer.rows[0] != expectedFirst {
+		t.Fatalf("expected first row %q, got %q", expectedFirst, writer.rows[0])
+	}
+	if !strings.Contains(writer.rows[1], "[]uint8:[0 255]") {
+		t.Fatalf("expected raw BLOB bytes, got %q", writer.rows[1])
+	}
+}
+
+func TestSQLiteEngine_ReadRecord_ReturnsFullStoredValuesOfIdentifiedRow(t *testing.T) {
+	// Arrange
+	db := setupSQLiteUpdateDB(t, `
+		CREATE TABLE notes (
+			id INTEGER PRIMARY KEY,
+			body TEXT,
+			payload BLOB
+		);
+	`)
+	body := strings.Repeat("x", maxMaterializedRecordCellBytes+10)
+	if _, err := db.Exec(`INSERT INTO notes (id, body, payload) VALUES (1, 'short', NULL), (2, ?, x'0102')`, body); err != nil {
+		t.Fatalf("failed to seed notes: %v", err)
+	}
+	engine := NewSQLiteEngine(db)
+	identity := model.RecordIdentity{Keys: []model.RecordIdentityKey{{
+		Column: "id",
+		Value:  model.Value{Text: "2", Raw: int64(2)},
+	}}}
+
+	// Act
+	values, err := engine.ReadRecord(context.Background(), "notes", identity)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(values) != 3 {
+		t.Fatalf("expected three values, got %d", len(values))
+	}
+	if values[1].Text != body {
+		t.Fatalf("expected untruncated body of %d bytes, got %d bytes", len(body), len(values[1].Text))
+	}
+	if payload, ok := values[2].Raw.([]byte); !ok || !reflect.DeepEqual(payload, []byte{1, 2}) {
+		t.Fatalf("expected raw BLOB bytes, got %#v", values[2].Raw)
+	}
+}
+
+func TestSQLiteEngine_ReadRecord_ReportsMissingRow(t *testing.T) {
+	// Arrange
+	db := setupSQLiteUpdateDB(t, `CREATE TABLE notes (id INTEGER PRIMARY KEY, body TEXT);`)
+	engine := NewSQLiteEngine(db)
+	identity := model.RecordIdentity{Keys: []model.RecordIdentityKey{{
+		Column: "id",
+		Value:  model.Value{Text: "7", Raw: int64(7)},
+	}}}
+
+	// Act
+	_, err := engine.ReadRecord(context.Background(), "notes", identity)
+
+	// Assert
+	if !errors.Is(err, model.ErrRecordNotFound) {
+		t.Fatalf("expected ErrRecordNotFound, got %v", err)
+	}
+}
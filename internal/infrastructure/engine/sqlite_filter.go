@@ -11,27 +11,103 @@ import (
 var (
 	ErrMissingFilterColumn = errors.New("filter column is required")
 	ErrUnknownOperator     = errors.New("unknown operator")
+	ErrUnknownFilterLogic  = errors.New("unknown filter logic")
+	ErrInvalidFilterValues = errors.New("filter values do not match operator")
 )
 
-func buildFilterClause(filter *model.Filter) (string, []any, error) {
+func buildFilterClause(filter *model.FilterGroup) (string, []any, error) {
 	if filter == nil {
 		return "", nil, nil
 	}
+	expression, args, err := buildFilterGroupExpression(*filter)
+	if err != nil {
+		return "", nil, err
+	}
+	if expression == "" {
+		return "", nil, nil
+	}
+	return "WHERE " + expression, args, nil
+}
+
+func buildFilterGroupExpression(group model.FilterGroup) (string, []any, error) {
+	separator, ok := sqliteFilterLogicSeparator(group.Logic)
+	if !ok {
+		return "", nil, ErrUnknownFilterLogic
+	}
+
+	parts := make([]string, 0, len(group.Conditions)+len(group.Groups))
+	var args []any
+	for _, condition := range group.Conditions {
+		expression, conditionArgs, err := buildFilterConditionExpression(condition)
+		if err != nil {
+			return "", nil, err
+		}
+		parts = append(parts, expression)
+		args = append(args, conditionArgs...)
+	}
+	for _, nested := range group.Groups {
+		expression, nestedArgs, err := buildFilterGroupExpression(nested)
+		if err != nil {
+			return "", nil, err
+		}
+		if expression == "" {
+			continue
+		}
+		parts = append(parts, "("+expression+")")
+		args = append(args, nestedArgs...)
+	}
+	return strings.Join(parts, separator), args, nil
+}
+
+func buildFilterConditionExpression(filter model.Filter) (string, []any, error) {
 	if strings.TrimSpace(filter.Column) == "" {
 		return "", nil, ErrMissingFilterColumn
 	}
-	operatorSQL, ok := sqliteOperatorSQL(filter.Operator.Kind)
+	operator, ok := sqliteOperatorSpecForKind(filter.Operator.Kind)
 	if !ok {
 		return "", nil, ErrUnknownOperator
 	}
 
-	clause := fmt.Sprintf("WHERE %s %s", quoteIdentifier(filter.Column), operatorSQL)
-	var args []any
-	if filter.Operator.RequiresValue {
-		clause += " ?"
-		args = append(args, filter.Value)
+	column := quoteIdentifier(filter.Column)
+	if !operator.requiresValue {
+		return fmt.Sprintf("%s %s", column, operator.sql), nil, nil
+	}
+
+	switch operator.valueMode {
+	case model.OperatorValueRange:
+		if len(filter.Values) != 2 {
+			return "", nil, ErrInvalidFilterValues
+		}
+		expression := fmt.Sprintf("%s %s ? AND ?%s", column, operator.sql, operator.suffix)
+		return expression, []any{filter.Values[0], filter.Values[1]}, nil
+	case model.OperatorValueList:
+		if len(filter.Values) == 0 {
+			return "", nil, ErrInvalidFilterValues
+		}
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(filter.Values)), ", ")
+		args := make([]any, len(filter.Values))
+		for i, value := range filter.Values {
+			args[i] = value
+		}
+		return fmt.Sprintf("%s %s (%s)%s", column, operator.sql, placeholders, operator.suffix), args, nil
+	default:
+		value := filter.Value
+		if operator.pattern != nil {
+			value = operator.pattern(value)
+		}
+		return fmt.Sprintf("%s %s ?%s", column, operator.sql, operator.suffix), []any{value}, nil
+	}
+}
+
+func sqliteFilterLogicSeparator(logic model.FilterLogic) (string, bool) {
+	switch logic {
+	case model.FilterLogicAnd:
+		return " AND ", true
+	case model.FilterLogicOr:
+		return " OR ", true
+	default:
+		return "", false
 	}
-	return clause, args, nil
 }
 
 func quoteIdentifier(identifier string) string {
@@ -2,6 +2,7 @@ package engine
 
 import (
 	"errors"
+	"reflect"
 	"testing"
 
 	"github.com/mgierok/dbc/internal/domain/model"
@@ -9,7 +10,7 @@ import (
 
 func TestBuildFilterClause_NoFilter(t *testing.T) {
 	// Arrange
-	var filter *model.Filter
+	var filter *model.FilterGroup
 
 	// Act
 	clause, args, err := buildFilterClause(filter)
@@ -28,14 +29,14 @@ func TestBuildFilterClause_NoFilter(t *testing.T) {
 
 func TestBuildFilterClause_WithValueOperator(t *testing.T) {
 	// Arrange
-	filter := &model.Filter{
+	filter := singleConditionFilter(model.Filter{
 		Column: "name",
 		Operator: model.Operator{
 			Kind:          model.OperatorKindEq,
 			RequiresValue: true,
 		},
 		Value: "alice",
-	}
+	})
 
 	// Act
 	clause, args, err := buildFilterClause(filter)
@@ -55,13 +56,13 @@ func TestBuildFilterClause_WithValueOperator(t *testing.T) {
 
 func TestBuildFilterClause_IsNullOperator(t *testing.T) {
 	// Arrange
-	filter := &model.Filter{
+	filter := singleConditionFilter(model.Filter{
 		Column: "deleted_at",
 		Operator: model.Operator{
 			Kind:          model.OperatorKindIsNull,
 			RequiresValue: false,
 		},
-	}
+	})
 
 	// Act
 	clause, args, err := buildFilterClause(filter)
@@ -81,14 +82,14 @@ func TestBuildFilterClause_IsNullOperator(t *testing.T) {
 
 func TestBuildFilterClause_UnknownOperator(t *testing.T) {
 	// Arrange
-	filter := &model.Filter{
+	filter := singleConditionFilter(model.Filter{
 		Column: "name",
 		Operator: model.Operator{
 			Kind:          model.OperatorKind("drop_table"),
 			RequiresValue: true,
 		},
 		Value: "x",
-	}
+	})
 
 	// Act
 	_, _, err := buildFilterClause(filter)
@@ -101,14 +102,14 @@ func TestBuildFilterClause_UnknownOperator(t *testing.T) {
 
 func TestBuildFilterClause_MissingColumn(t *testing.T) {
 	// Arrange
-	filter := &model.Filter{
+	filter := singleConditionFilter(model.Filter{
 		Column: " ",
 		Operator: model.Operator{
 			Kind:          model.OperatorKindEq,
 			RequiresValue: true,
 		},
 		Value: "x",
-	}
+	})
 
 	// Act
 	_, _, err := buildFilterClause(filter)
@@ -118,3 +119,184 @@ func TestBuildFilterClause_MissingColumn(t *testing.T) {
 		t.Fatalf("expected error %v, got %v", ErrMissingFilterColumn, err)
 	}
 }
+
+func TestBuildFilterClause_CombinesConditionsAndNestedGroups(t *testing.T) {
+	// Arrange
+	filter := &model.FilterGroup{
+		Logic: model.FilterLogicAnd,
+		Conditions: []model.Filter{
+			{
+				Column:   "status",
+				Operator: model.Operator{Kind: model.OperatorKindEq, RequiresValue: true},
+				Value:    "failed",
+			},
+		},
+		Groups: []model.FilterGroup{
+			{
+				Logic: model.FilterLogicOr,
+				Conditions: []model.Filter{
+					{
+						Column:   "created_at",
+						Operator: model.Operator{Kind: model.OperatorKindGt, RequiresValue: true},
+						Value:    "2026-01-01",
+					},
+					{
+						Column:   "retried_at",
+						Operator: model.Operator{Kind: model.OperatorKindIsNull},
+					},
+				},
+			},
+		},
+	}
+
+	// Act
+	clause, args, err := buildFilterClause(filter)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	expectedClause := `WHERE "status" = ? AND ("created_at" > ? OR "retried_at" IS NULL)`
+	if clause != expectedClause {
+		t.Fatalf("expected clause %q, got %q", expectedClause, clause)
+	}
+	if len(args) != 2 || args[0] != "failed" || args[1] != "2026-01-01" {
+		t.Fatalf("expected args [failed 2026-01-01], got %v", args)
+	}
+}
+
+func TestBuildFilterClause_EmptyGroupProducesNoClause(t *testing.T) {
+	// Arrange
+	filter := &model.FilterGroup{
+		Logic:  model.FilterLogicAnd,
+		Groups: []model.FilterGroup{{Logic: model.FilterLogicOr}},
+	}
+
+	// Act
+	clause, args, err := buildFilterClause(filter)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if clause != "" {
+		t.Fatalf("expected empty clause, got %q", clause)
+	}
+	if len(args) != 0 {
+		t.Fatalf("expected no args, got %v", args)
+	}
+}
+
+func TestBuildFilterClause_UnknownLogic(t *testing.T) {
+	// Arrange
+	filter := &model.FilterGroup{
+		Logic: model.FilterLogic("XOR"),
+		Conditions: []model.Filter{
+			{
+				Column:   "name",
+				Operator: model.Operator{Kind: model.OperatorKindEq, RequiresValue: true},
+				Value:    "x",
+			},
+		},
+	}
+
+	// Act
+	_, _, err := buildFilterClause(filter)
+
+	// Assert
+	if !errors.Is(err, ErrUnknownFilterLogic) {
+		t.Fatalf("expected error %v, got %v", ErrUnknownFilterLogic, err)
+	}
+}
+
+func singleConditionFilter(filter model.Filter) *model.FilterGroup {
+	return &model.FilterGroup{
+		Logic:      model.FilterLogicAnd,
+		Conditions: []model.Filter{filter},
+	}
+}
+
+func TestBuildFilterClause_BindsMultiValueOperators(t *testing.T) {
+	testCases := []struct {
+		name           string
+		filter         model.Filter
+		expectedClause string
+		expectedArgs   []any
+	}{
+		{
+			name: "between",
+			filter: model.Filter{
+				Column:   "score",
+				Operator: model.Operator{Kind: model.OperatorKindBetween, RequiresValue: true, ValueMode: model.OperatorValueRange},
+				Values:   []string{"10", "20"},
+			},
+			expectedClause: `WHERE "score" BETWEEN ? AND ?`,
+			expectedArgs:   []any{"10", "20"},
+		},
+		{
+			name: "not in",
+			filter: model.Filter{
+				Column:   "status",
+				Operator: model.Operator{Kind: model.OperatorKindNotIn, RequiresValue: true, ValueMode: model.OperatorValueList},
+				Values:   []string{"done", "failed", "queued"},
+			},
+			expectedClause: `WHERE "status" NOT IN (?, ?, ?)`,
+			expectedArgs:   []any{"done", "failed", "queued"},
+		},
+		{
+			name: "contains escapes wildcards",
+			filter: model.Filter{
+				Column:   "note",
+				Operator: model.Operator{Kind: model.OperatorKindContains, RequiresValue: true},
+				Value:    `50%_off\`,
+			},
+			expectedClause: `WHERE "note" LIKE ? ESCAPE '\'`,
+			expectedArgs:   []any{`%50\%\_off\\%`},
+		},
+		{
+			name: "case-insensitive equality",
+			filter: model.Filter{
+				Column:   "name",
+				Operator: model.Operator{Kind: model.OperatorKindEqNoCase, RequiresValue: true},
+				Value:    "Alice",
+			},
+			expectedClause: `WHERE "name" = ? COLLATE NOCASE`,
+			expectedArgs:   []any{"Alice"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			clause, args, err := buildFilterClause(singleConditionFilter(tc.filter))
+
+			// Assert
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if clause != tc.expectedClause {
+				t.Fatalf("expected clause %q, got %q", tc.expectedClause, clause)
+			}
+			if !reflect.DeepEqual(args, tc.expectedArgs) {
+				t.Fatalf("expected args %v, got %v", tc.expectedArgs, args)
+			}
+		})
+	}
+}
+
+func TestBuildFilterClause_RejectsValueCountMismatch(t *testing.T) {
+	// Arrange
+	filter := singleConditionFilter(model.Filter{
+		Column:   "score",
+		Operator: model.Operator{Kind: model.OperatorKindBetween, RequiresValue: true, ValueMode: model.OperatorValueRange},
+		Values:   []string{"10"},
+	})
+
+	// Act
+	_, _, err := buildFilterClause(filter)
+
+	// Assert
+	if !errors.Is(err, ErrInvalidFilterValues) {
+		t.Fatalf("expected error %v, got %v", ErrInvalidFilterValues, err)
+	}
+}
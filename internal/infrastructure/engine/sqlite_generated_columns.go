@@ -0,0 +1,88 @@
+package engine
+
+import "strings"
+
+// PRAGMA table_xinfo hidden values.
+const (
+	columnHiddenNone             = 0
+	columnHiddenVirtualTable     = 1
+	columnHiddenGeneratedVirtual = 2
+	columnHiddenGeneratedStored  = 3
+)
+
+// tableConstraintKeywords start table constraints rather than column
+// definitions inside CREATE TABLE.
+var tableConstraintKeywords = map[string]struct{}{
+	"CONSTRAINT": {},
+	"PRIMARY":    {},
+	"UNIQUE":     {},
+	"CHECK":      {},
+	"FOREIGN":    {},
+}
+
+// generatedColumnExpressions maps lower-cased column names to the
+// expressions of their GENERATED ALWAYS AS clauses in a stored CREATE TABLE
+// statement.
+func generatedColumnExpressions(tableSQL string) map[string]string {
+	tokens := tokenizeSQL(tableSQL)
+	open := -1
+	for i, token := range tokens {
+		if token.text == "(" {
+			open = i
+			break
+		}
+	}
+	if open < 0 {
+		return nil
+	}
+	closing := matchingParenthesis(tokens, open)
+	if closing < 0 {
+		return nil
+	}
+
+	expressions := make(map[string]string)
+	segmentStart := open + 1
+	depth := 0
+	for i := open + 1; i <= closing; i++ {
+		switch tokens[i].text {
+		case "(":
+			depth++
+			continue
+		case ")":
+			if depth > 0 {
+				depth--
+				continue
+			}
+		case ",":
+			if depth > 0 {
+				continue
+			}
+		default:
+			continue
+		}
+		addGeneratedColumnExpression(expressions, tableSQL, tokens[segmentStart:i])
+		segmentStart = i + 1
+	}
+	return expressions
+}
+
+func addGeneratedColumnExpression(expressions map[string]string, tableSQL string, segment []sqlToken) {
+	if len(segment) == 0 {
+		return
+	}
+	if _, ok := tableConstraintKeywords[strings.ToUpper(segment[0].text)]; ok && segment[0].kind == sqlTokenWord {
+		return
+	}
+	for i := 1; i+1 < len(segment); i++ {
+		if segment[i].kind != sqlTokenWord || !strings.EqualFold(segment[i].text, "AS") || segment[i+1].text != "(" {
+			continue
+		}
+		closing := matchingParenthesis(segment, i+1)
+		if closing < 0 {
+			return
+		}
+		name := strings.ToLower(identifierText(segment[0]))
+		expressions[name] = strings.TrimSpace(tableSQL[segment[i+1].end:segment[closing].start])
+		return
+	}
+}
@@ -0,0 +1,29 @@
+package engine
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGeneratedColumnExpressions_ReadsColumnDefinitionsOnly(t *testing.T) {
+	// Arrange
+	tableSQL := `CREATE TABLE t (
+		a INTEGER,
+		"b c" TEXT GENERATED ALWAYS AS (a || ', ' || (a + 1)) STORED,
+		d AS (a * 2),
+		CONSTRAINT pk PRIMARY KEY (a),
+		CHECK (a AS_NOT_A_KEYWORD > 0)
+	)`
+
+	// Act
+	expressions := generatedColumnExpressions(tableSQL)
+
+	// Assert
+	expected := map[string]string{
+		"b c": "a || ', ' || (a + 1)",
+		"d":   "a * 2",
+	}
+	if !reflect.DeepEqual(expressions, expected) {
+		t.Fatalf("expected %v, got %v", expected, expressions)
+	}
+}
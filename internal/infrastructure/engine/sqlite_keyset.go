@@ -0,0 +1,193 @@
+package engine
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidRecordCursor = errors.New("invalid or stale record cursor")
+
+const (
+	recordCursorValueNull = "null"
+	recordCursorValueInt  = "int"
+	recordCursorValueReal = "real"
+	recordCursorValueText = "text"
+	recordCursorValueBlob = "blob"
+)
+
+// recordCursor is the decoded form of the opaque keyset cursor returned in
+// model.RecordPage. It stores the boundary row's ORDER BY values with their
+// SQLite storage class so the seek predicate compares like ORDER BY does.
+type recordCursor struct {
+	Backward bool                `json:"b,omitempty"`
+	Order    string              `json:"o"`
+	Values   []recordCursorValue `json:"v"`
+}
+
+type recordCursorValue struct {
+	Kind string `json:"k"`
+	Text string `json:"t,omitempty"`
+}
+
+func encodeRecordCursor(cursor recordCursor) (string, error) {
+	payload, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(payload), nil
+}
+
+func decodeRecordCursor(encoded string, terms []sqliteSortTerm) (recordCursor, []any, error) {
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return recordCursor{}, nil, ErrInvalidRecordCursor
+	}
+	var cursor recordCursor
+	if err := json.Unmarshal(payload, &cursor); err != nil {
+		return recordCursor{}, nil, ErrInvalidRecordCursor
+	}
+	if cursor.Order != sortClause(terms) || len(cursor.Values) != len(terms) {
+		return recordCursor{}, nil, ErrInvalidRecordCursor
+	}
+	values := make([]any, len(cursor.Values))
+	for i, value := range cursor.Values {
+		decoded, err := value.decode()
+		if err != nil {
+			return recordCursor{}, nil, err
+		}
+		values[i] = decoded
+	}
+	return cursor, values, nil
+}
+
+func newRecordCursorValue(raw any) (recordCursorValue, error) {
+	switch typed := raw.(type) {
+	case nil:
+		return recordCursorValue{Kind: recordCursorValueNull}, nil
+	case int64:
+		return recordCursorValue{Kind: recordCursorValueInt, Text: strconv.FormatInt(typed, 10)}, nil
+	case float64:
+		return recordCursorValue{Kind: recordCursorValueReal, Text: strconv.FormatFloat(typed, 'g', -1, 64)}, nil
+	case string:
+		return recordCursorValue{Kind: recordCursorValueText, Text: typed}, nil
+	case []byte:
+		return recordCursorValue{Kind: recordCursorValueBlob, Text: base64.StdEncoding.EncodeToString(typed)}, nil
+	default:
+		return recordCursorValue{}, fmt.Errorf("unsupported cursor value type %T", raw)
+	}
+}
+
+func (v recordCursorValue) decode() (any, error) {
+	switch v.Kind {
+	case recordCursorValueNull:
+		return nil, nil
+	case recordCursorValueInt:
+		parsed, err := strconv.ParseInt(v.Text, 10, 64)
+		if err != nil {
+			return nil, ErrInvalidRecordCursor
+		}
+		return parsed, nil
+	case recordCursorValueReal:
+		parsed, err := strconv.ParseFloat(v.Text, 64)
+		if err != nil {
+			return nil, ErrInvalidRecordCursor
+		}
+		return parsed, nil
+	case recordCursorValueText:
+		return v.Text, nil
+	case recordCursorValueBlob:
+		parsed, err := base64.StdEncoding.DecodeString(v.Text)
+		if err != nil {
+			return nil, ErrInvalidRecordCursor
+		}
+		return parsed, nil
+	default:
+		return nil, ErrInvalidRecordCursor
+	}
+}
+
+// buildRecordCursor captures the seek values projected for one row.
+func buildRecordCursor(terms []sqliteSortTerm, seekValues []any, backward bool) (string, error) {
+	values := make([]recordCursorValue, len(seekValues))
+	for i, raw := range seekValues {
+		value, err := newRecordCursorValue(raw)
+		if err != nil {
+			return "", err
+		}
+		values[i] = value
+	}
+	return encodeRecordCursor(recordCursor{
+		Backward: backward,
+		Order:    sortClause(terms),
+		Values:   values,
+	})
+}
+
+func appendSeekProjection(selectParts []string, terms []sqliteSortTerm) []string {
+	for index, term := range terms {
+		selectParts = append(selectParts, fmt.Sprintf("%s AS %s", term.columnRef, quoteIdentifier(seekColumnAlias(index))))
+	}
+	return selectParts
+}
+
+func seekColumnAlias(index int) string {
+	return fmt.Sprintf("__dbc_seek_%d", index)
+}
+
+// buildSeekExpression returns the predicate selecting rows strictly after
+// values in the order described by terms, expanded as
+// (t1 > v1) OR (t1 = v1 AND t2 > v2) OR ...
+func buildSeekExpression(terms []sqliteSortTerm, values []any) (string, []any) {
+	disjuncts := make([]string, 0, len(terms))
+	var args []any
+	for i, term := range terms {
+		after, afterArgs, ok := seekAfterExpression(term, values[i])
+		if !ok {
+			continue
+		}
+		parts := make([]string, 0, i+1)
+		var disjunctArgs []any
+		for j := 0; j < i; j++ {
+			equal, equalArgs := seekEqualExpression(terms[j], values[j])
+			parts = append(parts, equal)
+			disjunctArgs = append(disjunctArgs, equalArgs...)
+		}
+		parts = append(parts, after)
+		disjunctArgs = append(disjunctArgs, afterArgs...)
+		disjuncts = append(disjuncts, "("+strings.Join(parts, " AND ")+")")
+		args = append(args, disjunctArgs...)
+	}
+	if len(disjuncts) == 0 {
+		return "0", nil
+	}
+	return "(" + strings.Join(disjuncts, " OR ") + ")", args
+}
+
+func seekEqualExpression(term sqliteSortTerm, value any) (string, []any) {
+	if value == nil {
+		return term.columnRef + " IS NULL", nil
+	}
+	return term.columnRef + term.collation + " = ?", []any{value}
+}
+
+func seekAfterExpression(term sqliteSortTerm, value any) (string, []any, bool) {
+	if value == nil {
+		if term.nullsFirst() {
+			return term.columnRef + " IS NOT NULL", nil, true
+		}
+		return "", nil, false
+	}
+	comparison := " > ?"
+	if term.descending {
+		comparison = " < ?"
+	}
+	expression := term.columnRef + term.collation + comparison
+	if term.nullsFirst() {
+		return expression, []any{value}, true
+	}
+	return "(" + term.columnRef + " IS NULL OR " + expression + ")", []any{value}, true
+}
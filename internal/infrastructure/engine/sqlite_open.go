@@ -8,6 +8,8 @@ import (
 	"os"
 
 	_ "modernc.org/sqlite"
+
+	"github.com/mgierok/dbc/internal/sqliteidentity"
 )
 
 type sqliteDatabaseHandle interface {
@@ -15,28 +17,33 @@ type sqliteDatabaseHandle interface {
 	Close() error
 }
 
-var openSQLiteHandle = func(dbPath string) (sqliteDatabaseHandle, error) {
-	return sql.Open("sqlite", dbPath)
+var openSQLiteHandle = func(dsn string) (sqliteDatabaseHandle, error) {
+	return sql.Open("sqlite", dsn)
+}
+
+// OpenSQLiteDatabase validates the sqlite connection string and returns an open, reachable DB handle.
+func OpenSQLiteDatabase(ctx context.Context, connString string) (*sql.DB, error) {
+	return OpenSQLiteDatabaseWithOptions(ctx, connString, SQLiteOpenOptions{})
 }
 
-// OpenSQLiteDatabase validates the sqlite path and returns an open, reachable DB handle.
-func OpenSQLiteDatabase(ctx context.Context, dbPath string) (*sql.DB, error) {
+// OpenSQLiteDatabaseWithOptions opens a plain path or file: URI like
+// OpenSQLiteDatabase, applying read-only mode and connection pragmas.
+func OpenSQLiteDatabaseWithOptions(ctx context.Context, connString string, options SQLiteOpenOptions) (*sql.DB, error) {
 	if ctx == nil {
 		ctx = context.Background()
 	}
 
-	info, err := os.Stat(dbPath)
+	dsn, err := sqliteDSN(connString, options)
 	if err != nil {
-		if errors.Is(err, os.ErrNotExist) {
-			return nil, fmt.Errorf("database file does not exist: %s", dbPath)
-		}
-		return nil, fmt.Errorf("check database path: %w", err)
+		return nil, err
 	}
-	if info.IsDir() {
-		return nil, fmt.Errorf("database path points to a directory: %s", dbPath)
+	if dbPath, ok := sqliteidentity.FilePath(connString); ok {
+		if err := checkSQLiteFile(dbPath); err != nil {
+			return nil, err
+		}
 	}
 
-	db, err := openSQLiteHandle(dbPath)
+	db, err := openSQLiteHandle(dsn)
 	if err != nil {
 		return nil, fmt.Errorf("open sqlite database: %w", err)
 	}
@@ -59,3 +66,17 @@ func OpenSQLiteDatabase(ctx context.Context, dbPath string) (*sql.DB, error) {
 	}
 	return nil, fmt.Errorf("ping sqlite database: %w", pingErr)
 }
+
+func checkSQLiteFile(dbPath string) error {
+	info, err := os.Stat(dbPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("database file does not exist: %s", dbPath)
+		}
+		return fmt.Errorf("check database path: %w", err)
+	}
+	if info.IsDir() {
+		return fmt.Errorf("database path points to a directory: %s", dbPath)
+	}
+	return nil
+}
@@ -119,3 +119,102 @@ func TestOpenSQLiteDatabase_ReturnsJoinedPingAndCloseErrorsWhenBothFail(t *testi
 		t.Fatalf("expected joined error to contain close error %v, got %v", expectedCloseErr, err)
 	}
 }
+
+func TestOpenSQLiteDatabaseWithOptions_ReadOnlyReadsButRejectsWrites(t *testing.T) {
+	// Arrange
+	dbPath := filepath.Join(t.TempDir(), "snapshot #1 100%.sqlite")
+	seed, err := sql.Open("sqlite", dbPath)
+	if err != nil {
+		t.Fatalf("failed to open seed sqlite database: %v", err)
+	}
+	if _, err := seed.Exec(`CREATE TABLE users (id INTEGER PRIMARY KEY); INSERT INTO users (id) VALUES (1);`); err != nil {
+		t.Fatalf("failed to initialize seed sqlite database: %v", err)
+	}
+	if err := seed.Close(); err != nil {
+		t.Fatalf("failed to close seed sqlite database: %v", err)
+	}
+
+	// Act
+	db, err := OpenSQLiteDatabaseWithOptions(context.Background(), dbPath, SQLiteOpenOptions{ReadOnly: true})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	var count int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM users`).Scan(&count); err != nil {
+		t.Fatalf("expected read to succeed, got %v", err)
+	}
+	if count != 1 {
+		t.Fatalf("expected 1 row, got %d", count)
+	}
+	if _, err := db.Exec(`INSERT INTO users (id) VALUES (2)`); err == nil {
+		t.Fatal("expected write to fail on read-only database")
+	}
+}
+
+func TestOpenSQLiteDatabaseWithOptions_OpensFileURIAndAppliesPragmas(t *testing.T) {
+	// Arrange
+	dbPath := filepath.Join(t.TempDir(), "pragmas.sqlite")
+	seed, err := sql.Open("sqlite", dbPath)
+	if err != nil {
+		t.Fatalf("failed to open seed sqlite database: %v", err)
+	}
+	if _, err := seed.Exec(`CREATE TABLE users (id INTEGER PRIMARY KEY);`); err != nil {
+		t.Fatalf("failed to initialize seed sqlite database: %v", err)
+	}
+	if err := seed.Close(); err != nil {
+		t.Fatalf("failed to close seed sqlite database: %v", err)
+	}
+
+	// Act
+	db, err := OpenSQLiteDatabaseWithOptions(context.Background(), "file:"+dbPath+"?cache=private", SQLiteOpenOptions{
+		Pragmas: SQLitePragmas{
+			BusyTimeout: 2500,
+			JournalMode: "wal",
+			ForeignKeys: true,
+			Synchronous: "NORMAL",
+		},
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	checks := []struct {
+		pragma string
+		want   string
+	}{
+		{pragma: "busy_timeout", want: "2500"},
+		{pragma: "journal_mode", want: "wal"},
+		{pragma: "foreign_keys", want: "1"},
+		{pragma: "synchronous", want: "1"},
+	}
+	for _, check := range checks {
+		var got string
+		if err := db.QueryRow("PRAGMA " + check.pragma).Scan(&got); err != nil {
+			t.Fatalf("failed to read pragma %s: %v", check.pragma, err)
+		}
+		if got != check.want {
+			t.Fatalf("expected pragma %s=%s, got %s", check.pragma, check.want, got)
+		}
+	}
+}
+
+func TestOpenSQLiteDatabase_ReportsMissingFileForFileURI(t *testing.T) {
+	// Arrange
+	dbPath := filepath.Join(t.TempDir(), "missing.sqlite")
+
+	// Act
+	db, err := OpenSQLiteDatabase(context.Background(), "file:"+dbPath+"?mode=ro")
+
+	// Assert
+	if db != nil {
+		t.Fatal("expected no database handle for missing file")
+	}
+	if err == nil || err.Error() != "database file does not exist: "+dbPath {
+		t.Fatalf("expected missing file error for %q, got %v", dbPath, err)
+	}
+}
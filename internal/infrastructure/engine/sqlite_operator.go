@@ -6,48 +6,113 @@ import (
 	"github.com/mgierok/dbc/internal/domain/model"
 )
 
+type sqliteTypeAffinity int
+
+const (
+	sqliteAffinityNone sqliteTypeAffinity = iota
+	sqliteAffinityText
+	sqliteAffinityNumeric
+	sqliteAffinityBlob
+)
+
 type sqliteOperatorSpec struct {
 	kind          model.OperatorKind
 	name          string
 	sql           string
+	suffix        string
 	requiresValue bool
+	valueMode     model.OperatorValueMode
+	pattern       func(string) string
+	affinities    []sqliteTypeAffinity
 }
 
+var (
+	sqliteTextAffinities    = []sqliteTypeAffinity{sqliteAffinityText}
+	sqliteNumericAffinities = []sqliteTypeAffinity{sqliteAffinityNumeric}
+)
+
 var sqliteOperators = []sqliteOperatorSpec{
 	{kind: model.OperatorKindEq, name: "Equals", sql: "=", requiresValue: true},
+	{kind: model.OperatorKindEqNoCase, name: "Equals (Ignore Case)", sql: "=", suffix: " COLLATE NOCASE", requiresValue: true, affinities: sqliteTextAffinities},
 	{kind: model.OperatorKindNeq, name: "Not Equals", sql: "!=", requiresValue: true},
 	{kind: model.OperatorKindLt, name: "Less Than", sql: "<", requiresValue: true},
 	{kind: model.OperatorKindLte, name: "Less Or Equal", sql: "<=", requiresValue: true},
 	{kind: model.OperatorKindGt, name: "Greater Than", sql: ">", requiresValue: true},
 	{kind: model.OperatorKindGte, name: "Greater Or Equal", sql: ">=", requiresValue: true},
+	{kind: model.OperatorKindBetween, name: "Between", sql: "BETWEEN", requiresValue: true, valueMode: model.OperatorValueRange, affinities: sqliteNumericAffinities},
 	{kind: model.OperatorKindLike, name: "Like", sql: "LIKE", requiresValue: true},
+	{kind: model.OperatorKindNotLike, name: "Not Like", sql: "NOT LIKE", requiresValue: true, affinities: sqliteTextAffinities},
+	{kind: model.OperatorKindStarts, name: "Starts With", sql: "LIKE", suffix: ` ESCAPE '\'`, requiresValue: true, pattern: likePattern("", "%"), affinities: sqliteTextAffinities},
+	{kind: model.OperatorKindEnds, name: "Ends With", sql: "LIKE", suffix: ` ESCAPE '\'`, requiresValue: true, pattern: likePattern("%", ""), affinities: sqliteTextAffinities},
+	{kind: model.OperatorKindContains, name: "Contains", sql: "LIKE", suffix: ` ESCAPE '\'`, requiresValue: true, pattern: likePattern("%", "%"), affinities: sqliteTextAffinities},
+	{kind: model.OperatorKindGlob, name: "Glob", sql: "GLOB", requiresValue: true, affinities: sqliteTextAffinities},
+	{kind: model.OperatorKindIn, name: "In", sql: "IN", requiresValue: true, valueMode: model.OperatorValueList},
+	{kind: model.OperatorKindNotIn, name: "Not In", sql: "NOT IN", requiresValue: true, valueMode: model.OperatorValueList},
 	{kind: model.OperatorKindIsNull, name: "Is Null", sql: "IS NULL", requiresValue: false},
 	{kind: model.OperatorKindIsNotNull, name: "Is Not Null", sql: "IS NOT NULL", requiresValue: false},
 }
 
 func operatorsForType(columnType string) []model.Operator {
-	normalized := strings.ToUpper(strings.TrimSpace(columnType))
+	affinity := sqliteColumnAffinity(columnType)
 
 	operators := make([]model.Operator, 0, len(sqliteOperators))
 	for _, operator := range sqliteOperators {
+		if !operator.appliesTo(affinity) {
+			continue
+		}
 		operators = append(operators, model.Operator{
 			Name:          operator.name,
 			Kind:          operator.kind,
 			RequiresValue: operator.requiresValue,
+			ValueMode:     operator.valueMode,
 		})
 	}
+	return operators
+}
 
-	if normalized == "" {
-		return operators
+func (s sqliteOperatorSpec) appliesTo(affinity sqliteTypeAffinity) bool {
+	if len(s.affinities) == 0 || affinity == sqliteAffinityNone {
+		return true
 	}
-	return operators
+	for _, candidate := range s.affinities {
+		if candidate == affinity {
+			return true
+		}
+	}
+	return false
 }
 
-func sqliteOperatorSQL(kind model.OperatorKind) (string, bool) {
+// sqliteColumnAffinity follows SQLite's declared-type affinity rules, except
+// that an empty declared type is kept apart from BLOB so untyped columns get
+// the full operator catalogue. Date and time types resolve to numeric affinity.
+func sqliteColumnAffinity(columnType string) sqliteTypeAffinity {
+	normalized := strings.ToUpper(strings.TrimSpace(columnType))
+	switch {
+	case normalized == "":
+		return sqliteAffinityNone
+	case strings.Contains(normalized, "INT"):
+		return sqliteAffinityNumeric
+	case strings.Contains(normalized, "CHAR"), strings.Contains(normalized, "CLOB"), strings.Contains(normalized, "TEXT"):
+		return sqliteAffinityText
+	case strings.Contains(normalized, "BLOB"):
+		return sqliteAffinityBlob
+	default:
+		return sqliteAffinityNumeric
+	}
+}
+
+func sqliteOperatorSpecForKind(kind model.OperatorKind) (sqliteOperatorSpec, bool) {
 	for _, operator := range sqliteOperators {
 		if operator.kind == kind {
-			return operator.sql, true
+			return operator, true
 		}
 	}
-	return "", false
+	return sqliteOperatorSpec{}, false
+}
+
+func likePattern(prefix, suffix string) func(string) string {
+	escaper := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return func(value string) string {
+		return prefix + escaper.Replace(value) + suffix
+	}
 }
@@ -28,3 +28,67 @@ func TestOperatorsForType_ReturnsSQLiteOperators(t *testing.T) {
 		t.Fatal("expected IS NULL operator")
 	}
 }
+
+func TestOperatorsForType_DependsOnColumnAffinity(t *testing.T) {
+	testCases := []struct {
+		name       string
+		columnType string
+		includes   []model.OperatorKind
+		excludes   []model.OperatorKind
+	}{
+		{
+			name:       "text",
+			columnType: "VARCHAR(40)",
+			includes:   []model.OperatorKind{model.OperatorKindNotLike, model.OperatorKindGlob, model.OperatorKindStarts, model.OperatorKindEnds, model.OperatorKindContains, model.OperatorKindEqNoCase},
+			excludes:   []model.OperatorKind{model.OperatorKindBetween},
+		},
+		{
+			name:       "integer",
+			columnType: "INTEGER",
+			includes:   []model.OperatorKind{model.OperatorKindBetween},
+			excludes:   []model.OperatorKind{model.OperatorKindGlob, model.OperatorKindContains, model.OperatorKindEqNoCase},
+		},
+		{
+			name:       "date",
+			columnType: "DATETIME",
+			includes:   []model.OperatorKind{model.OperatorKindBetween},
+			excludes:   []model.OperatorKind{model.OperatorKindNotLike},
+		},
+		{
+			name:       "blob",
+			columnType: "BLOB",
+			excludes:   []model.OperatorKind{model.OperatorKindBetween, model.OperatorKindGlob},
+		},
+		{
+			name:       "untyped",
+			columnType: " ",
+			includes:   []model.OperatorKind{model.OperatorKindBetween, model.OperatorKindGlob},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			operators := operatorsForType(tc.columnType)
+
+			// Assert
+			kinds := make(map[model.OperatorKind]model.Operator, len(operators))
+			for _, operator := range operators {
+				kinds[operator.Kind] = operator
+			}
+			for _, kind := range append(tc.includes, model.OperatorKindIn, model.OperatorKindNotIn, model.OperatorKindIsNull, model.OperatorKindEq) {
+				if _, ok := kinds[kind]; !ok {
+					t.Fatalf("expected operator %q for type %q", kind, tc.columnType)
+				}
+			}
+			for _, kind := range tc.excludes {
+				if _, ok := kinds[kind]; ok {
+					t.Fatalf("expected no operator %q for type %q", kind, tc.columnType)
+				}
+			}
+			if kinds[model.OperatorKindIn].ValueMode != model.OperatorValueList {
+				t.Fatalf("expected IN to use list value mode, got %q", kinds[model.OperatorKindIn].ValueMode)
+			}
+		})
+	}
+}
@@ -24,18 +24,27 @@ type tableColumnInfo struct {
 	primaryKeyOrder int
 	autoIncrement   bool
 	foreignKeys     []model.ForeignKeyRef
+	hidden          int
+	generatedExpr   string
+}
+
+func (c tableColumnInfo) generated() bool {
+	return c.hidden == columnHiddenGeneratedVirtual || c.hidden == columnHiddenGeneratedStored
 }
 
 func (c tableColumnInfo) toModelColumn() model.Column {
 	return model.Column{
-		Name:          c.name,
-		Type:          c.typ,
-		Nullable:      !c.notNull,
-		PrimaryKey:    c.primaryKeyOrder > 0,
-		Unique:        c.unique,
-		DefaultValue:  c.defaultValue,
-		AutoIncrement: c.autoIncrement,
-		ForeignKeys:   append([]model.ForeignKeyRef(nil), c.foreignKeys...),
+		Name:                 c.name,
+		Type:                 c.typ,
+		Nullable:             !c.notNull,
+		PrimaryKey:           c.primaryKeyOrder > 0,
+		Unique:               c.unique,
+		DefaultValue:         c.defaultValue,
+		AutoIncrement:        c.autoIncrement,
+		ForeignKeys:          append([]model.ForeignKeyRef(nil), c.foreignKeys...),
+		Generated:            c.generated(),
+		GeneratedStored:      c.hidden == columnHiddenGeneratedStored,
+		GenerationExpression: c.generatedExpr,
 	}
 }
 
@@ -53,7 +62,8 @@ func (e *SQLiteEngine) tableColumnInfos(ctx context.Context, tableName string) (
 		return nil, err
 	}
 
-	query := fmt.Sprintf("PRAGMA table_info(%s)", quoteIdentifier(tableName))
+	var generatedExpressions map[string]string
+	query := fmt.Sprintf("PRAGMA table_xinfo(%s)", quoteIdentifier(tableName))
 	rows, err := e.db.QueryContext(ctx, query)
 	if err != nil {
 		return nil, err
@@ -72,10 +82,21 @@ func (e *SQLiteEngine) tableColumnInfos(ctx context.Context, tableName string) (
 			notNull int
 			dflt    sql.NullString
 			pk      int
+			hidden  int
 		)
-		if err := rows.Scan(&cid, &name, &typ, &notNull, &dflt, &pk); err != nil {
+		if err := rows.Scan(&cid, &name, &typ, &notNull, &dflt, &pk, &hidden); err != nil {
 			return nil, err
 		}
+		if hidden == columnHiddenVirtualTable {
+			continue
+		}
+		var generatedExpr string
+		if hidden != columnHiddenNone {
+			if generatedExpressions == nil {
+				generatedExpressions = generatedColumnExpressions(tableSQL)
+			}
+			generatedExpr = generatedExpressions[strings.ToLower(name)]
+		}
 		var defaultValue *string
 		if dflt.Valid {
 			defaultValue = &dflt.String
@@ -90,6 +111,8 @@ func (e *SQLiteEngine) tableColumnInfos(ctx context.Context, tableName string) (
 			primaryKeyOrder: pk,
 			autoIncrement:   pk > 0 && columnHasAutoIncrement(tableSQL, name),
 			foreignKeys:     append([]model.ForeignKeyRef(nil), foreignKeysByColumn[name]...),
+			hidden:          hidden,
+			generatedExpr:   generatedExpr,
 		})
 	}
 	if err := rows.Err(); err != nil {
@@ -185,7 +208,11 @@ func (e *SQLiteEngine) foreignKeysByColumn(ctx context.Context, tableName string
 		}
 	}()
 
-	foreignKeys = make(map[string][]model.ForeignKeyRef)
+	// Rows of one constraint share its id; only single-column constraints
+	// become column references, composite ones stay table-level.
+	refs := make(map[int]model.ForeignKeyRef)
+	sources := make(map[int]string)
+	var ids []int
 	for rows.Next() {
 		var (
 			id       int
@@ -200,24 +227,40 @@ func (e *SQLiteEngine) foreignKeysByColumn(ctx context.Context, tableName string
 		if err := rows.Scan(&id, &seq, &refTable, &from, &to, &onUpdate, &onDelete, &match); err != nil {
 			return nil, err
 		}
+		if _, seen := refs[id]; seen {
+			delete(sources, id)
+			continue
+		}
 		ref := model.ForeignKeyRef{Table: refTable}
 		if to.Valid {
 			ref.Column = to.String
 		}
-		foreignKeys[from] = append(foreignKeys[from], ref)
+		refs[id] = ref
+		sources[id] = from
+		ids = append(ids, id)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
+	foreignKeys = make(map[string][]model.ForeignKeyRef)
+	for _, id := range ids {
+		if from, single := sources[id]; single {
+			foreignKeys[from] = append(foreignKeys[from], refs[id])
+		}
+	}
 	return foreignKeys, nil
 }
 
-func appendIdentityProjection(selectParts []string, pkColumns []tableColumnInfo) []string {
+// appendIdentityProjection projects the identity columns and whether they
+// identify the row. A non-empty viewName also requires that no other row of
+// that view holds the same values, since identical view rows cannot be told
+// apart.
+func appendIdentityProjection(selectParts []string, pkColumns []tableColumnInfo, viewName string) []string {
 	for index, column := range pkColumns {
 		selectParts = append(selectParts, identityProjectionForColumn(column, index))
 	}
 	if len(pkColumns) > 0 {
-		selectParts = append(selectParts, identityAvailabilityProjection(pkColumns))
+		selectParts = append(selectParts, identityAvailabilityProjection(pkColumns, viewName))
 	}
 	return selectParts
 }
@@ -283,10 +326,13 @@ func identityProjectionForColumn(column tableColumnInfo, index int) string {
 	)
 }
 
-func identityAvailabilityProjection(pkColumns []tableColumnInfo) string {
-	conditions := make([]string, 0, len(pkColumns))
+func identityAvailabilityProjection(pkColumns []tableColumnInfo, viewName string) string {
+	conditions := make([]string, 0, len(pkColumns)+1)
 	for _, column := range pkColumns {
-		conditions = append(conditions, materializationSafeCondition(quoteIdentifier(column.name)))
+		conditions = append(conditions, "("+materializationSafeCondition(quoteIdentifier(column.name))+")")
+	}
+	if viewName != "" {
+		conditions = append(conditions, uniqueViewRowCondition(viewName, pkColumns))
 	}
 	return fmt.Sprintf(
 		"CASE WHEN %s THEN 1 ELSE 0 END AS %s",
@@ -295,6 +341,25 @@ func identityAvailabilityProjection(pkColumns []tableColumnInfo) string {
 	)
 }
 
+// uniqueViewRowCondition holds when no second row of viewName matches the
+// current row on every column. IS compares NULLs as equal, as the identity
+// clause a save builds does.
+func uniqueViewRowCondition(viewName string, columns []tableColumnInfo) string {
+	viewRef := quoteIdentifier(viewName)
+	peerRef := quoteIdentifier(viewPeerAlias)
+	matches := make([]string, 0, len(columns))
+	for _, column := range columns {
+		columnRef := quoteIdentifier(column.name)
+		matches = append(matches, fmt.Sprintf("%s.%s IS %s.%s", peerRef, columnRef, viewRef, columnRef))
+	}
+	return fmt.Sprintf(
+		"NOT EXISTS (SELECT 1 FROM %s AS %s WHERE %s LIMIT 1 OFFSET 1)",
+		viewRef,
+		peerRef,
+		strings.Join(matches, " AND "),
+	)
+}
+
 func materializationSafeCondition(columnRef string) string {
 	return fmt.Sprintf("%s IS NULL OR length(CAST(%s AS BLOB)) <= %d", columnRef, columnRef, maxMaterializedRecordCellBytes)
 }
@@ -335,6 +400,20 @@ func materializeIdentityValue(columnType string, raw any) (model.Value, error) {
 	return service.ParseValue(columnType, text, isNull, true)
 }
 
+// materializeViewIdentityValue keeps a view column value as SQLite returned
+// it. View columns carry no reliable declared type, so the stored value itself
+// is bound when the row is matched again.
+func materializeViewIdentityValue(raw any) model.Value {
+	switch typed := raw.(type) {
+	case nil:
+		return model.Value{IsNull: true, Text: "NULL"}
+	case []byte:
+		return model.Value{Text: "0x" + hex.EncodeToString(typed), Raw: append([]byte(nil), typed...)}
+	default:
+		return model.Value{Text: fmt.Sprint(typed), Raw: typed}
+	}
+}
+
 func identityInputFromRaw(columnType string, raw any) (string, bool, error) {
 	if raw == nil {
 		return "", true, nil
@@ -403,6 +482,8 @@ func projectedFlagEnabled(raw any) bool {
 
 const identityAvailabilityAlias = "__dbc_identity_available"
 
+const viewPeerAlias = "__dbc_peer"
+
 func identityColumnAlias(index int) string {
 	return fmt.Sprintf("__dbc_identity_%d", index)
 }
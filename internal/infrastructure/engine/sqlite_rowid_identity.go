@@ -0,0 +1,73 @@
+package engine
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+// rowIDAliases are the names SQLite accepts for the implicit rowid, in the
+// order tried when a real column shadows one of them.
+var rowIDAliases = []string{"rowid", "_rowid_", "oid"}
+
+// identityColumns returns the columns that identify a persisted record: the
+// primary key, or for ordinary rowid tables without one, the rowid under an
+// alias no real column shadows. Views are identified by all of their columns;
+// ListRecords withholds the identity of view rows another row repeats.
+// Virtual tables and WITHOUT ROWID tables without a usable key get no
+// identity.
+func (e *SQLiteEngine) identityColumns(ctx context.Context, tableName string, kind model.TableKind, columns []tableColumnInfo) ([]tableColumnInfo, error) {
+	if kind == model.TableKindView {
+		return columns, nil
+	}
+	pkColumns := primaryKeyColumnsInOrder(columns)
+	if len(pkColumns) > 0 || kind != model.TableKindTable {
+		return pkColumns, nil
+	}
+	tableSQL, err := e.tableDefinitionSQL(ctx, tableName)
+	if err != nil {
+		return nil, err
+	}
+	if !tableHasRowID(tableSQL) {
+		return nil, nil
+	}
+	alias := rowIDAlias(columns)
+	if alias == "" {
+		return nil, nil
+	}
+	return []tableColumnInfo{{name: alias, typ: "INTEGER", notNull: true, primaryKeyOrder: 1}}, nil
+}
+
+// tableHasRowID reports whether a stored CREATE TABLE statement defines an
+// ordinary rowid table. An empty statement belongs to a table outside main's
+// sqlite_master, which is assumed to have a rowid.
+func tableHasRowID(tableSQL string) bool {
+	tokens := tokenizeSQL(tableSQL)
+	if len(tokens) >= 2 && strings.EqualFold(tokens[1].text, "VIRTUAL") {
+		return false
+	}
+	for i := 0; i+1 < len(tokens); i++ {
+		if tokens[i].kind == sqlTokenWord && strings.EqualFold(tokens[i].text, "WITHOUT") &&
+			tokens[i+1].kind == sqlTokenWord && strings.EqualFold(tokens[i+1].text, "ROWID") {
+			return false
+		}
+	}
+	return true
+}
+
+func rowIDAlias(columns []tableColumnInfo) string {
+	for _, alias := range rowIDAliases {
+		shadowed := false
+		for _, column := range columns {
+			if strings.EqualFold(column.name, alias) {
+				shadowed = true
+				break
+			}
+		}
+		if !shadowed {
+			return alias
+		}
+	}
+	return ""
+}
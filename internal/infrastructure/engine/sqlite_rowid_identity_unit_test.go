@@ -0,0 +1,29 @@
+package engine
+
+import "testing"
+
+func TestTableHasRowID(t *testing.T) {
+	tests := []struct {
+		name     string
+		tableSQL string
+		want     bool
+	}{
+		{name: "ordinary table", tableSQL: "CREATE TABLE t (a TEXT)", want: true},
+		{name: "without rowid", tableSQL: "CREATE TABLE t (a TEXT PRIMARY KEY) without  rowid", want: false},
+		{name: "quoted words are not clauses", tableSQL: `CREATE TABLE t ("WITHOUT" TEXT, "ROWID" TEXT, b TEXT DEFAULT 'WITHOUT ROWID')`, want: true},
+		{name: "virtual table", tableSQL: "CREATE VIRTUAL TABLE t USING fts5(body)", want: false},
+		{name: "unknown definition", tableSQL: "", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			got := tableHasRowID(tt.tableSQL)
+
+			// Assert
+			if got != tt.want {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+// indexExpressionColumn names index key parts built from expressions, which
+// PRAGMA index_xinfo reports without a column name.
+const indexExpressionColumn = "<expr>"
+
+func (e *SQLiteEngine) tableIndexes(ctx context.Context, tableName string) (indexes []model.Index, err error) {
+	query := fmt.Sprintf("PRAGMA index_list(%s)", quoteIdentifier(tableName))
+	rows, err := e.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}()
+
+	for rows.Next() {
+		var (
+			seq     int
+			name    string
+			unique  int
+			origin  string
+			partial int
+		)
+		if err := rows.Scan(&seq, &name, &unique, &origin, &partial); err != nil {
+			return nil, err
+		}
+		indexes = append(indexes, model.Index{
+			Name:    name,
+			Unique:  unique != 0,
+			Partial: partial != 0,
+			Origin:  model.IndexOrigin(origin),
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// PRAGMA index_list returns the newest index first; list them in
+	// creation order so the schema view reads like the DDL.
+	for i, j := 0, len(indexes)-1; i < j; i, j = i+1, j-1 {
+		indexes[i], indexes[j] = indexes[j], indexes[i]
+	}
+	for i := range indexes {
+		columns, err := e.indexKeyColumns(ctx, indexes[i].Name)
+		if err != nil {
+			return nil, err
+		}
+		indexes[i].Columns = columns
+		definition, err := e.schemaObjectSQL(ctx, "index", indexes[i].Name)
+		if err != nil {
+			return nil, err
+		}
+		indexes[i].SQL = definition
+	}
+	return indexes, nil
+}
+
+func (e *SQLiteEngine) indexKeyColumns(ctx context.Context, indexName string) (columns []string, err error) {
+	query := fmt.Sprintf("PRAGMA index_xinfo(%s)", quoteIdentifier(indexName))
+	rows, err := e.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}()
+
+	for rows.Next() {
+		var (
+			seqno     int
+			cid       int
+			name      sql.NullString
+			desc      int
+			collation sql.NullString
+			key       int
+		)
+		if err := rows.Scan(&seqno, &cid, &name, &desc, &collation, &key); err != nil {
+			return nil, err
+		}
+		if key == 0 {
+			continue
+		}
+		column := indexExpressionColumn
+		if name.Valid {
+			column = name.String
+		}
+		if desc != 0 {
+			column += " DESC"
+		}
+		columns = append(columns, column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return columns, nil
+}
+
+func (e *SQLiteEngine) tableTriggers(ctx context.Context, tableName string) (triggers []model.Trigger, err error) {
+	const query = `
+		SELECT name, sql
+		FROM sqlite_master
+		WHERE type = 'trigger' AND tbl_name = ? COLLATE NOCASE
+		ORDER BY name
+	`
+	rows, err := e.db.QueryContext(ctx, query, tableName)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}()
+
+	for rows.Next() {
+		var (
+			name       string
+			definition sql.NullString
+		)
+		if err := rows.Scan(&name, &definition); err != nil {
+			return nil, err
+		}
+		triggers = append(triggers, model.Trigger{Name: name, SQL: definition.String})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return triggers, nil
+}
+
+func (e *SQLiteEngine) schemaObjectSQL(ctx context.Context, objectType, name string) (string, error) {
+	var definition sql.NullString
+	const query = `SELECT sql FROM sqlite_master WHERE type = ? AND name = ? COLLATE NOCASE`
+	if err := e.db.QueryRowContext(ctx, query, objectType, name).Scan(&definition); err != nil {
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
+		return "", err
+	}
+	return definition.String, nil
+}
@@ -13,6 +13,8 @@ var (
 	ErrMissingSortColumn    = errors.New("sort column is required")
 	ErrUnknownSortDirection = errors.New("unknown sort direction")
 	ErrUnknownSortColumn    = errors.New("unknown sort column")
+	ErrUnknownSortNulls     = errors.New("unknown sort nulls placement")
+	ErrUnknownSortCollation = errors.New("unknown sort collation")
 )
 
 func normalizeSortDirection(direction model.SortDirection) (string, error) {
@@ -29,34 +31,148 @@ func normalizeSortDirection(direction model.SortDirection) (string, error) {
 	}
 }
 
-func (e *SQLiteEngine) buildSortClause(ctx context.Context, tableName string, sort *model.Sort) (string, error) {
-	if sort == nil {
+func normalizeSortNulls(nulls model.SortNulls) (model.SortNulls, error) {
+	value := model.SortNulls(strings.ToUpper(strings.TrimSpace(string(nulls))))
+	switch value {
+	case model.SortNullsDefault, model.SortNullsFirst, model.SortNullsLast:
+		return value, nil
+	default:
+		return "", ErrUnknownSortNulls
+	}
+}
+
+func normalizeSortCollation(collation model.SortCollation) (string, error) {
+	switch model.SortCollation(strings.ToUpper(strings.TrimSpace(string(collation)))) {
+	case model.SortCollationDefault:
 		return "", nil
+	case model.SortCollationNoCase:
+		return " COLLATE NOCASE", nil
+	case model.SortCollationRTrim:
+		return " COLLATE RTRIM", nil
+	default:
+		return "", ErrUnknownSortCollation
 	}
-	column := strings.TrimSpace(sort.Column)
-	if column == "" {
-		return "", ErrMissingSortColumn
+}
+
+// sqliteSortTerm is one validated ORDER BY key. columnRef is already quoted
+// (or the bare rowid tiebreaker) and collation is an allowlisted suffix.
+type sqliteSortTerm struct {
+	columnRef  string
+	collation  string
+	descending bool
+	nulls      model.SortNulls
+}
+
+func (t sqliteSortTerm) sql() string {
+	direction := string(model.SortDirectionAsc)
+	if t.descending {
+		direction = string(model.SortDirectionDesc)
 	}
+	nulls := ""
+	if t.nulls != model.SortNullsDefault {
+		nulls = " NULLS " + string(t.nulls)
+	}
+	return t.columnRef + t.collation + " " + direction + nulls
+}
 
-	columns, err := e.tableColumns(ctx, tableName)
-	if err != nil {
-		return "", err
+// nullsFirst reports where NULL sorts for this term; SQLite treats NULL as
+// the smallest value when no explicit placement is given.
+func (t sqliteSortTerm) nullsFirst() bool {
+	if t.nulls == model.SortNullsDefault {
+		return !t.descending
+	}
+	return t.nulls == model.SortNullsFirst
+}
+
+func (t sqliteSortTerm) reversed() sqliteSortTerm {
+	reversed := t
+	reversed.descending = !t.descending
+	reversed.nulls = model.SortNullsFirst
+	if t.nullsFirst() {
+		reversed.nulls = model.SortNullsLast
 	}
-	normalizedColumn, ok := columns[strings.ToLower(column)]
-	if !ok {
-		return "", ErrUnknownSortColumn
+	return reversed
+}
+
+func sortClause(terms []sqliteSortTerm) string {
+	parts := make([]string, len(terms))
+	for i, term := range terms {
+		parts[i] = term.sql()
 	}
+	return "ORDER BY " + strings.Join(parts, ", ")
+}
 
-	direction, err := normalizeSortDirection(sort.Direction)
-	if err != nil {
-		return "", err
+// buildSortTerms validates the user sort keys and appends a deterministic
+// tiebreaker, so LIMIT/OFFSET and keyset pages stay stable when sort values
+// repeat.
+func (e *SQLiteEngine) buildSortTerms(ctx context.Context, tableName string, sorts []model.Sort, keyColumns []tableColumnInfo) ([]sqliteSortTerm, error) {
+	for _, sort := range sorts {
+		if strings.TrimSpace(sort.Column) == "" {
+			return nil, ErrMissingSortColumn
+		}
+	}
+
+	var columns map[string]string
+	if len(sorts) > 0 {
+		var err error
+		columns, err = e.tableColumns(ctx, tableName)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	return fmt.Sprintf("ORDER BY %s %s", quoteIdentifier(normalizedColumn), direction), nil
+	terms := make([]sqliteSortTerm, 0, len(sorts)+len(keyColumns)+1)
+	sortedColumns := make(map[string]struct{}, len(sorts))
+	for _, sort := range sorts {
+		normalizedColumn, ok := columns[strings.ToLower(strings.TrimSpace(sort.Column))]
+		if !ok {
+			return nil, ErrUnknownSortColumn
+		}
+		direction, err := normalizeSortDirection(sort.Direction)
+		if err != nil {
+			return nil, err
+		}
+		nulls, err := normalizeSortNulls(sort.Nulls)
+		if err != nil {
+			return nil, err
+		}
+		collation, err := normalizeSortCollation(sort.Collation)
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, sqliteSortTerm{
+			columnRef:  quoteIdentifier(normalizedColumn),
+			collation:  collation,
+			descending: direction == string(model.SortDirectionDesc),
+			nulls:      nulls,
+		})
+		if collation == "" {
+			sortedColumns[strings.ToLower(normalizedColumn)] = struct{}{}
+		}
+	}
+	terms = append(terms, sortTiebreakerTerms(keyColumns, sortedColumns)...)
+
+	return terms, nil
+}
+
+// sortTiebreakerTerms orders by keyColumns, the record identity columns with
+// their rowid alias resolved, or by rowid when the table has no identity.
+func sortTiebreakerTerms(keyColumns []tableColumnInfo, sortedColumns map[string]struct{}) []sqliteSortTerm {
+	if len(keyColumns) == 0 {
+		return []sqliteSortTerm{{columnRef: "rowid"}}
+	}
+	terms := make([]sqliteSortTerm, 0, len(keyColumns))
+	for _, column := range keyColumns {
+		if _, ok := sortedColumns[strings.ToLower(column.name)]; ok {
+			continue
+		}
+		terms = append(terms, sqliteSortTerm{columnRef: quoteIdentifier(column.name)})
+	}
+	return terms
 }
 
 func (e *SQLiteEngine) tableColumns(ctx context.Context, tableName string) (columns map[string]string, err error) {
-	query := fmt.Sprintf("PRAGMA table_info(%s)", quoteIdentifier(tableName))
+	query := fmt.Sprintf("PRAGMA table_xinfo(%s)", quoteIdentifier(tableName))
 	rows, err := e.db.QueryContext(ctx, query)
 	if err != nil {
 		return nil, err
@@ -76,10 +192,14 @@ func (e *SQLiteEngine) tableColumns(ctx context.Context, tableName string) (colu
 			notnull int
 			dflt    any
 			pk      int
+			hidden  int
 		)
-		if err := rows.Scan(&cid, &name, &typ, &notnull, &dflt, &pk); err != nil {
+		if err := rows.Scan(&cid, &name, &typ, &notnull, &dflt, &pk, &hidden); err != nil {
 			return nil, err
 		}
+		if hidden == columnHiddenVirtualTable {
+			continue
+		}
 		columns[strings.ToLower(name)] = name
 	}
 	if err := rows.Err(); err != nil {
@@ -0,0 +1,126 @@
+package engine
+
+import "strings"
+
+type sqlTokenKind int
+
+const (
+	sqlTokenWord sqlTokenKind = iota
+	sqlTokenQuotedIdentifier
+	sqlTokenString
+	sqlTokenPunct
+)
+
+type sqlToken struct {
+	kind  sqlTokenKind
+	text  string
+	start int
+	end   int
+}
+
+func matchingParenthesis(tokens []sqlToken, open int) int {
+	depth := 0
+	for i := open; i < len(tokens); i++ {
+		switch tokens[i].text {
+		case "(":
+			depth++
+		case ")":
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func identifierText(token sqlToken) string {
+	if token.kind != sqlTokenQuotedIdentifier || len(token.text) < 2 {
+		return token.text
+	}
+	quote := token.text[0]
+	inner := token.text[1 : len(token.text)-1]
+	switch quote {
+	case '"':
+		return strings.ReplaceAll(inner, `""`, `"`)
+	case '`':
+		return strings.ReplaceAll(inner, "``", "`")
+	default:
+		return inner
+	}
+}
+
+// tokenizeSQL splits statement into words, quoted identifiers, string
+// literals, and single-character punctuation, dropping whitespace and
+// comments.
+func tokenizeSQL(statement string) []sqlToken {
+	var tokens []sqlToken
+	for i := 0; i < len(statement); {
+		c := statement[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f':
+			i++
+		case c == '-' && i+1 < len(statement) && statement[i+1] == '-':
+			end := strings.IndexByte(statement[i:], '\n')
+			if end < 0 {
+				return tokens
+			}
+			i += end + 1
+		case c == '/' && i+1 < len(statement) && statement[i+1] == '*':
+			end := strings.Index(statement[i+2:], "*/")
+			if end < 0 {
+				return tokens
+			}
+			i += end + 4
+		case c == '\'':
+			end := quotedEnd(statement, i, '\'')
+			tokens = append(tokens, sqlToken{kind: sqlTokenString, text: statement[i:end], start: i, end: end})
+			i = end
+		case c == '"' || c == '`':
+			end := quotedEnd(statement, i, c)
+			tokens = append(tokens, sqlToken{kind: sqlTokenQuotedIdentifier, text: statement[i:end], start: i, end: end})
+			i = end
+		case c == '[':
+			end := strings.IndexByte(statement[i:], ']')
+			if end < 0 {
+				end = len(statement)
+			} else {
+				end += i + 1
+			}
+			tokens = append(tokens, sqlToken{kind: sqlTokenQuotedIdentifier, text: statement[i:end], start: i, end: end})
+			i = end
+		case isSQLWordByte(c):
+			end := i + 1
+			for end < len(statement) && isSQLWordByte(statement[end]) {
+				end++
+			}
+			tokens = append(tokens, sqlToken{kind: sqlTokenWord, text: statement[i:end], start: i, end: end})
+			i = end
+		default:
+			tokens = append(tokens, sqlToken{kind: sqlTokenPunct, text: statement[i : i+1], start: i, end: i + 1})
+			i++
+		}
+	}
+	return tokens
+}
+
+// quotedEnd returns the index just past the quote closing the literal that
+// starts at start, treating a doubled quote as an escaped one.
+func quotedEnd(statement string, start int, quote byte) int {
+	for i := start + 1; i < len(statement); i++ {
+		if statement[i] != quote {
+			continue
+		}
+		if i+1 < len(statement) && statement[i+1] == quote {
+			i++
+			continue
+		}
+		return i + 1
+	}
+	return len(statement)
+}
+
+func isSQLWordByte(c byte) bool {
+	return c == '_' || c == '$' || c >= 0x80 ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
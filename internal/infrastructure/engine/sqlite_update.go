@@ -15,11 +15,20 @@ type txExecutor interface {
 }
 
 func (e *SQLiteEngine) ApplyRecordChanges(ctx context.Context, tableName string, changes model.TableChanges) (int, error) {
-	if strings.TrimSpace(tableName) == "" {
-		return 0, fmt.Errorf("table name is required")
+	return e.ApplyDatabaseChanges(ctx, []model.TableChangeSet{{Table: tableName, Changes: changes}})
+}
+
+// ApplyDatabaseChanges saves the changes of several tables in one
+// transaction. Inserts and updates run with referenced tables first and
+// deletes with referencing tables first, so foreign keys hold at every step.
+func (e *SQLiteEngine) ApplyDatabaseChanges(ctx context.Context, changeSets []model.TableChangeSet) (int, error) {
+	ordered, err := e.prepareChangeSets(ctx, changeSets)
+	if err != nil {
+		return 0, err
 	}
-	if len(changes.Inserts) == 0 && len(changes.Updates) == 0 && len(changes.Deletes) == 0 {
-		return 0, model.ErrMissingTableChanges
+	statements, err := buildWriteStatements(ordered)
+	if err != nil {
+		return 0, err
 	}
 
 	tx, err := e.db.BeginTx(ctx, nil)
@@ -27,28 +36,97 @@ func (e *SQLiteEngine) ApplyRecordChanges(ctx context.Context, tableName string,
 		return 0, err
 	}
 
-	inserted, err := applyRecordInserts(ctx, tx, tableName, changes.Inserts)
-	if err != nil {
-		return 0, withRollbackError(err, tx.Rollback)
+	for _, changeSet := range ordered {
+		if err := ensureIdentitiesMatchOneRecord(ctx, tx, changeSet.Table, changeSet.Changes); err != nil {
+			return 0, withRollbackError(err, tx.Rollback)
+		}
 	}
-	updated, err := applyRecordUpdates(ctx, tx, tableName, changes.Updates, changes.Deletes)
-	if err != nil {
-		return 0, withRollbackError(err, tx.Rollback)
+
+	var conflicts []model.RecordConflict
+	for _, changeSet := range ordered {
+		if changeSet.Changes.Force {
+			continue
+		}
+		tableConflicts, err := detectRecordConflicts(ctx, tx, changeSet.Table, changeSet.Changes)
+		if err != nil {
+			return 0, withRollbackError(err, tx.Rollback)
+		}
+		conflicts = append(conflicts, tableConflicts...)
 	}
-	deleted, err := applyRecordDeletes(ctx, tx, tableName, changes.Deletes)
-	if err != nil {
-		return 0, withRollbackError(err, tx.Rollback)
+	if len(conflicts) > 0 {
+		return 0, withRollbackError(&model.RecordConflictError{Conflicts: conflicts}, tx.Rollback)
+	}
+
+	total := 0
+	for _, statement := range statements {
+		affected, err := execAffectedRows(ctx, tx, statement.SQL, bindValues(statement.Args)...)
+		if err != nil {
+			return 0, withRollbackError(err, tx.Rollback)
+		}
+		total += affected
 	}
 
 	if err := tx.Commit(); err != nil {
 		return 0, err
 	}
 
-	return inserted + updated + deleted, nil
+	return total, nil
 }
 
-func applyRecordInserts(ctx context.Context, tx txExecutor, tableName string, inserts []model.RecordInsert) (int, error) {
-	total := 0
+// PreviewDatabaseChanges returns the statements ApplyDatabaseChanges would
+// execute for changeSets, in execution order, without touching the database.
+func (e *SQLiteEngine) PreviewDatabaseChanges(ctx context.Context, changeSets []model.TableChangeSet) ([]model.WriteStatement, error) {
+	ordered, err := e.prepareChangeSets(ctx, changeSets)
+	if err != nil {
+		return nil, err
+	}
+	return buildWriteStatements(ordered)
+}
+
+func (e *SQLiteEngine) prepareChangeSets(ctx context.Context, changeSets []model.TableChangeSet) ([]model.TableChangeSet, error) {
+	if len(changeSets) == 0 {
+		return nil, model.ErrMissingTableChanges
+	}
+	for _, changeSet := range changeSets {
+		if strings.TrimSpace(changeSet.Table) == "" {
+			return nil, model.ErrMissingTableName
+		}
+		changes := changeSet.Changes
+		if len(changes.Inserts) == 0 && len(changes.Updates) == 0 && len(changes.Deletes) == 0 {
+			return nil, model.ErrMissingTableChanges
+		}
+	}
+	return e.orderChangeSetsByForeignKeys(ctx, changeSets)
+}
+
+func buildWriteStatements(ordered []model.TableChangeSet) ([]model.WriteStatement, error) {
+	var statements []model.WriteStatement
+	for _, changeSet := range ordered {
+		inserts, err := buildInsertStatements(changeSet.Table, changeSet.Changes.Inserts)
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, inserts...)
+	}
+	for _, changeSet := range ordered {
+		updates, err := buildUpdateStatements(changeSet.Table, changeSet.Changes.Updates, changeSet.Changes.Deletes)
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, updates...)
+	}
+	for i := len(ordered) - 1; i >= 0; i-- {
+		deletes, err := buildDeleteStatements(ordered[i].Table, ordered[i].Changes.Deletes)
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, deletes...)
+	}
+	return statements, nil
+}
+
+func buildInsertStatements(tableName string, inserts []model.RecordInsert) ([]model.WriteStatement, error) {
+	statements := make([]model.WriteStatement, 0, len(inserts))
 	for _, insert := range inserts {
 		orderedColumns := make([]string, 0, len(insert.Values)+len(insert.ExplicitAutoValues))
 		columnValues := make(map[string]model.Value, len(insert.Values)+len(insert.ExplicitAutoValues))
@@ -56,7 +134,7 @@ func applyRecordInserts(ctx context.Context, tx txExecutor, tableName string, in
 		for _, value := range insert.Values {
 			column := strings.TrimSpace(value.Column)
 			if column == "" {
-				return 0, model.ErrMissingInsertValues
+				return nil, model.ErrMissingInsertValues
 			}
 			if _, exists := columnValues[column]; !exists {
 				orderedColumns = append(orderedColumns, column)
@@ -66,7 +144,7 @@ func applyRecordInserts(ctx context.Context, tx txExecutor, tableName string, in
 		for _, value := range insert.ExplicitAutoValues {
 			column := strings.TrimSpace(value.Column)
 			if column == "" {
-				return 0, model.ErrMissingInsertValues
+				return nil, model.ErrMissingInsertValues
 			}
 			if _, exists := columnValues[column]; !exists {
 				orderedColumns = append(orderedColumns, column)
@@ -74,94 +152,91 @@ func applyRecordInserts(ctx context.Context, tx txExecutor, tableName string, in
 			columnValues[column] = value.Value
 		}
 		if len(orderedColumns) == 0 {
-			return 0, model.ErrMissingInsertValues
+			return nil, model.ErrMissingInsertValues
 		}
 
 		columns := make([]string, 0, len(orderedColumns))
 		placeholders := make([]string, 0, len(orderedColumns))
-		args := make([]any, 0, len(orderedColumns))
+		args := make([]model.Value, 0, len(orderedColumns))
 		for _, column := range orderedColumns {
 			columns = append(columns, quoteIdentifier(column))
 			placeholders = append(placeholders, "?")
-			args = append(args, bindValue(columnValues[column]))
+			args = append(args, columnValues[column])
 		}
 
-		query := fmt.Sprintf(
-			"INSERT INTO %s (%s) VALUES (%s)",
-			quoteIdentifier(tableName),
-			strings.Join(columns, ", "),
-			strings.Join(placeholders, ", "),
-		)
-		affected, err := execAffectedRows(ctx, tx, query, args...)
-		if err != nil {
-			return 0, err
-		}
-		total += affected
+		statements = append(statements, model.WriteStatement{
+			Table: tableName,
+			SQL: fmt.Sprintf(
+				"INSERT INTO %s (%s) VALUES (%s)",
+				quoteIdentifier(tableName),
+				strings.Join(columns, ", "),
+				strings.Join(placeholders, ", "),
+			),
+			Args: args,
+		})
 	}
-	return total, nil
+	return statements, nil
 }
 
-func applyRecordUpdates(ctx context.Context, tx txExecutor, tableName string, updates []model.RecordUpdate, deletes []model.RecordDelete) (int, error) {
+func buildUpdateStatements(tableName string, updates []model.RecordUpdate, deletes []model.RecordDelete) ([]model.WriteStatement, error) {
 	skippedDeletes := make(map[string]struct{}, len(deletes))
 	for _, deleteChange := range deletes {
 		signature, err := recordIdentitySignature(deleteChange.Identity)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
 		skippedDeletes[signature] = struct{}{}
 	}
 
-	total := 0
+	statements := make([]model.WriteStatement, 0, len(updates))
 	for _, update := range updates {
 		if len(update.Changes) == 0 {
-			return 0, model.ErrMissingRecordChanges
+			return nil, model.ErrMissingRecordChanges
 		}
 		signature, err := recordIdentitySignature(update.Identity)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
 		if _, skip := skippedDeletes[signature]; skip {
 			continue
 		}
 		whereClause, whereArgs, err := buildRecordIdentityClause(update.Identity)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
 		setParts := make([]string, 0, len(update.Changes))
-		args := make([]any, 0, len(update.Changes)+len(whereArgs))
+		args := make([]model.Value, 0, len(update.Changes)+len(whereArgs))
 		for _, change := range update.Changes {
 			if strings.TrimSpace(change.Column) == "" {
-				return 0, model.ErrMissingRecordChanges
+				return nil, model.ErrMissingRecordChanges
 			}
 			setParts = append(setParts, fmt.Sprintf("%s = ?", quoteIdentifier(change.Column)))
-			args = append(args, bindValue(change.Value))
+			args = append(args, change.Value)
 		}
 		args = append(args, whereArgs...)
-		query := fmt.Sprintf("UPDATE %s SET %s %s", quoteIdentifier(tableName), strings.Join(setParts, ", "), whereClause)
-		affected, err := execAffectedRows(ctx, tx, query, args...)
-		if err != nil {
-			return 0, err
-		}
-		total += affected
+		statements = append(statements, model.WriteStatement{
+			Table: tableName,
+			SQL:   fmt.Sprintf("UPDATE %s SET %s %s", quoteIdentifier(tableName), strings.Join(setParts, ", "), whereClause),
+			Args:  args,
+		})
 	}
-	return total, nil
+	return statements, nil
 }
 
-func applyRecordDeletes(ctx context.Context, tx txExecutor, tableName string, deletes []model.RecordDelete) (int, error) {
-	total := 0
+func buildDeleteStatements(tableName string, deletes []model.RecordDelete) ([]model.WriteStatement, error) {
+	statements := make([]model.WriteStatement, 0, len(deletes))
 	for _, deleteChange := range deletes {
 		whereClause, whereArgs, err := buildRecordIdentityClause(deleteChange.Identity)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		query := fmt.Sprintf("DELETE FROM %s %s", quoteIdentifier(tableName), whereClause)
-		affected, err := execAffectedRows(ctx, tx, query, whereArgs...)
-		if err != nil {
-			return 0, err
-		}
-		total += affected
+		statements = append(statements, model.WriteStatement{
+			Table: tableName,
+			SQL:   fmt.Sprintf("DELETE FROM %s %s", quoteIdentifier(tableName), whereClause),
+			Args:  whereArgs,
+		})
 	}
-	return total, nil
+	return statements, nil
 }
 
 func recordIdentitySignature(identity model.RecordIdentity) (string, error) {
@@ -169,15 +244,15 @@ func recordIdentitySignature(identity model.RecordIdentity) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return fmt.Sprintf("%s|%v", clause, args), nil
+	return fmt.Sprintf("%s|%v", clause, bindValues(args)), nil
 }
 
-func buildRecordIdentityClause(identity model.RecordIdentity) (string, []any, error) {
+func buildRecordIdentityClause(identity model.RecordIdentity) (string, []model.Value, error) {
 	if len(identity.Keys) == 0 {
 		return "", nil, model.ErrMissingRecordIdentity
 	}
 	parts := make([]string, 0, len(identity.Keys))
-	args := make([]any, 0, len(identity.Keys))
+	args := make([]model.Value, 0, len(identity.Keys))
 	for _, key := range identity.Keys {
 		if strings.TrimSpace(key.Column) == "" {
 			return "", nil, model.ErrMissingRecordIdentity
@@ -187,7 +262,7 @@ func buildRecordIdentityClause(identity model.RecordIdentity) (string, []any, er
 			continue
 		}
 		parts = append(parts, fmt.Sprintf("%s = ?", quoteIdentifier(key.Column)))
-		args = append(args, bindValue(key.Value))
+		args = append(args, key.Value)
 	}
 	return "WHERE " + strings.Join(parts, " AND "), args, nil
 }
@@ -202,6 +277,14 @@ func bindValue(value model.Value) any {
 	return value.Text
 }
 
+func bindValues(values []model.Value) []any {
+	bound := make([]any, len(values))
+	for i, value := range values {
+		bound[i] = bindValue(value)
+	}
+	return bound
+}
+
 func execAffectedRows(ctx context.Context, tx txExecutor, query string, args ...any) (int, error) {
 	result, err := tx.ExecContext(ctx, query, args...)
 	if err != nil {
@@ -0,0 +1,153 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+type txQuerier interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// detectRecordConflicts compares the records targeted by updates and deletes
+// with the values they held when loaded. Updates conflict when a changed
+// column no longer holds its original value; updates and deletes conflict
+// when their record is gone. Values are compared in their text form, the same
+// form the records were displayed in.
+func detectRecordConflicts(ctx context.Context, tx txQuerier, tableName string, changes model.TableChanges) ([]model.RecordConflict, error) {
+	var conflicts []model.RecordConflict
+	for _, update := range changes.Updates {
+		if len(update.Originals) == 0 {
+			continue
+		}
+		conflict, ok, err := detectUpdateConflict(ctx, tx, tableName, update)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			conflicts = append(conflicts, conflict)
+		}
+	}
+	for _, deleteChange := range changes.Deletes {
+		exists, err := recordExists(ctx, tx, tableName, deleteChange.Identity)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			conflicts = append(conflicts, model.RecordConflict{Table: tableName, Identity: deleteChange.Identity, Missing: true})
+		}
+	}
+	return conflicts, nil
+}
+
+func detectUpdateConflict(ctx context.Context, tx txQuerier, tableName string, update model.RecordUpdate) (model.RecordConflict, bool, error) {
+	whereClause, whereArgs, err := buildRecordIdentityClause(update.Identity)
+	if err != nil {
+		return model.RecordConflict{}, false, err
+	}
+	selectParts := make([]string, 0, len(update.Originals))
+	for _, original := range update.Originals {
+		if strings.TrimSpace(original.Column) == "" {
+			return model.RecordConflict{}, false, model.ErrMissingRecordChanges
+		}
+		selectParts = append(selectParts, fmt.Sprintf("CAST(%s AS TEXT)", quoteIdentifier(original.Column)))
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s %s", strings.Join(selectParts, ", "), quoteIdentifier(tableName), whereClause)
+	current := make([]sql.NullString, len(update.Originals))
+	dest := make([]any, len(current))
+	for i := range current {
+		dest[i] = &current[i]
+	}
+	err = tx.QueryRowContext(ctx, query, bindValues(whereArgs)...).Scan(dest...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.RecordConflict{Table: tableName, Identity: update.Identity, Missing: true}, true, nil
+	}
+	if err != nil {
+		return model.RecordConflict{}, false, err
+	}
+
+	staged := make(map[string]model.Value, len(update.Changes))
+	for _, change := range update.Changes {
+		staged[change.Column] = change.Value
+	}
+	conflict := model.RecordConflict{Table: tableName, Identity: update.Identity}
+	for i, original := range update.Originals {
+		currentValue := model.Value{IsNull: !current[i].Valid, Text: current[i].String}
+		if valueTextEqual(original.Value, currentValue) {
+			continue
+		}
+		conflict.Columns = append(conflict.Columns, model.ColumnConflict{
+			Column:   original.Column,
+			Original: original.Value,
+			Current:  currentValue,
+			Staged:   staged[original.Column],
+		})
+	}
+	return conflict, len(conflict.Columns) > 0, nil
+}
+
+func recordExists(ctx context.Context, tx txQuerier, tableName string, identity model.RecordIdentity) (bool, error) {
+	whereClause, whereArgs, err := buildRecordIdentityClause(identity)
+	if err != nil {
+		return false, err
+	}
+	query := fmt.Sprintf("SELECT 1 FROM %s %s", quoteIdentifier(tableName), whereClause)
+	var found int
+	err = tx.QueryRowContext(ctx, query, bindValues(whereArgs)...).Scan(&found)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ensureIdentitiesMatchOneRecord refuses changes whose identity matches more
+// than one record, which happens when a view holds identical rows: each write
+// would rewrite all of them. It runs for forced saves too.
+func ensureIdentitiesMatchOneRecord(ctx context.Context, tx txQuerier, tableName string, changes model.TableChanges) error {
+	identities := make([]model.RecordIdentity, 0, len(changes.Updates)+len(changes.Deletes))
+	for _, update := range changes.Updates {
+		identities = append(identities, update.Identity)
+	}
+	for _, deleteChange := range changes.Deletes {
+		identities = append(identities, deleteChange.Identity)
+	}
+	for _, identity := range identities {
+		count, err := countIdentityMatches(ctx, tx, tableName, identity)
+		if err != nil {
+			return err
+		}
+		if count > 1 {
+			return fmt.Errorf("%w: table %q", model.ErrAmbiguousRecord, tableName)
+		}
+	}
+	return nil
+}
+
+// countIdentityMatches counts the records identity matches, stopping at two.
+func countIdentityMatches(ctx context.Context, tx txQuerier, tableName string, identity model.RecordIdentity) (int, error) {
+	whereClause, whereArgs, err := buildRecordIdentityClause(identity)
+	if err != nil {
+		return 0, err
+	}
+	query := fmt.Sprintf("SELECT COUNT(*) FROM (SELECT 1 FROM %s %s LIMIT 2)", quoteIdentifier(tableName), whereClause)
+	var count int
+	if err := tx.QueryRowContext(ctx, query, bindValues(whereArgs)...).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func valueTextEqual(left, right model.Value) bool {
+	if left.IsNull || right.IsNull {
+		return left.IsNull == right.IsNull
+	}
+	return left.Text == right.Text
+}
@@ -3,7 +3,9 @@ package engine
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 
 	_ "modernc.org/sqlite"
@@ -297,7 +299,7 @@ func TestSQLiteEngine_ApplyRecordChanges_SkipsUpdatesForRowsMarkedDelete(t *test
 	}
 }
 
-func TestSQLiteEngine_ApplyRecordChanges_ReturnsZeroForStaleDelete(t *testing.T) {
+func TestSQLiteEngine_ApplyRecordChanges_ReturnsZeroForForcedStaleDelete(t *testing.T) {
 	// Arrange
 	db := setupSQLiteUpdateDB(t, `
 		CREATE TABLE users (
@@ -314,6 +316,7 @@ func TestSQLiteEngine_ApplyRecordChanges_ReturnsZeroForStaleDelete(t *testing.T)
 				},
 			},
 		},
+		Force: true,
 	}
 
 	// Act
@@ -367,6 +370,119 @@ func TestSQLiteEngine_ApplyRecordChanges_ReturnsOnlyAppliedRowsWhenMixedWithStal
 	}
 }
 
+func TestSQLiteEngine_ApplyRecordChanges_ReportsConflictsAndRollsBack(t *testing.T) {
+	// Arrange
+	db := setupSQLiteUpdateDB(t, `
+		CREATE TABLE users (
+			id INTEGER PRIMARY KEY,
+			name TEXT NOT NULL,
+			email TEXT
+		);
+		INSERT INTO users (id, name, email) VALUES (1, 'alice', NULL), (2, 'bob', 'bob@example.com');
+	`)
+	engine := NewSQLiteEngine(db)
+	userID := func(id int64) model.RecordIdentity {
+		return model.RecordIdentity{
+			Keys: []model.RecordIdentityKey{{Column: "id", Value: model.Value{Text: fmt.Sprint(id), Raw: id}}},
+		}
+	}
+	changes := model.TableChanges{
+		Inserts: []model.RecordInsert{
+			{Values: []model.ColumnValue{{Column: "name", Value: model.Value{Text: "carol", Raw: "carol"}}}},
+		},
+		Updates: []model.RecordUpdate{
+			{
+				Identity:  userID(1),
+				Changes:   []model.ColumnValue{{Column: "name", Value: model.Value{Text: "alicia", Raw: "alicia"}}, {Column: "email", Value: model.Value{Text: "a@example.com", Raw: "a@example.com"}}},
+				Originals: []model.ColumnValue{{Column: "name", Value: model.Value{Text: "alison"}}, {Column: "email", Value: model.Value{IsNull: true}}},
+			},
+			{
+				Identity:  userID(2),
+				Changes:   []model.ColumnValue{{Column: "name", Value: model.Value{Text: "robert", Raw: "robert"}}},
+				Originals: []model.ColumnValue{{Column: "name", Value: model.Value{Text: "bob"}}},
+			},
+		},
+		Deletes: []model.RecordDelete{{Identity: userID(3)}},
+	}
+
+	// Act
+	count, err := engine.ApplyRecordChanges(context.Background(), "users", changes)
+
+	// Assert
+	if count != 0 {
+		t.Fatalf("expected no affected rows, got %d", count)
+	}
+	var conflictErr *model.RecordConflictError
+	if !errors.As(err, &conflictErr) || !errors.Is(err, model.ErrRecordConflict) {
+		t.Fatalf("expected record conflict error, got %v", err)
+	}
+	expected := []model.RecordConflict{
+		{
+			Table:    "users",
+			Identity: userID(1),
+			Columns: []model.ColumnConflict{{
+				Column:   "name",
+				Original: model.Value{Text: "alison"},
+				Current:  model.Value{Text: "alice"},
+				Staged:   model.Value{Text: "alicia", Raw: "alicia"},
+			}},
+		},
+		{Table: "users", Identity: userID(3), Missing: true},
+	}
+	if !reflect.DeepEqual(conflictErr.Conflicts, expected) {
+		t.Fatalf("expected conflicts %+v, got %+v", expected, conflictErr.Conflicts)
+	}
+	var rows string
+	if err := db.QueryRow(`SELECT group_concat(id || ':' || name, ',') FROM users ORDER BY id`).Scan(&rows); err != nil {
+		t.Fatalf("expected no error reading rows, got %v", err)
+	}
+	if rows != "1:alice,2:bob" {
+		t.Fatalf("expected the save to be rolled back, got %q", rows)
+	}
+}
+
+func TestSQLiteEngine_ApplyRecordChanges_ForceOverwritesConcurrentChanges(t *testing.T) {
+	// Arrange
+	db := setupSQLiteUpdateDB(t, `
+		CREATE TABLE users (
+			id INTEGER PRIMARY KEY,
+			name TEXT NOT NULL
+		);
+		INSERT INTO users (id, name) VALUES (1, 'alice');
+	`)
+	engine := NewSQLiteEngine(db)
+	changes := model.TableChanges{
+		Updates: []model.RecordUpdate{
+			{
+				Identity: model.RecordIdentity{
+					Keys: []model.RecordIdentityKey{{Column: "id", Value: model.Value{Text: "1", Raw: int64(1)}}},
+				},
+				Changes:   []model.ColumnValue{{Column: "name", Value: model.Value{Text: "alicia", Raw: "alicia"}}},
+				Originals: []model.ColumnValue{{Column: "name", Value: model.Value{Text: "alison"}}},
+			},
+		},
+		Force: true,
+	}
+
+	// Act
+	count, err := engine.ApplyRecordChanges(context.Background(), "users", changes)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if count != 1 {
+		t.Fatalf("expected affected row count 1, got %d", count)
+	}
+	var name string
+	if err := db.QueryRow(`SELECT name FROM users WHERE id = 1`).Scan(&name); err != nil {
+		t.Fatalf("expected no error reading row, got %v", err)
+	}
+	if name != "alicia" {
+		t.Fatalf("expected forced update to apply, got %q", name)
+	}
+}
+
 func setupSQLiteUpdateDB(t *testing.T, schema string) *sql.DB {
 	t.Helper()
 	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())
@@ -384,3 +500,280 @@ func setupSQLiteUpdateDB(t *testing.T, schema string) *sql.DB {
 	}
 	return db
 }
+
+func TestSQLiteEngine_ApplyRecordChanges_UsesRowIDIdentityForTablesWithoutPrimaryKey(t *testing.T) {
+	// Arrange
+	db := setupSQLiteUpdateDB(t, `
+		CREATE TABLE imports (name TEXT, note TEXT);
+		INSERT INTO imports (name, note) VALUES ('alice', 'a'), ('alice', 'a'), ('bob', 'b');
+	`)
+	engine := NewSQLiteEngine(db)
+	page, err := engine.ListRecords(context.Background(), "imports", 0, 10, nil, nil)
+	if err != nil {
+		t.Fatalf("expected no error listing records, got %v", err)
+	}
+	if len(page.Records) != 3 {
+		t.Fatalf("expected three records, got %d", len(page.Records))
+	}
+	first := page.Records[0]
+	if first.RowKey != "rowid=1" || len(first.Identity.Keys) != 1 || first.Identity.Keys[0].Column != "rowid" {
+		t.Fatalf("expected rowid identity for first record, got key %q identity %+v", first.RowKey, first.Identity)
+	}
+	changes := model.TableChanges{
+		Updates: []model.RecordUpdate{
+			{
+				Identity: first.Identity,
+				Changes:  []model.ColumnValue{{Column: "note", Value: model.Value{Text: "edited", Raw: "edited"}}},
+			},
+		},
+		Deletes: []model.RecordDelete{{Identity: page.Records[1].Identity}},
+	}
+
+	// Act
+	count, err := engine.ApplyRecordChanges(context.Background(), "imports", changes)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if count != 2 {
+		t.Fatalf("expected two affected rows, got %d", count)
+	}
+	var remaining string
+	if err := db.QueryRow(`SELECT group_concat(rowid || ':' || name || ':' || note, ',') FROM imports ORDER BY rowid`).Scan(&remaining); err != nil {
+		t.Fatalf("expected no error reading rows, got %v", err)
+	}
+	if remaining != "1:alice:edited,3:bob:b" {
+		t.Fatalf("expected only the duplicate row edited and deleted, got %q", remaining)
+	}
+}
+
+func TestSQLiteEngine_ApplyRecordChanges_EditsAndDeletesViewRowsThroughInsteadOfTriggers(t *testing.T) {
+	// Arrange
+	db := setupSQLiteUpdateDB(t, `
+		CREATE TABLE orders (id INTEGER PRIMARY KEY, region TEXT NOT NULL, amount INTEGER NOT NULL, note TEXT);
+		INSERT INTO orders (id, region, amount, note)
+		VALUES (1, 'north', 10, NULL), (2, 'south', 30, 'keep'), (3, 'east', 5, NULL);
+		CREATE VIEW order_notes AS SELECT region, amount, note FROM orders;
+		CREATE TRIGGER order_notes_update INSTEAD OF UPDATE ON order_notes
+		BEGIN
+			UPDATE orders SET note = NEW.note WHERE region = OLD.region AND amount = OLD.amount;
+		END;
+		CREATE TRIGGER order_notes_delete INSTEAD OF DELETE ON order_notes
+		BEGIN
+			DELETE FROM orders WHERE region = OLD.region AND amount = OLD.amount;
+		END;
+	`)
+	engine := NewSQLiteEngine(db)
+	page, err := engine.ListRecords(context.Background(), "order_notes", 0, 10, nil, nil)
+	if err != nil {
+		t.Fatalf("expected no error listing view records, got %v", err)
+	}
+	if len(page.Records) != 3 {
+		t.Fatalf("expected three view records, got %d", len(page.Records))
+	}
+	east, north := page.Records[0], page.Records[1]
+	if north.RowKey != "region=north|amount=10|note=NULL" {
+		t.Fatalf("expected all-column identity for view row, got %q", north.RowKey)
+	}
+	changes := model.TableChanges{
+		Updates: []model.RecordUpdate{
+			{
+				Identity:  north.Identity,
+				Changes:   []model.ColumnValue{{Column: "note", Value: model.Value{Text: "edited", Raw: "edited"}}},
+				Originals: []model.ColumnValue{{Column: "note", Value: model.Value{IsNull: true}}},
+			},
+		},
+		Deletes: []model.RecordDelete{{Identity: east.Identity}},
+	}
+
+	// Act
+	_, err = engine.ApplyRecordChanges(context.Background(), "order_notes", changes)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	var remaining string
+	if err := db.QueryRow(`SELECT group_concat(id || ':' || coalesce(note, '-'), ',') FROM (SELECT id, note FROM orders ORDER BY id)`).Scan(&remaining); err != nil {
+		t.Fatalf("expected no error reading base rows, got %v", err)
+	}
+	if remaining != "1:edited,2:keep" {
+		t.Fatalf("expected view edit and delete to reach the base table, got %q", remaining)
+	}
+}
+
+func TestSQLiteEngine_ApplyRecordChanges_RefusesIdenticalViewRows(t *testing.T) {
+	// Arrange
+	db := setupSQLiteUpdateDB(t, `
+		CREATE TABLE orders (id INTEGER PRIMARY KEY, region TEXT NOT NULL, amount INTEGER NOT NULL, note TEXT);
+		INSERT INTO orders (id, region, amount, note)
+		VALUES (1, 'north', 10, NULL), (2, 'north', 10, NULL), (3, 'south', 30, 'keep');
+		CREATE VIEW order_notes AS SELECT region, amount, note FROM orders;
+		CREATE TRIGGER order_notes_update INSTEAD OF UPDATE ON order_notes
+		BEGIN
+			UPDATE orders SET note = NEW.note WHERE region = OLD.region AND amount = OLD.amount;
+		END;
+		CREATE TRIGGER order_notes_delete INSTEAD OF DELETE ON order_notes
+		BEGIN
+			DELETE FROM orders WHERE region = OLD.region AND amount = OLD.amount;
+		END;
+	`)
+	engine := NewSQLiteEngine(db)
+	page, err := engine.ListRecords(context.Background(), "order_notes", 0, 10, nil, nil)
+	if err != nil {
+		t.Fatalf("expected no error listing view records, got %v", err)
+	}
+	if len(page.Records) != 3 {
+		t.Fatalf("expected three view records, got %d", len(page.Records))
+	}
+	for i, record := range page.Records[:2] {
+		if !record.IdentityUnavailable {
+			t.Fatalf("expected identical view row %d to have no identity, got %q", i, record.RowKey)
+		}
+	}
+	if page.Records[2].IdentityUnavailable {
+		t.Fatalf("expected distinct view row to keep its identity")
+	}
+	duplicate := model.RecordIdentity{Keys: []model.RecordIdentityKey{
+		{Column: "region", Value: model.Value{Text: "north", Raw: "north"}},
+		{Column: "amount", Value: model.Value{Text: "10", Raw: int64(10)}},
+		{Column: "note", Value: model.Value{IsNull: true}},
+	}}
+	changes := model.TableChanges{
+		Updates: []model.RecordUpdate{{
+			Identity: duplicate,
+			Changes:  []model.ColumnValue{{Column: "note", Value: model.Value{Text: "edited", Raw: "edited"}}},
+		}},
+		Force: true,
+	}
+
+	// Act
+	_, err = engine.ApplyRecordChanges(context.Background(), "order_notes", changes)
+
+	// Assert
+	if !errors.Is(err, model.ErrAmbiguousRecord) {
+		t.Fatalf("expected ambiguous record error, got %v", err)
+	}
+	var notes string
+	if err := db.QueryRow(`SELECT group_concat(id || ':' || coalesce(note, '-'), ',') FROM (SELECT id, note FROM orders ORDER BY id)`).Scan(&notes); err != nil {
+		t.Fatalf("expected no error reading base rows, got %v", err)
+	}
+	if notes != "1:-,2:-,3:keep" {
+		t.Fatalf("expected base rows untouched, got %q", notes)
+	}
+}
+
+func TestSQLiteEngine_ListRecords_RowIDIdentityFallback(t *testing.T) {
+	tests := []struct {
+		name         string
+		ddl          string
+		table        string
+		wantIdentity string
+	}{
+		{
+			name:         "shadowed rowid alias",
+			ddl:          `CREATE TABLE logs (rowid TEXT, message TEXT); INSERT INTO logs VALUES ('x', 'hello');`,
+			table:        "logs",
+			wantIdentity: "_rowid_=1",
+		},
+		{
+			name:         "view identified by all columns",
+			ddl:          `CREATE TABLE logs (message TEXT, level INTEGER); INSERT INTO logs VALUES ('hello', NULL); CREATE VIEW recent_logs AS SELECT message, level FROM logs;`,
+			table:        "recent_logs",
+			wantIdentity: "message=hello|level=NULL",
+		},
+		{
+			name:         "without rowid table keeps primary key",
+			ddl:          `CREATE TABLE codes (code TEXT PRIMARY KEY, label TEXT) WITHOUT ROWID; INSERT INTO codes VALUES ('a', 'alpha');`,
+			table:        "codes",
+			wantIdentity: "code=a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			db := setupSQLiteUpdateDB(t, tt.ddl)
+			engine := NewSQLiteEngine(db)
+
+			// Act
+			page, err := engine.ListRecords(context.Background(), tt.table, 0, 10, nil, nil)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if len(page.Records) != 1 {
+				t.Fatalf("expected one record, got %d", len(page.Records))
+			}
+			if page.Records[0].RowKey != tt.wantIdentity {
+				t.Fatalf("expected row key %q, got %q", tt.wantIdentity, page.Records[0].RowKey)
+			}
+		})
+	}
+}
+
+func TestSQLiteEngine_PreviewDatabaseChanges_ReturnsStatementsWithoutWriting(t *testing.T) {
+	// Arrange
+	db := setupSQLiteUpdateDB(t, `
+		CREATE TABLE users (
+			id INTEGER PRIMARY KEY,
+			name TEXT
+		);
+		INSERT INTO users (id, name) VALUES (1, 'alice'), (2, 'bob');
+	`)
+	engine := NewSQLiteEngine(db)
+	changeSets := []model.TableChangeSet{{
+		Table: "users",
+		Changes: model.TableChanges{
+			Inserts: []model.RecordInsert{{Values: []model.ColumnValue{
+				{Column: "name", Value: model.Value{Text: "carol", Raw: "carol"}},
+			}}},
+			Updates: []model.RecordUpdate{{
+				Identity: model.RecordIdentity{
+					Keys: []model.RecordIdentityKey{{Column: "id", Value: model.Value{Text: "1", Raw: int64(1)}}},
+				},
+				Changes: []model.ColumnValue{{Column: "name", Value: model.Value{IsNull: true}}},
+			}},
+			Deletes: []model.RecordDelete{{Identity: model.RecordIdentity{
+				Keys: []model.RecordIdentityKey{{Column: "id", Value: model.Value{Text: "2", Raw: int64(2)}}},
+			}}},
+		},
+	}}
+
+	// Act
+	statements, err := engine.PreviewDatabaseChanges(context.Background(), changeSets)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	expected := []model.WriteStatement{
+		{
+			Table: "users",
+			SQL:   `INSERT INTO "users" ("name") VALUES (?)`,
+			Args:  []model.Value{{Text: "carol", Raw: "carol"}},
+		},
+		{
+			Table: "users",
+			SQL:   `UPDATE "users" SET "name" = ? WHERE "id" = ?`,
+			Args:  []model.Value{{IsNull: true}, {Text: "1", Raw: int64(1)}},
+		},
+		{
+			Table: "users",
+			SQL:   `DELETE FROM "users" WHERE "id" = ?`,
+			Args:  []model.Value{{Text: "2", Raw: int64(2)}},
+		},
+	}
+	if !reflect.DeepEqual(statements, expected) {
+		t.Fatalf("expected statements %+v, got %+v", expected, statements)
+	}
+	var count int
+	if err := db.QueryRow("SELECT COUNT(*) FROM users WHERE name IS NOT NULL").Scan(&count); err != nil {
+		t.Fatalf("failed to count rows: %v", err)
+	}
+	if count != 2 {
+		t.Fatalf("expected preview to leave both rows untouched, got %d named rows", count)
+	}
+}
@@ -0,0 +1,71 @@
+package engine
+
+import (
+	"context"
+	"strings"
+
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+// orderChangeSetsByForeignKeys orders change sets so tables come after the
+// tables their foreign keys reference. Tables in a reference cycle, and
+// self-references, keep their given order.
+func (e *SQLiteEngine) orderChangeSetsByForeignKeys(ctx context.Context, changeSets []model.TableChangeSet) ([]model.TableChangeSet, error) {
+	if len(changeSets) < 2 {
+		return changeSets, nil
+	}
+	indexByTable := make(map[string]int, len(changeSets))
+	for i, changeSet := range changeSets {
+		indexByTable[strings.ToLower(changeSet.Table)] = i
+	}
+
+	// references[i] lists the change sets table i references.
+	references := make([][]int, len(changeSets))
+	for i, changeSet := range changeSets {
+		constraints, err := e.foreignKeyConstraints(ctx, changeSet.Table)
+		if err != nil {
+			return nil, err
+		}
+		for _, constraint := range constraints {
+			referenced, ok := indexByTable[strings.ToLower(constraint.RefTable)]
+			if !ok || referenced == i {
+				continue
+			}
+			references[i] = append(references[i], referenced)
+		}
+	}
+
+	ordered := make([]model.TableChangeSet, 0, len(changeSets))
+	placed := make([]bool, len(changeSets))
+	for len(ordered) < len(changeSets) {
+		progressed := false
+		for i, changeSet := range changeSets {
+			if placed[i] || !allPlaced(references[i], placed) {
+				continue
+			}
+			ordered = append(ordered, changeSet)
+			placed[i] = true
+			progressed = true
+		}
+		if progressed {
+			continue
+		}
+		for i, changeSet := range changeSets {
+			if !placed[i] {
+				ordered = append(ordered, changeSet)
+				placed[i] = true
+				break
+			}
+		}
+	}
+	return ordered, nil
+}
+
+func allPlaced(indexes []int, placed []bool) bool {
+	for _, index := range indexes {
+		if !placed[index] {
+			return false
+		}
+	}
+	return true
+}
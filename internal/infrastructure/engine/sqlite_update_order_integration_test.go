@@ -0,0 +1,156 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+func setupSQLiteForeignKeyUpdateDB(t *testing.T) *SQLiteEngine {
+	t.Helper()
+	db := setupSQLiteUpdateDB(t, `
+		CREATE TABLE customers (
+			id INTEGER PRIMARY KEY,
+			name TEXT NOT NULL
+		);
+		CREATE TABLE orders (
+			id INTEGER PRIMARY KEY,
+			customer_id INTEGER NOT NULL REFERENCES customers(id),
+			total TEXT
+		);
+		INSERT INTO customers (id, name) VALUES (1, 'alice');
+		INSERT INTO orders (id, customer_id, total) VALUES (10, 1, '9.99');
+	`)
+	db.SetMaxOpenConns(1)
+	if _, err := db.Exec("PRAGMA foreign_keys = ON"); err != nil {
+		t.Fatalf("failed to enable foreign keys: %v", err)
+	}
+	return NewSQLiteEngine(db)
+}
+
+func TestSQLiteEngine_ApplyDatabaseChanges_InsertsParentsBeforeChildren(t *testing.T) {
+	// Arrange
+	engine := setupSQLiteForeignKeyUpdateDB(t)
+	changeSets := []model.TableChangeSet{
+		{
+			Table: "orders",
+			Changes: model.TableChanges{Inserts: []model.RecordInsert{{Values: []model.ColumnValue{
+				{Column: "id", Value: model.Value{Text: "11", Raw: int64(11)}},
+				{Column: "customer_id", Value: model.Value{Text: "2", Raw: int64(2)}},
+			}}}},
+		},
+		{
+			Table: "customers",
+			Changes: model.TableChanges{Inserts: []model.RecordInsert{{Values: []model.ColumnValue{
+				{Column: "id", Value: model.Value{Text: "2", Raw: int64(2)}},
+				{Column: "name", Value: model.Value{Text: "bob", Raw: "bob"}},
+			}}}},
+		},
+	}
+
+	// Act
+	count, err := engine.ApplyDatabaseChanges(context.Background(), changeSets)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if count != 2 {
+		t.Fatalf("expected 2 affected rows, got %d", count)
+	}
+	var customerID int
+	if err := engine.db.QueryRow("SELECT customer_id FROM orders WHERE id = 11").Scan(&customerID); err != nil {
+		t.Fatalf("failed to read inserted order: %v", err)
+	}
+	if customerID != 2 {
+		t.Fatalf("expected order to reference customer 2, got %d", customerID)
+	}
+}
+
+func TestSQLiteEngine_ApplyDatabaseChanges_DeletesChildrenBeforeParents(t *testing.T) {
+	// Arrange
+	engine := setupSQLiteForeignKeyUpdateDB(t)
+	changeSets := []model.TableChangeSet{
+		{
+			Table: "customers",
+			Changes: model.TableChanges{Deletes: []model.RecordDelete{{Identity: model.RecordIdentity{
+				Keys: []model.RecordIdentityKey{{Column: "id", Value: model.Value{Text: "1", Raw: int64(1)}}},
+			}}}},
+		},
+		{
+			Table: "orders",
+			Changes: model.TableChanges{Deletes: []model.RecordDelete{{Identity: model.RecordIdentity{
+				Keys: []model.RecordIdentityKey{{Column: "id", Value: model.Value{Text: "10", Raw: int64(10)}}},
+			}}}},
+		},
+	}
+
+	// Act
+	count, err := engine.ApplyDatabaseChanges(context.Background(), changeSets)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if count != 2 {
+		t.Fatalf("expected 2 affected rows, got %d", count)
+	}
+}
+
+func TestSQLiteEngine_ApplyDatabaseChanges_RollsBackEveryTableOnError(t *testing.T) {
+	// Arrange
+	engine := setupSQLiteForeignKeyUpdateDB(t)
+	changeSets := []model.TableChangeSet{
+		{
+			Table: "customers",
+			Changes: model.TableChanges{Updates: []model.RecordUpdate{{
+				Identity: model.RecordIdentity{
+					Keys: []model.RecordIdentityKey{{Column: "id", Value: model.Value{Text: "1", Raw: int64(1)}}},
+				},
+				Changes: []model.ColumnValue{{Column: "name", Value: model.Value{Text: "alicia", Raw: "alicia"}}},
+			}}},
+		},
+		{
+			Table: "orders",
+			Changes: model.TableChanges{Inserts: []model.RecordInsert{{Values: []model.ColumnValue{
+				{Column: "id", Value: model.Value{Text: "12", Raw: int64(12)}},
+				{Column: "customer_id", Value: model.Value{Text: "99", Raw: int64(99)}},
+			}}}},
+		},
+	}
+
+	// Act
+	_, err := engine.ApplyDatabaseChanges(context.Background(), changeSets)
+
+	// Assert
+	if err == nil {
+		t.Fatal("expected foreign key error, got nil")
+	}
+	var name string
+	if err := engine.db.QueryRow("SELECT name FROM customers WHERE id = 1").Scan(&name); err != nil {
+		t.Fatalf("failed to read customer: %v", err)
+	}
+	if name != "alice" {
+		t.Fatalf("expected customers update to roll back, got %q", name)
+	}
+}
+
+func TestSQLiteEngine_ApplyDatabaseChanges_RequiresTableNames(t *testing.T) {
+	// Arrange
+	engine := setupSQLiteForeignKeyUpdateDB(t)
+	changeSets := []model.TableChangeSet{{
+		Changes: model.TableChanges{Deletes: []model.RecordDelete{{Identity: model.RecordIdentity{
+			Keys: []model.RecordIdentityKey{{Column: "id", Value: model.Value{Text: "1", Raw: int64(1)}}},
+		}}}},
+	}}
+
+	// Act
+	_, err := engine.ApplyDatabaseChanges(context.Background(), changeSets)
+
+	// Assert
+	if !errors.Is(err, model.ErrMissingTableName) {
+		t.Fatalf("expected missing table name error, got %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mgierok/dbc/internal/domain/model"
+	"github.com/mgierok/dbc/internal/domain/service"
+)
+
+var ErrStatementArgumentMismatch = errors.New("statement placeholders do not match its arguments")
+
+// ScriptDatabaseChanges renders the statements ApplyDatabaseChanges would
+// execute for changeSets as standalone SQL, in execution order, with every
+// bound value written as a literal.
+func (e *SQLiteEngine) ScriptDatabaseChanges(ctx context.Context, changeSets []model.TableChangeSet) ([]string, error) {
+	statements, err := e.PreviewDatabaseChanges(ctx, changeSets)
+	if err != nil {
+		return nil, err
+	}
+	script := make([]string, len(statements))
+	for i, statement := range statements {
+		inlined, err := inlineStatementArgs(statement)
+		if err != nil {
+			return nil, err
+		}
+		script[i] = inlined
+	}
+	return script, nil
+}
+
+// inlineStatementArgs replaces each placeholder of statement with its bound
+// value. Placeholders are found through the tokenizer, so a question mark
+// inside a quoted identifier stays untouched.
+func inlineStatementArgs(statement model.WriteStatement) (string, error) {
+	var builder strings.Builder
+	last := 0
+	next := 0
+	for _, token := range tokenizeSQL(statement.SQL) {
+		if token.kind != sqlTokenPunct || token.text != "?" {
+			continue
+		}
+		if next >= len(statement.Args) {
+			return "", fmt.Errorf("%w: %s", ErrStatementArgumentMismatch, statement.Table)
+		}
+		literal, err := service.SQLLiteral(statement.Args[next])
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", statement.Table, err)
+		}
+		builder.WriteString(statement.SQL[last:token.start])
+		builder.WriteString(literal)
+		last = token.end
+		next++
+	}
+	if next != len(statement.Args) {
+		return "", fmt.Errorf("%w: %s", ErrStatementArgumentMismatch, statement.Table)
+	}
+	builder.WriteString(statement.SQL[last:])
+	return builder.String(), nil
+}
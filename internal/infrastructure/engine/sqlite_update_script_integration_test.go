@@ -0,0 +1,86 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+func TestSQLiteEngine_ScriptDatabaseChanges_ReplaysOnAnotherDatabase(t *testing.T) {
+	// Arrange
+	schema := `
+		CREATE TABLE users (
+			id INTEGER PRIMARY KEY,
+			name TEXT,
+			avatar BLOB
+		);
+		INSERT INTO users (id, name) VALUES (1, 'alice'), (2, 'bob');
+	`
+	source := NewSQLiteEngine(setupSQLiteUpdateDB(t, schema))
+	target, err := sql.Open("sqlite", fmt.Sprintf("file:%s_target?mode=memory&cache=shared", t.Name()))
+	if err != nil {
+		t.Fatalf("failed to open target db: %v", err)
+	}
+	t.Cleanup(func() { _ = target.Close() })
+	if _, err := target.Exec(schema); err != nil {
+		t.Fatalf("failed to setup target schema: %v", err)
+	}
+	changeSets := []model.TableChangeSet{{
+		Table: "users",
+		Changes: model.TableChanges{
+			Inserts: []model.RecordInsert{{Values: []model.ColumnValue{
+				{Column: "id", Value: model.Value{Text: "3", Raw: int64(3)}},
+				{Column: "name", Value: model.Value{Text: "O'Brien", Raw: "O'Brien"}},
+				{Column: "avatar", Value: model.Value{Text: "0x00ff", Raw: []byte{0x00, 0xff}}},
+			}}},
+			Updates: []model.RecordUpdate{{
+				Identity: model.RecordIdentity{
+					Keys: []model.RecordIdentityKey{{Column: "id", Value: model.Value{Text: "1", Raw: int64(1)}}},
+				},
+				Changes: []model.ColumnValue{{Column: "name", Value: model.Value{IsNull: true}}},
+			}},
+			Deletes: []model.RecordDelete{{Identity: model.RecordIdentity{
+				Keys: []model.RecordIdentityKey{{Column: "id", Value: model.Value{Text: "2", Raw: int64(2)}}},
+			}}},
+		},
+	}}
+
+	// Act
+	statements, err := source.ScriptDatabaseChanges(context.Background(), changeSets)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	expected := []string{
+		`INSERT INTO "users" ("id", "name", "avatar") VALUES (3, 'O''Brien', X'00FF')`,
+		`UPDATE "users" SET "name" = NULL WHERE "id" = 1`,
+		`DELETE FROM "users" WHERE "id" = 2`,
+	}
+	if strings.Join(statements, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("expected statements %q, got %q", expected, statements)
+	}
+	if _, err := target.Exec(strings.Join(statements, ";\n")); err != nil {
+		t.Fatalf("expected script to run on target, got %v", err)
+	}
+	var name string
+	var avatar []byte
+	if err := target.QueryRow("SELECT name, avatar FROM users WHERE id = 3").Scan(&name, &avatar); err != nil {
+		t.Fatalf("failed to read replayed insert: %v", err)
+	}
+	if name != "O'Brien" || !bytes.Equal(avatar, []byte{0x00, 0xff}) {
+		t.Fatalf("expected replayed insert to keep text and blob, got %q %x", name, avatar)
+	}
+	var count int
+	if err := source.db.QueryRow("SELECT COUNT(*) FROM users WHERE name IS NOT NULL").Scan(&count); err != nil {
+		t.Fatalf("failed to count source rows: %v", err)
+	}
+	if count != 2 {
+		t.Fatalf("expected script to leave source untouched, got %d named rows", count)
+	}
+}
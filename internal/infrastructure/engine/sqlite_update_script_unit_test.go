@@ -0,0 +1,64 @@
+package engine
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/mgierok/dbc/internal/domain/model"
+	"github.com/mgierok/dbc/internal/domain/service"
+)
+
+func TestInlineStatementArgs_SkipsPlaceholdersInsideQuotedIdentifiers(t *testing.T) {
+	// Arrange
+	statement := model.WriteStatement{
+		Table: "odd?table",
+		SQL:   `UPDATE "odd?table" SET "what?" = ? WHERE "id" = ?`,
+		Args:  []model.Value{{Text: "yes?", Raw: "yes?"}, {Text: "7", Raw: int64(7)}},
+	}
+
+	// Act
+	inlined, err := inlineStatementArgs(statement)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	expected := `UPDATE "odd?table" SET "what?" = 'yes?' WHERE "id" = 7`
+	if inlined != expected {
+		t.Fatalf("expected %q, got %q", expected, inlined)
+	}
+}
+
+func TestInlineStatementArgs_RejectsArgumentCountMismatch(t *testing.T) {
+	// Arrange
+	statement := model.WriteStatement{
+		Table: "users",
+		SQL:   `DELETE FROM "users" WHERE "id" = ?`,
+	}
+
+	// Act
+	_, err := inlineStatementArgs(statement)
+
+	// Assert
+	if !errors.Is(err, ErrStatementArgumentMismatch) {
+		t.Fatalf("expected argument mismatch error, got %v", err)
+	}
+}
+
+func TestInlineStatementArgs_RefusesNaN(t *testing.T) {
+	// Arrange
+	statement := model.WriteStatement{
+		Table: "readings",
+		SQL:   `UPDATE "readings" SET "value" = ? WHERE "id" = ?`,
+		Args:  []model.Value{{Text: "NaN", Raw: math.NaN()}, {Text: "1", Raw: int64(1)}},
+	}
+
+	// Act
+	_, err := inlineStatementArgs(statement)
+
+	// Assert
+	if !errors.Is(err, service.ErrValueHasNoSQLLiteral) {
+		t.Fatalf("expected NaN literal error, got %v", err)
+	}
+}
@@ -0,0 +1,116 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+// viewWriteTriggers maps lower-cased view names to the INSTEAD OF triggers
+// that make them writable.
+func (e *SQLiteEngine) viewWriteTriggers(ctx context.Context) (triggers map[string]model.TableWriteTriggers, err error) {
+	const query = `
+		SELECT tbl_name, sql
+		FROM sqlite_master
+		WHERE type = 'trigger'
+	`
+	rows, err := e.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}()
+
+	triggers = make(map[string]model.TableWriteTriggers)
+	for rows.Next() {
+		var (
+			tableName  string
+			definition sql.NullString
+		)
+		if err := rows.Scan(&tableName, &definition); err != nil {
+			return nil, err
+		}
+		event, ok := insteadOfTriggerEvent(definition.String)
+		if !ok {
+			continue
+		}
+		key := strings.ToLower(tableName)
+		current := triggers[key]
+		switch event {
+		case "INSERT":
+			current.Insert = true
+		case "UPDATE":
+			current.Update = true
+		case "DELETE":
+			current.Delete = true
+		}
+		triggers[key] = current
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return triggers, nil
+}
+
+// insteadOfTriggerEvent returns the upper-cased event of a CREATE TRIGGER
+// statement declared INSTEAD OF. Only the header before ON is read, through
+// tokenizeSQL, so comments, string literals, and the trigger body never match.
+func insteadOfTriggerEvent(definition string) (string, bool) {
+	tokens := tokenizeSQL(definition)
+	i := 0
+	word := func(words ...string) bool {
+		if i >= len(tokens) || tokens[i].kind != sqlTokenWord {
+			return false
+		}
+		for _, w := range words {
+			if strings.EqualFold(tokens[i].text, w) {
+				i++
+				return true
+			}
+		}
+		return false
+	}
+	if !word("CREATE") {
+		return "", false
+	}
+	word("TEMP", "TEMPORARY")
+	if !word("TRIGGER") {
+		return "", false
+	}
+	if word("IF") && !(word("NOT") && word("EXISTS")) {
+		return "", false
+	}
+	// Trigger name, optionally qualified by a schema name.
+	i++
+	if i < len(tokens) && tokens[i].text == "." {
+		i += 2
+	}
+	if !word("INSTEAD") || !word("OF") || i >= len(tokens) {
+		return "", false
+	}
+	event := strings.ToUpper(tokens[i].text)
+	if !word("INSERT", "UPDATE", "DELETE") {
+		return "", false
+	}
+	return event, true
+}
+
+func (e *SQLiteEngine) tableKind(ctx context.Context, tableName string) (model.TableKind, error) {
+	var kind string
+	err := e.db.QueryRowContext(ctx, "SELECT type FROM sqlite_master WHERE name = ? COLLATE NOCASE AND type IN ('table', 'view')", tableName).Scan(&kind)
+	if errors.Is(err, sql.ErrNoRows) {
+		// Temporary and attached objects are not in main's sqlite_master;
+		// treat them as tables, as before views were recognized.
+		return model.TableKindTable, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return model.TableKind(kind), nil
+}
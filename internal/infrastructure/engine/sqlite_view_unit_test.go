@@ -0,0 +1,35 @@
+package engine
+
+import "testing"
+
+func TestInsteadOfTriggerEvent(t *testing.T) {
+	tests := []struct {
+		name       string
+		definition string
+		wantEvent  string
+		wantOK     bool
+	}{
+		{name: "instead of update", definition: "CREATE TRIGGER t INSTEAD OF UPDATE ON v BEGIN SELECT 1; END", wantEvent: "UPDATE", wantOK: true},
+		{name: "temp qualified name", definition: `create temp trigger if not exists main."t" instead of delete on v begin select 1; end`, wantEvent: "DELETE", wantOK: true},
+		{name: "update of columns", definition: "CREATE TRIGGER t INSTEAD OF UPDATE OF a, b ON v BEGIN SELECT 1; END", wantEvent: "UPDATE", wantOK: true},
+		{name: "comment before event", definition: "CREATE TRIGGER t /* INSTEAD OF DELETE */ INSTEAD OF INSERT ON v BEGIN SELECT 1; END", wantEvent: "INSERT", wantOK: true},
+		{name: "after trigger", definition: "CREATE TRIGGER t AFTER INSERT ON orders BEGIN SELECT 1; END", wantOK: false},
+		{name: "comment in header", definition: "CREATE TRIGGER t -- INSTEAD OF DELETE\nAFTER INSERT ON orders BEGIN SELECT 1; END", wantOK: false},
+		{name: "literal in body", definition: "CREATE TRIGGER t AFTER INSERT ON orders BEGIN SELECT 'INSTEAD OF DELETE'; END", wantOK: false},
+		{name: "words in body", definition: "CREATE TRIGGER t BEFORE DELETE ON orders BEGIN SELECT instead, of FROM (SELECT 1 AS instead, 2 AS of); END", wantOK: false},
+		{name: "quoted name", definition: `CREATE TRIGGER "INSTEAD OF DELETE" AFTER UPDATE ON orders BEGIN SELECT 1; END`, wantOK: false},
+		{name: "no definition", definition: "", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			event, ok := insteadOfTriggerEvent(tt.definition)
+
+			// Assert
+			if ok != tt.wantOK || event != tt.wantEvent {
+				t.Fatalf("expected (%q, %v), got (%q, %v)", tt.wantEvent, tt.wantOK, event, ok)
+			}
+		})
+	}
+}
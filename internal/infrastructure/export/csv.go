@@ -0,0 +1,50 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+
+	"github.com/mgierok/dbc/internal/application/port"
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+// csvWriter writes a header line of column names followed by one line per
+// record. NULL is written as the configured NULL text.
+type csvWriter struct {
+	out     *csv.Writer
+	options port.RecordFormatOptions
+	fields  []string
+}
+
+func newCSVWriter(out io.Writer, options port.RecordFormatOptions) port.RecordWriter {
+	return &csvWriter{out: csv.NewWriter(out), options: options}
+}
+
+// newTSVWriter writes the csv layout with tab separators. Fields holding a
+// tab, quote, or line break are quoted the way the TSV importer reads them.
+func newTSVWriter(out io.Writer, options port.RecordFormatOptions) port.RecordWriter {
+	writer := csv.NewWriter(out)
+	writer.Comma = '\t'
+	return &csvWriter{out: writer, options: options}
+}
+
+func (w *csvWriter) WriteHeader(_ string, columns []model.Column) error {
+	w.fields = make([]string, len(columns))
+	return w.out.Write(columnNames(columns))
+}
+
+func (w *csvWriter) WriteRecord(values []model.Value) error {
+	for i, value := range values {
+		if value.IsNull {
+			w.fields[i] = w.options.NullText
+			continue
+		}
+		w.fields[i] = valueText(value, w.options)
+	}
+	return w.out.Write(w.fields)
+}
+
+func (w *csvWriter) Finish() error {
+	w.out.Flush()
+	return w.out.Error()
+}
@@ -0,0 +1,243 @@
+package export_test
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"io"
+	"math"
+	"reflect"
+	"testing"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/mgierok/dbc/internal/application/port"
+	"github.com/mgierok/dbc/internal/domain/model"
+	"github.com/mgierok/dbc/internal/domain/service"
+	"github.com/mgierok/dbc/internal/infrastructure/export"
+)
+
+func exportColumnsForTest() []model.Column {
+	return []model.Column{{Name: "id"}, {Name: "name"}, {Name: "avatar"}, {Name: "score"}}
+}
+
+func exportRecordsForTest() [][]model.Value {
+	return [][]model.Value{
+		{
+			{Text: "1", Raw: int64(1)},
+			{Text: "O'Brien | \"Bob\"\nline", Raw: "O'Brien | \"Bob\"\nline"},
+			{Raw: []byte{0x00, 0xff}},
+			{Text: "2", Raw: float64(2)},
+		},
+		{
+			{Text: "2", Raw: int64(2)},
+			{IsNull: true},
+			{IsNull: true},
+			{Text: "0.5", Raw: 0.5},
+		},
+	}
+}
+
+func writeExportForTest(t *testing.T, format string, options port.RecordFormatOptions) string {
+	t.Helper()
+	var out bytes.Buffer
+	writer, err := export.NewRegistry().NewRecordWriter(format, &out, options)
+	if err != nil {
+		t.Fatalf("failed to create %s writer: %v", format, err)
+	}
+	if err := writer.WriteHeader("users", exportColumnsForTest()); err != nil {
+		t.Fatalf("failed to write header: %v", err)
+	}
+	for _, record := range exportRecordsForTest() {
+		if err := writer.WriteRecord(record); err != nil {
+			t.Fatalf("failed to write record: %v", err)
+		}
+	}
+	if err := writer.Finish(); err != nil {
+		t.Fatalf("failed to finish: %v", err)
+	}
+	return out.String()
+}
+
+func TestRegistry_FormatsWriteRecords(t *testing.T) {
+	testCases := []struct {
+		name     string
+		format   string
+		options  port.RecordFormatOptions
+		expected string
+	}{
+		{
+			name:    "csv with base64 BLOB and NULL text",
+			format:  "csv",
+			options: port.RecordFormatOptions{Blob: port.BlobEncodingBase64, NullText: `\N`},
+			expected: "id,name,avatar,score\n" +
+				"1,\"O'Brien | \"\"Bob\"\"\nline\",AP8=,2.0\n" +
+				"2,\\N,\\N,0.5\n",
+		},
+		{
+			name:    "tsv with hex BLOB",
+			format:  "tsv",
+			options: port.RecordFormatOptions{Blob: port.BlobEncodingHex},
+			expected: "id\tname\tavatar\tscore\n" +
+				"1\t\"O'Brien | \"\"Bob\"\"\nline\"\t00ff\t2.0\n" +
+				"2\t\t\t0.5\n",
+		},
+		{
+			name:    "json with hex BLOB",
+			format:  "json",
+			options: port.RecordFormatOptions{Blob: port.BlobEncodingHex, NullText: "ignored"},
+			expected: "[\n" +
+				`{"id":1,"name":"O'Brien | \"Bob\"\nline","avatar":"00ff","score":2}` + ",\n" +
+				`{"id":2,"name":null,"avatar":null,"score":0.5}` + "\n]\n",
+		},
+		{
+			name:    "ndjson with omitted BLOB",
+			format:  "ndjson",
+			options: port.RecordFormatOptions{Blob: port.BlobEncodingOmit},
+			expected: `{"id":1,"name":"O'Brien | \"Bob\"\nline","score":2}` + "\n" +
+				`{"id":2,"name":null,"avatar":null,"score":0.5}` + "\n",
+		},
+		{
+			name:    "markdown",
+			format:  "markdown",
+			options: port.RecordFormatOptions{Blob: port.BlobEncodingHex, NullText: "NULL"},
+			expected: "| id | name | avatar | score |\n" +
+				"| --- | --- | --- | --- |\n" +
+				"| 1 | O'Brien \\| \"Bob\"<br>line | 00ff | 2.0 |\n" +
+				"| 2 | NULL | NULL | 0.5 |\n",
+		},
+		{
+			name:    "sql insert with omitted BLOB",
+			format:  "sql",
+			options: port.RecordFormatOptions{Blob: port.BlobEncodingOmit},
+			expected: `INSERT INTO "users" ("id", "name", "score") VALUES (1, 'O''Brien | "Bob"` + "\n" + `line', 2.0);` + "\n" +
+				`INSERT INTO "users" ("id", "name", "avatar", "score") VALUES (2, NULL, NULL, 0.5);` + "\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			output := writeExportForTest(t, tc.format, tc.options)
+
+			// Assert
+			if output != tc.expected {
+				t.Fatalf("expected:\n%s\ngot:\n%s", tc.expected, output)
+			}
+		})
+	}
+}
+
+func TestRegistry_SQLInsertWritesBLOBAsHexLiteral(t *testing.T) {
+	// Act
+	output := writeExportForTest(t, "sql", port.RecordFormatOptions{Blob: port.BlobEncodingBase64})
+
+	// Assert
+	expected := `INSERT INTO "users" ("id", "name", "avatar", "score") VALUES (1, 'O''Brien | "Bob"` + "\n" + `line', X'00FF', 2.0);`
+	if output[:len(expected)] != expected {
+		t.Fatalf("expected first statement %q, got %q", expected, output)
+	}
+}
+
+func TestRegistry_SQLInsertScriptReplaysIntoTableWithGeneratedColumn(t *testing.T) {
+	// Arrange
+	db, err := sql.Open("sqlite", "file:"+t.Name()+"?mode=memory")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	if _, err := db.Exec(`CREATE TABLE items (name TEXT, size INTEGER GENERATED ALWAYS AS (length(name)) STORED)`); err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+	var out bytes.Buffer
+	writer, err := export.NewRegistry().NewRecordWriter("sql", &out, port.RecordFormatOptions{})
+	if err != nil {
+		t.Fatalf("failed to create writer: %v", err)
+	}
+	if err := writer.WriteHeader("items", []model.Column{{Name: "name"}, {Name: "size", Generated: true}}); err != nil {
+		t.Fatalf("failed to write header: %v", err)
+	}
+	if err := writer.WriteRecord([]model.Value{{Text: "bolt", Raw: "bolt"}, {Text: "4", Raw: int64(4)}}); err != nil {
+		t.Fatalf("failed to write record: %v", err)
+	}
+	if err := writer.Finish(); err != nil {
+		t.Fatalf("failed to finish: %v", err)
+	}
+
+	// Act
+	_, err = db.Exec(out.String())
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected script %q to replay, got %v", out.String(), err)
+	}
+	var size int
+	if err := db.QueryRow(`SELECT size FROM items WHERE name = 'bolt'`).Scan(&size); err != nil {
+		t.Fatalf("failed to read replayed row: %v", err)
+	}
+	if size != 4 {
+		t.Fatalf("expected generated size 4, got %d", size)
+	}
+}
+
+func TestRegistry_SQLInsertRefusesNaN(t *testing.T) {
+	// Arrange
+	writer, err := export.NewRegistry().NewRecordWriter("sql", io.Discard, port.RecordFormatOptions{})
+	if err != nil {
+		t.Fatalf("failed to create writer: %v", err)
+	}
+	if err := writer.WriteHeader("readings", []model.Column{{Name: "value"}}); err != nil {
+		t.Fatalf("failed to write header: %v", err)
+	}
+
+	// Act
+	err = writer.WriteRecord([]model.Value{{Text: "NaN", Raw: math.NaN()}})
+
+	// Assert
+	if !errors.Is(err, service.ErrValueHasNoSQLLiteral) {
+		t.Fatalf("expected NaN literal error, got %v", err)
+	}
+}
+
+func TestRegistry_JSONWritesEmptyArrayWithoutRecords(t *testing.T) {
+	// Arrange
+	var out bytes.Buffer
+	writer, err := export.NewRegistry().NewRecordWriter("json", &out, port.RecordFormatOptions{})
+	if err != nil {
+		t.Fatalf("failed to create writer: %v", err)
+	}
+
+	// Act
+	if err := writer.WriteHeader("users", exportColumnsForTest()); err != nil {
+		t.Fatalf("failed to write header: %v", err)
+	}
+	err = writer.Finish()
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if out.String() != "[]\n" {
+		t.Fatalf("expected empty array, got %q", out.String())
+	}
+}
+
+func TestRegistry_RegisterAddsFormatAndRejectsUnknownFormat(t *testing.T) {
+	// Arrange
+	registry := export.NewRegistry()
+	registry.Register("yaml", func(out io.Writer, options port.RecordFormatOptions) port.RecordWriter {
+		return nil
+	})
+
+	// Act
+	_, err := registry.NewRecordWriter("xml", &bytes.Buffer{}, port.RecordFormatOptions{})
+
+	// Assert
+	if !errors.Is(err, export.ErrUnknownFormat) {
+		t.Fatalf("expected unknown format error, got %v", err)
+	}
+	expected := []string{"csv", "json", "markdown", "ndjson", "sql", "tsv", "yaml"}
+	if !reflect.DeepEqual(registry.Formats(), expected) {
+		t.Fatalf("expected formats %v, got %v", expected, registry.Formats())
+	}
+}
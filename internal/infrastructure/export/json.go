@@ -0,0 +1,121 @@
+package export
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"math"
+
+	"github.com/mgierok/dbc/internal/application/port"
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+// jsonWriter writes each record as an object with keys in column order:
+// either all records in one array or, for NDJSON, one object per line. NULL
+// is written as JSON null and omitted BLOBs leave out their key.
+type jsonWriter struct {
+	out       *bufio.Writer
+	options   port.RecordFormatOptions
+	lines     bool
+	keys      [][]byte
+	records   int
+	objectBuf []byte
+}
+
+func newJSONWriter(out io.Writer, options port.RecordFormatOptions) port.RecordWriter {
+	return &jsonWriter{out: bufio.NewWriter(out), options: options}
+}
+
+func newNDJSONWriter(out io.Writer, options port.RecordFormatOptions) port.RecordWriter {
+	return &jsonWriter{out: bufio.NewWriter(out), options: options, lines: true}
+}
+
+func (w *jsonWriter) WriteHeader(_ string, columns []model.Column) error {
+	w.keys = make([][]byte, len(columns))
+	for i, column := range columns {
+		key, err := json.Marshal(column.Name)
+		if err != nil {
+			return err
+		}
+		w.keys[i] = key
+	}
+	if w.lines {
+		return nil
+	}
+	_, err := w.out.WriteString("[")
+	return err
+}
+
+func (w *jsonWriter) WriteRecord(values []model.Value) error {
+	object := append(w.objectBuf[:0], '{')
+	first := true
+	for i, value := range values {
+		if blobOmitted(value, w.options) {
+			continue
+		}
+		encoded, err := w.encodeValue(value)
+		if err != nil {
+			return err
+		}
+		if !first {
+			object = append(object, ',')
+		}
+		first = false
+		object = append(object, w.keys[i]...)
+		object = append(object, ':')
+		object = append(object, encoded...)
+	}
+	object = append(object, '}')
+	w.objectBuf = object
+
+	if !w.lines {
+		separator := "\n"
+		if w.records > 0 {
+			separator = ",\n"
+		}
+		if _, err := w.out.WriteString(separator); err != nil {
+			return err
+		}
+	}
+	if _, err := w.out.Write(object); err != nil {
+		return err
+	}
+	if w.lines {
+		if err := w.out.WriteByte('\n'); err != nil {
+			return err
+		}
+	}
+	w.records++
+	return nil
+}
+
+func (w *jsonWriter) encodeValue(value model.Value) ([]byte, error) {
+	if value.IsNull {
+		return []byte("null"), nil
+	}
+	switch raw := value.Raw.(type) {
+	case int64:
+		return json.Marshal(raw)
+	case float64:
+		// JSON has no infinity; write it as text instead of failing.
+		if math.IsInf(raw, 0) || math.IsNaN(raw) {
+			return json.Marshal(realText(raw))
+		}
+		return json.Marshal(raw)
+	default:
+		return json.Marshal(valueText(value, w.options))
+	}
+}
+
+func (w *jsonWriter) Finish() error {
+	if !w.lines {
+		closing := "]\n"
+		if w.records > 0 {
+			closing = "\n]\n"
+		}
+		if _, err := w.out.WriteString(closing); err != nil {
+			return err
+		}
+	}
+	return w.out.Flush()
+}
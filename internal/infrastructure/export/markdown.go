@@ -0,0 +1,63 @@
+package export
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"github.com/mgierok/dbc/internal/application/port"
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+var markdownCellReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	"|", `\|`,
+	"\r\n", "<br>",
+	"\n", "<br>",
+	"\r", "<br>",
+)
+
+// markdownWriter writes a pipe table. Pipes and backslashes in values are
+// escaped and line breaks become <br> so every record stays on one row.
+type markdownWriter struct {
+	out     *bufio.Writer
+	options port.RecordFormatOptions
+}
+
+func newMarkdownWriter(out io.Writer, options port.RecordFormatOptions) port.RecordWriter {
+	return &markdownWriter{out: bufio.NewWriter(out), options: options}
+}
+
+func (w *markdownWriter) WriteHeader(_ string, columns []model.Column) error {
+	cells := make([]string, len(columns))
+	separators := make([]string, len(columns))
+	for i, column := range columns {
+		cells[i] = markdownCellReplacer.Replace(column.Name)
+		separators[i] = "---"
+	}
+	if err := w.writeRow(cells); err != nil {
+		return err
+	}
+	return w.writeRow(separators)
+}
+
+func (w *markdownWriter) WriteRecord(values []model.Value) error {
+	cells := make([]string, len(values))
+	for i, value := range values {
+		text := w.options.NullText
+		if !value.IsNull {
+			text = valueText(value, w.options)
+		}
+		cells[i] = markdownCellReplacer.Replace(text)
+	}
+	return w.writeRow(cells)
+}
+
+func (w *markdownWriter) writeRow(cells []string) error {
+	_, err := w.out.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+	return err
+}
+
+func (w *markdownWriter) Finish() error {
+	return w.out.Flush()
+}
@@ -0,0 +1,54 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/mgierok/dbc/internal/application/port"
+)
+
+var ErrUnknownFormat = errors.New("unknown export format")
+
+// Constructor creates a record writer that writes one format to out.
+type Constructor func(out io.Writer, options port.RecordFormatOptions) port.RecordWriter
+
+// Registry maps export format names to their writers. NewRegistry registers
+// the built-in formats; Register adds or replaces one.
+type Registry struct {
+	constructors map[string]Constructor
+}
+
+func NewRegistry() *Registry {
+	registry := &Registry{constructors: map[string]Constructor{}}
+	registry.Register("csv", newCSVWriter)
+	registry.Register("tsv", newTSVWriter)
+	registry.Register("json", newJSONWriter)
+	registry.Register("ndjson", newNDJSONWriter)
+	registry.Register("markdown", newMarkdownWriter)
+	registry.Register("sql", newSQLInsertWriter)
+	return registry
+}
+
+func (r *Registry) Register(format string, constructor Constructor) {
+	r.constructors[format] = constructor
+}
+
+// Formats returns the registered format names in alphabetical order.
+func (r *Registry) Formats() []string {
+	formats := make([]string, 0, len(r.constructors))
+	for format := range r.constructors {
+		formats = append(formats, format)
+	}
+	sort.Strings(formats)
+	return formats
+}
+
+func (r *Registry) NewRecordWriter(format string, out io.Writer, options port.RecordFormatOptions) (port.RecordWriter, error) {
+	constructor, ok := r.constructors[format]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+	return constructor(out, options), nil
+}
@@ -0,0 +1,68 @@
+package export
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"github.com/mgierok/dbc/internal/application/port"
+	"github.com/mgierok/dbc/internal/domain/model"
+	"github.com/mgierok/dbc/internal/domain/service"
+)
+
+// sqlInsertWriter writes one INSERT statement per record. BLOBs are always
+// written as X'…' literals, since SQL has no base64 literal; omitted BLOBs
+// drop their column from that record's statement. Generated columns are left
+// out of every statement, since SQLite refuses values for them.
+type sqlInsertWriter struct {
+	out       *bufio.Writer
+	options   port.RecordFormatOptions
+	table     string
+	columns   []string
+	generated []bool
+}
+
+func newSQLInsertWriter(out io.Writer, options port.RecordFormatOptions) port.RecordWriter {
+	return &sqlInsertWriter{out: bufio.NewWriter(out), options: options}
+}
+
+func (w *sqlInsertWriter) WriteHeader(table string, columns []model.Column) error {
+	w.table = quoteIdentifier(table)
+	w.columns = make([]string, len(columns))
+	w.generated = make([]bool, len(columns))
+	for i, column := range columns {
+		w.columns[i] = quoteIdentifier(column.Name)
+		w.generated[i] = column.Generated
+	}
+	return nil
+}
+
+func (w *sqlInsertWriter) WriteRecord(values []model.Value) error {
+	columns := make([]string, 0, len(values))
+	literals := make([]string, 0, len(values))
+	for i, value := range values {
+		if w.generated[i] || blobOmitted(value, w.options) {
+			continue
+		}
+		literal, err := service.SQLLiteral(value)
+		if err != nil {
+			return err
+		}
+		columns = append(columns, w.columns[i])
+		literals = append(literals, literal)
+	}
+	statement := "INSERT INTO " + w.table + " (" + strings.Join(columns, ", ") + ") VALUES (" + strings.Join(literals, ", ") + ");\n"
+	if len(columns) == 0 {
+		statement = "INSERT INTO " + w.table + " DEFAULT VALUES;\n"
+	}
+	_, err := w.out.WriteString(statement)
+	return err
+}
+
+func (w *sqlInsertWriter) Finish() error {
+	return w.out.Flush()
+}
+
+func quoteIdentifier(identifier string) string {
+	return `"` + strings.ReplaceAll(identifier, `"`, `""`) + `"`
+}
@@ -0,0 +1,69 @@
+package export
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/mgierok/dbc/internal/application/port"
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+func isBlob(value model.Value) bool {
+	_, ok := value.Raw.([]byte)
+	return ok
+}
+
+// blobOmitted reports whether value is a BLOB the options leave out.
+func blobOmitted(value model.Value, options port.RecordFormatOptions) bool {
+	return options.Blob == port.BlobEncodingOmit && isBlob(value)
+}
+
+// valueText renders a non-NULL value as plain text, encoding BLOBs as the
+// options ask.
+func valueText(value model.Value, options port.RecordFormatOptions) string {
+	switch raw := value.Raw.(type) {
+	case []byte:
+		if options.Blob == port.BlobEncodingHex {
+			return hex.EncodeToString(raw)
+		}
+		if options.Blob == port.BlobEncodingOmit {
+			return ""
+		}
+		return base64.StdEncoding.EncodeToString(raw)
+	case int64:
+		return strconv.FormatInt(raw, 10)
+	case float64:
+		return realText(raw)
+	case string:
+		return raw
+	case nil:
+		return value.Text
+	default:
+		return fmt.Sprint(raw)
+	}
+}
+
+// realText keeps a decimal point on integral reals, the way SQLite prints
+// them, so they stay distinguishable from integers.
+func realText(value float64) string {
+	if math.IsInf(value, 0) || math.IsNaN(value) {
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	}
+	text := strconv.FormatFloat(value, 'g', -1, 64)
+	if !strings.ContainsAny(text, ".e") {
+		text += ".0"
+	}
+	return text
+}
+
+func columnNames(columns []model.Column) []string {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		names[i] = column.Name
+	}
+	return names
+}
@@ -0,0 +1,20 @@
+package filesystem
+
+import (
+	"context"
+	"io"
+	"os"
+)
+
+type FileReader struct{}
+
+func NewFileReader() *FileReader {
+	return &FileReader{}
+}
+
+func (r *FileReader) OpenFile(ctx context.Context, path string) (io.ReadCloser, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return os.Open(path)
+}
@@ -0,0 +1,74 @@
+package filesystem
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/mgierok/dbc/internal/application/port"
+)
+
+type FileWriter struct{}
+
+func NewFileWriter() *FileWriter {
+	return &FileWriter{}
+}
+
+// WriteFile replaces path with content. The content goes to a temporary file
+// in the same directory first, so a failed write never leaves a partial file
+// behind.
+func (w *FileWriter) WriteFile(ctx context.Context, path string, content []byte) error {
+	sink, err := w.CreateFile(ctx, path)
+	if err != nil {
+		return err
+	}
+	if _, err := sink.Write(content); err != nil {
+		_ = sink.Abort()
+		return err
+	}
+	return sink.Commit()
+}
+
+// CreateFile starts writing path through a temporary file in the same
+// directory. The target is replaced only when the returned sink is committed.
+func (w *FileWriter) CreateFile(ctx context.Context, path string) (port.FileSink, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	file, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return nil, err
+	}
+	return &fileSink{file: file, path: path}, nil
+}
+
+type fileSink struct {
+	file *os.File
+	path string
+}
+
+func (s *fileSink) Write(p []byte) (int, error) {
+	return s.file.Write(p)
+}
+
+func (s *fileSink) Commit() (err error) {
+	tmpPath := s.file.Name()
+	defer func() {
+		if err != nil {
+			_ = os.Remove(tmpPath)
+		}
+	}()
+	if err := s.file.Chmod(0o644); err != nil {
+		_ = s.file.Close()
+		return err
+	}
+	if err := s.file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, s.path)
+}
+
+func (s *fileSink) Abort() error {
+	_ = s.file.Close()
+	return os.Remove(s.file.Name())
+}
@@ -0,0 +1,122 @@
+package filesystem_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mgierok/dbc/internal/infrastructure/filesystem"
+)
+
+func TestFileWriter_WriteFileReplacesExistingContent(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	path := filepath.Join(dir, "changes.sql")
+	if err := os.WriteFile(path, []byte("old"), 0o600); err != nil {
+		t.Fatalf("failed to seed file: %v", err)
+	}
+	writer := filesystem.NewFileWriter()
+
+	// Act
+	err := writer.WriteFile(context.Background(), path, []byte("COMMIT;\n"))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read file: %v", err)
+	}
+	if string(content) != "COMMIT;\n" {
+		t.Fatalf("expected replaced content, got %q", content)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("failed to list dir: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("expected no temporary files to remain, got %d entries", len(entries))
+	}
+}
+
+func TestFileWriter_WriteFileFailsForMissingDirectory(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "missing", "changes.sql")
+	writer := filesystem.NewFileWriter()
+
+	// Act
+	err := writer.WriteFile(context.Background(), path, []byte("COMMIT;\n"))
+
+	// Assert
+	if err == nil {
+		t.Fatal("expected missing directory error, got nil")
+	}
+}
+
+func TestFileWriter_CreateFileAbortKeepsExistingContent(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	path := filepath.Join(dir, "users.csv")
+	if err := os.WriteFile(path, []byte("old"), 0o600); err != nil {
+		t.Fatalf("failed to seed file: %v", err)
+	}
+	sink, err := filesystem.NewFileWriter().CreateFile(context.Background(), path)
+	if err != nil {
+		t.Fatalf("failed to create file: %v", err)
+	}
+
+	// Act
+	if _, err := sink.Write([]byte("id\n1\n")); err != nil {
+		t.Fatalf("failed to write: %v", err)
+	}
+	err = sink.Abort()
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read file: %v", err)
+	}
+	if string(content) != "old" {
+		t.Fatalf("expected untouched content, got %q", content)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("failed to list dir: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("expected no temporary files to remain, got %d entries", len(entries))
+	}
+}
+
+func TestFileReader_OpenFileReadsContent(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "users.csv")
+	if err := os.WriteFile(path, []byte("id\n1\n"), 0o600); err != nil {
+		t.Fatalf("failed to seed file: %v", err)
+	}
+	reader := filesystem.NewFileReader()
+
+	// Act
+	file, err := reader.OpenFile(context.Background(), path)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+	content, err := io.ReadAll(file)
+	if err != nil {
+		t.Fatalf("failed to read file: %v", err)
+	}
+	if string(content) != "id\n1\n" {
+		t.Fatalf("unexpected content %q", content)
+	}
+}
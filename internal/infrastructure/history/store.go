@@ -0,0 +1,161 @@
+package history
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/mgierok/dbc/internal/application/port"
+)
+
+const (
+	historyDirName      = "history"
+	maxHistorySizeBytes = 32 << 20
+)
+
+var (
+	ErrHistoryTooLarge     = errors.New("history file exceeds 32 MiB limit")
+	ErrUnknownHistoryKind  = errors.New("unknown history kind")
+	errHistoryFileMismatch = errors.New("history file belongs to another database")
+)
+
+// historyFile holds both histories of one database. The database key is kept
+// in the file so a hash collision is detected instead of mixing histories.
+type historyFile struct {
+	Database string   `json:"database"`
+	Commands []string `json:"commands,omitempty"`
+	SQL      []string `json:"sql,omitempty"`
+}
+
+// Store keeps one JSON file per database in a history directory next to the
+// config file.
+type Store struct {
+	dir string
+}
+
+func NewStore(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// DirFromConfigPath returns the history directory stored beside configPath.
+func DirFromConfigPath(configPath string) string {
+	return filepath.Join(filepath.Dir(configPath), historyDirName)
+}
+
+func (s *Store) Load(ctx context.Context, database string, kind port.HistoryKind) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	file, err := s.readFile(database)
+	if err != nil {
+		if errors.Is(err, errHistoryFileMismatch) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+	entries, err := file.entries(kind)
+	if err != nil {
+		return nil, err
+	}
+	return append([]string{}, *entries...), nil
+}
+
+func (s *Store) Save(ctx context.Context, database string, kind port.HistoryKind, entries []string) (err error) {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	file, err := s.readFile(database)
+	if err != nil {
+		if !errors.Is(err, errHistoryFileMismatch) {
+			return err
+		}
+		file = historyFile{Database: database}
+	}
+	target, err := file.entries(kind)
+	if err != nil {
+		return err
+	}
+	*target = append([]string(nil), entries...)
+
+	content, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.dir, 0o700); err != nil {
+		return err
+	}
+	path := s.path(database)
+	tmp, err := os.CreateTemp(s.dir, "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+	defer func() {
+		if err != nil {
+			_ = os.Remove(tmpPath)
+		}
+	}()
+	if _, err := tmp.Write(append(content, '\n')); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if closeErr := tmp.Close(); closeErr != nil {
+		return closeErr
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// readFile returns the history file of database, or an empty one when none
+// exists yet.
+func (s *Store) readFile(database string) (file historyFile, err error) {
+	// #nosec G304 -- the file name is a hash inside the history directory.
+	handle, err := os.Open(s.path(database))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return historyFile{Database: database}, nil
+		}
+		return historyFile{}, err
+	}
+	defer func() {
+		if closeErr := handle.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}()
+
+	content, err := io.ReadAll(io.LimitReader(handle, maxHistorySizeBytes+1))
+	if err != nil {
+		return historyFile{}, err
+	}
+	if len(content) > maxHistorySizeBytes {
+		return historyFile{}, ErrHistoryTooLarge
+	}
+	if err := json.Unmarshal(content, &file); err != nil {
+		return historyFile{}, fmt.Errorf("invalid history file %s: %w", s.path(database), err)
+	}
+	if file.Database != database {
+		return historyFile{}, errHistoryFileMismatch
+	}
+	return file, nil
+}
+
+func (s *Store) path(database string) string {
+	sum := sha256.Sum256([]byte(database))
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:16])+".json")
+}
+
+func (f *historyFile) entries(kind port.HistoryKind) (*[]string, error) {
+	switch kind {
+	case port.HistoryKindCommands:
+		return &f.Commands, nil
+	case port.HistoryKindSQL:
+		return &f.SQL, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownHistoryKind, kind)
+	}
+}
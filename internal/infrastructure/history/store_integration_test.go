@@ -0,0 +1,109 @@
+package history_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mgierok/dbc/internal/application/port"
+	"github.com/mgierok/dbc/internal/infrastructure/history"
+)
+
+func TestStore_LoadReturnsEmptyHistoryWhenNothingWasSaved(t *testing.T) {
+	// Arrange
+	store := history.NewStore(filepath.Join(t.TempDir(), "history"))
+
+	// Act
+	entries, err := store.Load(context.Background(), "/tmp/app.db", port.HistoryKindCommands)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("expected empty history, got %v", entries)
+	}
+}
+
+func TestStore_SaveKeepsHistoriesPerDatabaseAndKind(t *testing.T) {
+	// Arrange
+	dir := filepath.Join(t.TempDir(), "history")
+	store := history.NewStore(dir)
+	ctx := context.Background()
+
+	// Act
+	if err := store.Save(ctx, "/tmp/app.db", port.HistoryKindCommands, []string{"w", "preview"}); err != nil {
+		t.Fatalf("failed to save commands: %v", err)
+	}
+	if err := store.Save(ctx, "/tmp/app.db", port.HistoryKindSQL, []string{"SELECT 1"}); err != nil {
+		t.Fatalf("failed to save SQL: %v", err)
+	}
+	if err := store.Save(ctx, "/tmp/other.db", port.HistoryKindCommands, []string{"q"}); err != nil {
+		t.Fatalf("failed to save other commands: %v", err)
+	}
+
+	// Assert
+	reopened := history.NewStore(dir)
+	for _, tc := range []struct {
+		database string
+		kind     port.HistoryKind
+		expected []string
+	}{
+		{database: "/tmp/app.db", kind: port.HistoryKindCommands, expected: []string{"w", "preview"}},
+		{database: "/tmp/app.db", kind: port.HistoryKindSQL, expected: []string{"SELECT 1"}},
+		{database: "/tmp/other.db", kind: port.HistoryKindCommands, expected: []string{"q"}},
+		{database: "/tmp/other.db", kind: port.HistoryKindSQL, expected: []string{}},
+	} {
+		entries, err := reopened.Load(ctx, tc.database, tc.kind)
+		if err != nil {
+			t.Fatalf("failed to load %s %s history: %v", tc.database, tc.kind, err)
+		}
+		if fmt.Sprint(entries) != fmt.Sprint(tc.expected) {
+			t.Fatalf("expected %s %s history %v, got %v", tc.database, tc.kind, tc.expected, entries)
+		}
+	}
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("failed to list history dir: %v", err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("expected one file per database and no temporary files, got %d", len(files))
+	}
+	info, err := os.Stat(filepath.Join(dir, files[0].Name()))
+	if err != nil {
+		t.Fatalf("failed to stat history file: %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Fatalf("expected private history file, got %v", info.Mode().Perm())
+	}
+}
+
+func TestStore_RejectsUnknownHistoryKind(t *testing.T) {
+	// Arrange
+	store := history.NewStore(t.TempDir())
+
+	// Act
+	err := store.Save(context.Background(), "/tmp/app.db", port.HistoryKind("files"), []string{"x"})
+
+	// Assert
+	if !errors.Is(err, history.ErrUnknownHistoryKind) {
+		t.Fatalf("expected unknown history kind error, got %v", err)
+	}
+}
+
+func TestDirFromConfigPath_UsesConfigDirectory(t *testing.T) {
+	// Arrange
+	configPath := filepath.Join("home", ".config", "dbc", "config.json")
+
+	// Act
+	dir := history.DirFromConfigPath(configPath)
+
+	// Assert
+	expected := filepath.Join("home", ".config", "dbc", "history")
+	if dir != expected {
+		t.Fatalf("expected %q, got %q", expected, dir)
+	}
+}
@@ -0,0 +1,87 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mgierok/dbc/internal/application/port"
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+var (
+	ErrMissingHeader   = errors.New("missing header line")
+	ErrDuplicateHeader = errors.New("duplicate header")
+)
+
+// delimitedReader reads a header line of column names followed by one record
+// per line. Every value is read as text; NULL is left to the caller.
+type delimitedReader struct {
+	in      *csv.Reader
+	headers []string
+}
+
+func newCSVReader(in io.Reader) (port.RecordReader, error) {
+	return newDelimitedReader(csv.NewReader(in))
+}
+
+// newTSVReader reads tab separated values. Quotes have no special meaning
+// inside a field, as TSV files rarely escape them.
+func newTSVReader(in io.Reader) (port.RecordReader, error) {
+	reader := csv.NewReader(in)
+	reader.Comma = '\t'
+	reader.LazyQuotes = true
+	return newDelimitedReader(reader)
+}
+
+func newDelimitedReader(in *csv.Reader) (port.RecordReader, error) {
+	in.FieldsPerRecord = -1
+	headers, err := in.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, ErrMissingHeader
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(headers) > 0 {
+		headers[0] = strings.TrimPrefix(headers[0], "\ufeff")
+	}
+	seen := make(map[string]bool, len(headers))
+	for i, header := range headers {
+		headers[i] = strings.TrimSpace(header)
+		if seen[headers[i]] {
+			return nil, fmt.Errorf("%w: %q", ErrDuplicateHeader, headers[i])
+		}
+		seen[headers[i]] = true
+	}
+	return &delimitedReader{in: in, headers: headers}, nil
+}
+
+func (r *delimitedReader) Headers() []string {
+	return r.headers
+}
+
+func (r *delimitedReader) Next() (port.ImportRecord, error) {
+	fields, err := r.in.Read()
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return port.ImportRecord{Line: parseErr.StartLine, Err: parseErr.Err}, nil
+		}
+		return port.ImportRecord{}, err
+	}
+	line, _ := r.in.FieldPos(0)
+	if len(fields) != len(r.headers) {
+		return port.ImportRecord{
+			Line: line,
+			Err:  fmt.Errorf("expected %d fields, got %d", len(r.headers), len(fields)),
+		}, nil
+	}
+	values := make(map[string]model.Value, len(fields))
+	for i, field := range fields {
+		values[r.headers[i]] = model.Value{Text: field}
+	}
+	return port.ImportRecord{Line: line, Values: values}, nil
+}
@@ -0,0 +1,137 @@
+package importer_test
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/mgierok/dbc/internal/application/port"
+	"github.com/mgierok/dbc/internal/domain/model"
+	"github.com/mgierok/dbc/internal/infrastructure/importer"
+)
+
+func readImportForTest(t *testing.T, format, content string) ([]string, []port.ImportRecord) {
+	t.Helper()
+	reader, err := importer.NewRegistry().NewRecordReader(format, strings.NewReader(content))
+	if err != nil {
+		t.Fatalf("failed to create %s reader: %v", format, err)
+	}
+	var records []port.ImportRecord
+	for {
+		record, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("failed to read record: %v", err)
+		}
+		records = append(records, record)
+	}
+	return reader.Headers(), records
+}
+
+func TestRegistry_CSVReadsHeaderAndReportsMalformedLines(t *testing.T) {
+	// Arrange
+	content := "\ufeffid, name\n1,\"O'Brien, \"\"Bob\"\"\"\n2\n\n3,\"multi\nline\"\n"
+
+	// Act
+	headers, records := readImportForTest(t, "csv", content)
+
+	// Assert
+	if !reflect.DeepEqual(headers, []string{"id", "name"}) {
+		t.Fatalf("expected trimmed headers, got %v", headers)
+	}
+	if len(records) != 3 {
+		t.Fatalf("expected three records, got %+v", records)
+	}
+	expectedFirst := map[string]model.Value{"id": {Text: "1"}, "name": {Text: `O'Brien, "Bob"`}}
+	if records[0].Line != 2 || !reflect.DeepEqual(records[0].Values, expectedFirst) {
+		t.Fatalf("unexpected first record %+v", records[0])
+	}
+	if records[1].Line != 3 || records[1].Err == nil || records[1].Err.Error() != "expected 2 fields, got 1" {
+		t.Fatalf("expected field count error on line 3, got %+v", records[1])
+	}
+	if records[2].Line != 5 || records[2].Values["name"].Text != "multi\nline" {
+		t.Fatalf("expected quoted line break on line 5, got %+v", records[2])
+	}
+}
+
+func TestRegistry_TSVKeepsQuotesLiteral(t *testing.T) {
+	// Arrange
+	content := "id\tname\n1\tsay \"hi\"\n"
+
+	// Act
+	headers, records := readImportForTest(t, "tsv", content)
+
+	// Assert
+	if !reflect.DeepEqual(headers, []string{"id", "name"}) {
+		t.Fatalf("unexpected headers %v", headers)
+	}
+	if len(records) != 1 || records[0].Values["name"].Text != `say "hi"` {
+		t.Fatalf("expected literal quotes, got %+v", records)
+	}
+}
+
+func TestRegistry_NDJSONCollectsKeysAndConvertsValues(t *testing.T) {
+	// Arrange
+	content := `{"id": 1, "name": "alice", "active": true, "tags": ["a", "b"]}` + "\n" +
+		"\n" +
+		`[1, 2]` + "\n" +
+		`{"id": 2.5e3, "note": null}` + "\n" +
+		`{"id": 3` + "\n"
+
+	// Act
+	headers, records := readImportForTest(t, "ndjson", content)
+
+	// Assert
+	if !reflect.DeepEqual(headers, []string{"id", "name", "active", "tags", "note"}) {
+		t.Fatalf("expected keys in first-seen order, got %v", headers)
+	}
+	if len(records) != 4 {
+		t.Fatalf("expected four records, got %+v", records)
+	}
+	expectedFirst := map[string]model.Value{
+		"id":     {Text: "1"},
+		"name":   {Text: "alice"},
+		"active": {Text: "true"},
+		"tags":   {Text: `["a","b"]`},
+	}
+	if records[0].Line != 1 || !reflect.DeepEqual(records[0].Values, expectedFirst) {
+		t.Fatalf("unexpected first record %+v", records[0])
+	}
+	if records[1].Line != 3 || records[1].Err == nil {
+		t.Fatalf("expected non-object error on line 3, got %+v", records[1])
+	}
+	expectedThird := map[string]model.Value{"id": {Text: "2.5e3"}, "note": {IsNull: true}}
+	if records[2].Line != 4 || !reflect.DeepEqual(records[2].Values, expectedThird) {
+		t.Fatalf("unexpected third record %+v", records[2])
+	}
+	if records[3].Line != 5 || records[3].Err == nil {
+		t.Fatalf("expected syntax error on line 5, got %+v", records[3])
+	}
+}
+
+func TestRegistry_CSVRejectsDuplicateHeaders(t *testing.T) {
+	// Act
+	_, err := importer.NewRegistry().NewRecordReader("csv", strings.NewReader("id,ID,id\n"))
+
+	// Assert
+	if !errors.Is(err, importer.ErrDuplicateHeader) {
+		t.Fatalf("expected duplicate header error, got %v", err)
+	}
+}
+
+func TestRegistry_UnknownFormat(t *testing.T) {
+	// Act
+	_, err := importer.NewRegistry().NewRecordReader("xlsx", strings.NewReader(""))
+
+	// Assert
+	if !errors.Is(err, importer.ErrUnknownFormat) {
+		t.Fatalf("expected unknown format error, got %v", err)
+	}
+	if got := importer.NewRegistry().Formats(); !reflect.DeepEqual(got, []string{"csv", "ndjson", "tsv"}) {
+		t.Fatalf("unexpected formats %v", got)
+	}
+}
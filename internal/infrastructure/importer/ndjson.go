@@ -0,0 +1,129 @@
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/mgierok/dbc/internal/application/port"
+	"github.com/mgierok/dbc/internal/domain/model"
+)
+
+const maxNDJSONLineBytes = 16 << 20
+
+var errNotJSONObject = errors.New("expected a JSON object")
+
+// ndjsonReader reads one JSON object per line. Headers are the object keys in
+// the order they first appear. Strings and numbers are read as their text,
+// booleans as true or false, null as NULL, and nested objects and arrays as
+// compact JSON text. Blank lines are skipped.
+type ndjsonReader struct {
+	in      *bufio.Scanner
+	line    int
+	headers []string
+	seen    map[string]bool
+}
+
+func newNDJSONReader(in io.Reader) (port.RecordReader, error) {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxNDJSONLineBytes)
+	return &ndjsonReader{in: scanner, seen: map[string]bool{}}, nil
+}
+
+func (r *ndjsonReader) Headers() []string {
+	return r.headers
+}
+
+func (r *ndjsonReader) Next() (port.ImportRecord, error) {
+	for r.in.Scan() {
+		r.line++
+		text := bytes.TrimSpace(r.in.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+		values, err := r.parseObject(text)
+		if err != nil {
+			return port.ImportRecord{Line: r.line, Err: err}, nil
+		}
+		return port.ImportRecord{Line: r.line, Values: values}, nil
+	}
+	if err := r.in.Err(); err != nil {
+		return port.ImportRecord{}, err
+	}
+	return port.ImportRecord{}, io.EOF
+}
+
+func (r *ndjsonReader) parseObject(text []byte) (map[string]model.Value, error) {
+	decoder := json.NewDecoder(bytes.NewReader(text))
+	decoder.UseNumber()
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return nil, errNotJSONObject
+	}
+	values := map[string]model.Value{}
+	keys := []string{}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		key := token.(string)
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			return nil, err
+		}
+		value, err := jsonValue(raw)
+		if err != nil {
+			return nil, err
+		}
+		values[key] = value
+		keys = append(keys, key)
+	}
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("unexpected data after JSON object")
+	}
+	for _, key := range keys {
+		if !r.seen[key] {
+			r.seen[key] = true
+			r.headers = append(r.headers, key)
+		}
+	}
+	return values, nil
+}
+
+func jsonValue(raw json.RawMessage) (model.Value, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var decoded any
+	if err := decoder.Decode(&decoded); err != nil {
+		return model.Value{}, err
+	}
+	switch typed := decoded.(type) {
+	case nil:
+		return model.Value{IsNull: true}, nil
+	case string:
+		return model.Value{Text: typed}, nil
+	case json.Number:
+		return model.Value{Text: typed.String()}, nil
+	case bool:
+		if typed {
+			return model.Value{Text: "true"}, nil
+		}
+		return model.Value{Text: "false"}, nil
+	default:
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, raw); err != nil {
+			return model.Value{}, err
+		}
+		return model.Value{Text: compact.String()}, nil
+	}
+}
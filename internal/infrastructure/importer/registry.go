@@ -0,0 +1,51 @@
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/mgierok/dbc/internal/application/port"
+)
+
+var ErrUnknownFormat = errors.New("unknown import format")
+
+// Constructor creates a record reader that parses one format from in.
+type Constructor func(in io.Reader) (port.RecordReader, error)
+
+// Registry maps import format names to their readers. NewRegistry registers
+// the built-in formats; Register adds or replaces one.
+type Registry struct {
+	constructors map[string]Constructor
+}
+
+func NewRegistry() *Registry {
+	registry := &Registry{constructors: map[string]Constructor{}}
+	registry.Register("csv", newCSVReader)
+	registry.Register("tsv", newTSVReader)
+	registry.Register("ndjson", newNDJSONReader)
+	return registry
+}
+
+func (r *Registry) Register(format string, constructor Constructor) {
+	r.constructors[format] = constructor
+}
+
+// Formats returns the registered format names in alphabetical order.
+func (r *Registry) Formats() []string {
+	formats := make([]string, 0, len(r.constructors))
+	for format := range r.constructors {
+		formats = append(formats, format)
+	}
+	sort.Strings(formats)
+	return formats
+}
+
+func (r *Registry) NewRecordReader(format string, in io.Reader) (port.RecordReader, error) {
+	constructor, ok := r.constructors[format]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+	return constructor(in)
+}
@@ -13,13 +13,25 @@ type RuntimeRunDeps struct {
 	ListTables             *usecase.ListTables
 	GetSchema              *usecase.GetSchema
 	ListRecords            *usecase.ListRecords
+	CountRecords           *usecase.CountRecords
 	ListOperators          *usecase.ListOperators
 	SaveChanges            *usecase.SaveTableChanges
+	PreviewChanges         *usecase.PreviewDatabaseChanges
+	ExportChanges          *usecase.ExportDatabaseChanges
+	ExportRecords          *usecase.ExportRecords
+	ImportRecords          *usecase.ImportRecords
+	YankRecords            *usecase.YankRecords
+	ReadRecord             *usecase.ReadRecord
+	RunSQL                 *usecase.RunSQL
+	History                *usecase.RuntimeHistory
+	SavedViews             *usecase.SavedViews
 	SaveWorkflow           *usecase.RuntimeSaveWorkflow
 	RecordLimitPolicy      *usecase.RuntimeRecordLimitPolicy
 	NavigationWorkflow     *usecase.RuntimeNavigationWorkflow
 	DatabaseTargetResolver *usecase.RuntimeDatabaseTargetResolver
 	Translator             *usecase.StagedChangesTranslator
+	ReadOnly               bool
+	ConfirmSave            bool
 	DatabaseSelector       *RuntimeDatabaseSelectorDeps
 	Close                  func()
 }
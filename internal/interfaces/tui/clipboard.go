@@ -0,0 +1,37 @@
+package tui
+
+import (
+	"io"
+	"os"
+	"strings"
+
+	"github.com/aymanbagabas/go-osc52/v2"
+)
+
+type clipboardWriter interface {
+	Copy(text string) error
+}
+
+// osc52Clipboard copies text through the terminal with an OSC 52 escape
+// sequence, so copies reach the local clipboard over SSH too. The sequence
+// goes to stderr to stay clear of the renderer, and is wrapped for tmux and
+// screen, which would swallow it otherwise.
+type osc52Clipboard struct {
+	out io.Writer
+}
+
+func newOSC52Clipboard() osc52Clipboard {
+	return osc52Clipboard{out: os.Stderr}
+}
+
+func (c osc52Clipboard) Copy(text string) error {
+	sequence := osc52.New(text)
+	switch {
+	case os.Getenv("TMUX") != "":
+		sequence = sequence.Tmux()
+	case strings.HasPrefix(os.Getenv("TERM"), "screen"):
+		sequence = sequence.Screen()
+	}
+	_, err := sequence.WriteTo(c.out)
+	return err
+}
@@ -0,0 +1,46 @@
+package tui
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+func TestOSC52Clipboard_CopyWritesEncodedTextSequence(t *testing.T) {
+	// Arrange
+	t.Setenv("TMUX", "")
+	t.Setenv("TERM", "xterm-256color")
+	var out bytes.Buffer
+	clipboard := osc52Clipboard{out: &out}
+
+	// Act
+	err := clipboard.Copy("id\tnote")
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	expected := "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte("id\tnote")) + "\x07"
+	if out.String() != expected {
+		t.Fatalf("expected sequence %q, got %q", expected, out.String())
+	}
+}
+
+func TestOSC52Clipboard_CopyWrapsSequenceForTmux(t *testing.T) {
+	// Arrange
+	t.Setenv("TMUX", "/tmp/tmux-1000/default,1,0")
+	var out bytes.Buffer
+	clipboard := osc52Clipboard{out: &out}
+
+	// Act
+	err := clipboard.Copy("note")
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !strings.HasPrefix(out.String(), "\x1bPtmux;") {
+		t.Fatalf("expected tmux passthrough, got %q", out.String())
+	}
+}
@@ -5,6 +5,7 @@ const (
 	IconInsert       = "✚"
 	IconEdit         = "✱"
 	IconDelete       = "✖"
+	IconAdjust       = "⚠"
 	IconSortAsc      = "↑"
 	IconSortDesc     = "↓"
 	IconConfigSource = "⚙"
@@ -20,8 +20,11 @@ const (
 	KeyRuntimeEsc              KeyBindingID = "runtime.esc"
 	KeyRuntimeFilter           KeyBindingID = "runtime.filter"
 	KeyRuntimeSort             KeyBindingID = "runtime.sort"
+	KeyRuntimeSavedViews       KeyBindingID = "runtime.saved_views"
 	KeyRuntimeRecordDetail     KeyBindingID = "runtime.record_detail"
 	KeyRuntimeInsert           KeyBindingID = "runtime.insert"
+	KeyRuntimeDuplicate        KeyBindingID = "runtime.duplicate"
+	KeyRuntimeVisualLine       KeyBindingID = "runtime.visual_line"
 	KeyRuntimeDelete           KeyBindingID = "runtime.delete"
 	KeyRuntimeUndo             KeyBindingID = "runtime.undo"
 	KeyRuntimeRedo             KeyBindingID = "runtime.redo"
@@ -32,6 +35,10 @@ const (
 	KeyRuntimeMoveRight        KeyBindingID = "runtime.move_right"
 	KeyRuntimePageDown         KeyBindingID = "runtime.page_down"
 	KeyRuntimePageUp           KeyBindingID = "runtime.page_up"
+	KeyRuntimeYankPending      KeyBindingID = "runtime.yank_pending"
+	KeyRuntimeYankRow          KeyBindingID = "runtime.yank_row"
+	KeyRuntimeYankCell         KeyBindingID = "runtime.yank_cell"
+	KeyRuntimeYankPage         KeyBindingID = "runtime.yank_page"
 
 	KeyPopupMoveDown   KeyBindingID = "popup.move_down"
 	KeyPopupMoveUp     KeyBindingID = "popup.move_up"
@@ -43,6 +50,28 @@ const (
 	KeyInputBackspace KeyBindingID = "input.backspace"
 	KeyEditSetNull    KeyBindingID = "edit.set_null"
 
+	KeySQLConsoleRun         KeyBindingID = "sql_console.run"
+	KeySQLConsoleSwitchFocus KeyBindingID = "sql_console.switch_focus"
+	KeySQLConsoleNewline     KeyBindingID = "sql_console.newline"
+	KeySQLConsoleMoveUp      KeyBindingID = "sql_console.move_up"
+	KeySQLConsoleMoveDown    KeyBindingID = "sql_console.move_down"
+
+	KeyHistoryPrevious KeyBindingID = "history.previous"
+	KeyHistoryNext     KeyBindingID = "history.next"
+	KeyHistorySearch   KeyBindingID = "history.search"
+
+	KeyFilterAddCondition    KeyBindingID = "filter.add_condition"
+	KeyFilterDeleteCondition KeyBindingID = "filter.delete_condition"
+	KeyFilterToggleLogic     KeyBindingID = "filter.toggle_logic"
+
+	KeySortAddKey        KeyBindingID = "sort.add_key"
+	KeySortDeleteKey     KeyBindingID = "sort.delete_key"
+	KeySortRaisePriority KeyBindingID = "sort.raise_priority"
+	KeySortLowerPriority KeyBindingID = "sort.lower_priority"
+
+	KeyImportPreviousHeader KeyBindingID = "import.previous_header"
+	KeyImportNextHeader     KeyBindingID = "import.next_header"
+
 	KeyConfirmCancel KeyBindingID = "confirm.cancel"
 	KeyConfirmAccept KeyBindingID = "confirm.accept"
 
@@ -77,8 +106,11 @@ var keyBindings = map[KeyBindingID]keyBinding{
 	KeyRuntimeEsc:              {keys: []string{"esc"}, label: "Esc"},
 	KeyRuntimeFilter:           {keys: []string{"F"}, label: "Shift+F"},
 	KeyRuntimeSort:             {keys: []string{"S"}, label: "Shift+S"},
+	KeyRuntimeSavedViews:       {keys: []string{"P"}, label: "Shift+P"},
 	KeyRuntimeRecordDetail:     {keys: []string{"enter"}, label: "Enter"},
 	KeyRuntimeInsert:           {keys: []string{"i"}, label: "i"},
+	KeyRuntimeDuplicate:        {keys: []string{"I"}, label: "Shift+I"},
+	KeyRuntimeVisualLine:       {keys: []string{"V"}, label: "Shift+V"},
 	KeyRuntimeDelete:           {keys: []string{"d"}, label: "d"},
 	KeyRuntimeUndo:             {keys: []string{"u"}, label: "u"},
 	KeyRuntimeRedo:             {keys: []string{"ctrl+r"}, label: "Ctrl+r"},
@@ -89,6 +121,10 @@ var keyBindings = map[KeyBindingID]keyBinding{
 	KeyRuntimeMoveRight:        {keys: []string{"l"}, label: "l"},
 	KeyRuntimePageDown:         {keys: []string{"ctrl+f"}, label: "Ctrl+f"},
 	KeyRuntimePageUp:           {keys: []string{"ctrl+b"}, label: "Ctrl+b"},
+	KeyRuntimeYankPending:      {keys: []string{"y"}, label: "y"},
+	KeyRuntimeYankRow:          {keys: []string{"y"}, label: "yy"},
+	KeyRuntimeYankCell:         {keys: []string{"c"}, label: "yc"},
+	KeyRuntimeYankPage:         {keys: []string{"p"}, label: "yp"},
 
 	KeyPopupMoveDown:   {keys: []string{"j", "down"}, label: "j"},
 	KeyPopupMoveUp:     {keys: []string{"k", "up"}, label: "k"},
@@ -100,6 +136,28 @@ var keyBindings = map[KeyBindingID]keyBinding{
 	KeyInputBackspace: {keys: []string{"backspace"}, label: "backspace"},
 	KeyEditSetNull:    {keys: []string{"ctrl+n"}, label: "Ctrl+n"},
 
+	KeySQLConsoleRun:         {keys: []string{"ctrl+e"}, label: "Ctrl+e"},
+	KeySQLConsoleSwitchFocus: {keys: []string{"tab"}, label: "Tab"},
+	KeySQLConsoleNewline:     {keys: []string{"enter"}, label: "Enter"},
+	KeySQLConsoleMoveUp:      {keys: []string{"up"}, label: "up"},
+	KeySQLConsoleMoveDown:    {keys: []string{"down"}, label: "down"},
+
+	KeyHistoryPrevious: {keys: []string{"up"}, label: "up"},
+	KeyHistoryNext:     {keys: []string{"down"}, label: "down"},
+	KeyHistorySearch:   {keys: []string{"ctrl+r"}, label: "Ctrl+r"},
+
+	KeyFilterAddCondition:    {keys: []string{"a"}, label: "a"},
+	KeyFilterDeleteCondition: {keys: []string{"d"}, label: "d"},
+	KeyFilterToggleLogic:     {keys: []string{"o"}, label: "o"},
+
+	KeySortAddKey:        {keys: []string{"a"}, label: "a"},
+	KeySortDeleteKey:     {keys: []string{"d"}, label: "d"},
+	KeySortRaisePriority: {keys: []string{"K"}, label: "Shift+K"},
+	KeySortLowerPriority: {keys: []string{"J"}, label: "Shift+J"},
+
+	KeyImportPreviousHeader: {keys: []string{"h", "left"}, label: "h"},
+	KeyImportNextHeader:     {keys: []string{"l", "right"}, label: "l"},
+
 	KeyConfirmCancel: {keys: []string{"esc"}, label: "Esc"},
 	KeyConfirmAccept: {keys: []string{"enter"}, label: "Enter"},
 
@@ -19,6 +19,14 @@ const (
 	RuntimeCommandActionSave
 	RuntimeCommandActionSaveAndQuit
 	RuntimeCommandActionSetRecordLimit
+	RuntimeCommandActionPreviewSave
+	RuntimeCommandActionExportChanges
+	RuntimeCommandActionOpenSQLConsole
+	RuntimeCommandActionSaveView
+	RuntimeCommandActionOpenView
+	RuntimeCommandActionExportRecords
+	RuntimeCommandActionSetOption
+	RuntimeCommandActionImportRecords
 )
 
 type runtimeCommandMatcher func(input string, spec RuntimeCommandSpec) (RuntimeCommandSpec, bool, error)
@@ -31,6 +39,11 @@ type RuntimeCommandSpec struct {
 	Force       bool
 	ConnString  string
 	RecordLimit int
+	Path        string
+	ViewName    string
+	Format      string
+	Option      string
+	OptionValue string
 	matcher     runtimeCommandMatcher
 }
 
@@ -66,12 +79,80 @@ var runtimeCommandSpecs = []RuntimeCommandSpec{
 		Description: "Save staged changes immediately and quit on success.",
 		Action:      RuntimeCommandActionSaveAndQuit,
 	},
+	{
+		Aliases:     []string{"preview"},
+		Description: "Preview the SQL a save of staged changes would run.",
+		Action:      RuntimeCommandActionPreviewSave,
+	},
+	{
+		Usage:       ":export <format> <path>",
+		Description: "Write all records of the current table matching filter and sort to a file (csv, json, ndjson, markdown, sql).",
+		Action:      RuntimeCommandActionExportRecords,
+		matcher:     matchExportRecordsCommand,
+	},
+	{
+		Usage:       ":import <path>",
+		Description: "Stage the lines of a CSV, TSV, or NDJSON file as inserts into the current table.",
+		Action:      RuntimeCommandActionImportRecords,
+		matcher:     matchImportRecordsCommand,
+	},
+	{
+		Usage:       ":export-changes <path>",
+		Description: "Write staged changes to a file as a replayable SQL script.",
+		Action:      RuntimeCommandActionExportChanges,
+		matcher:     matchExportChangesCommand,
+	},
+	{
+		Aliases:     []string{"sql"},
+		Description: "Open SQL console to run arbitrary statements.",
+		Action:      RuntimeCommandActionOpenSQLConsole,
+	},
+	{
+		Usage:       ":save-view <name>",
+		Description: "Save the current table filter and sort under a name.",
+		Action:      RuntimeCommandActionSaveView,
+		matcher:     matchSaveViewCommand,
+	},
+	{
+		Usage:       ":view [<name>]",
+		Description: "Apply a saved filter and sort, or pick one from a list.",
+		Action:      RuntimeCommandActionOpenView,
+		matcher:     matchViewCommand,
+	},
 	{
 		Usage:       ":set limit=<n>",
 		Description: "Set records page limit for the current app session.",
 		Action:      RuntimeCommandActionSetRecordLimit,
 		matcher:     matchSetRecordLimitCommand,
 	},
+	{
+		Usage:       ":set exportblob=<base64|hex|omit>",
+		Description: "Set how :export writes BLOB values.",
+		Action:      RuntimeCommandActionSetOption,
+		Option:      "exportblob",
+		matcher:     matchSetOptionCommand,
+	},
+	{
+		Usage:       ":set exportnull=<text>",
+		Description: "Set the text :export writes for NULL in CSV and Markdown.",
+		Action:      RuntimeCommandActionSetOption,
+		Option:      "exportnull",
+		matcher:     matchSetOptionCommand,
+	},
+	{
+		Usage:       ":set importnull=<text>",
+		Description: "Set the text :import reads as NULL in CSV and TSV.",
+		Action:      RuntimeCommandActionSetOption,
+		Option:      "importnull",
+		matcher:     matchSetOptionCommand,
+	},
+	{
+		Usage:       ":set yankformat=<tsv|json|sql>",
+		Description: "Set the format yy and yp copy rows in.",
+		Action:      RuntimeCommandActionSetOption,
+		Option:      "yankformat",
+		matcher:     matchSetOptionCommand,
+	},
 	{
 		Aliases:     []string{"quit", "q"},
 		Description: "Quit the application.",
@@ -149,6 +230,28 @@ func matchSetRecordLimitCommand(input string, spec RuntimeCommandSpec) (RuntimeC
 	return matchedSpec, true, nil
 }
 
+// matchSetOptionCommand matches ":set <option>=<value>" for the option named
+// by spec. The value is kept as typed and may be empty.
+func matchSetOptionCommand(input string, spec RuntimeCommandSpec) (RuntimeCommandSpec, bool, error) {
+	setKeyword, remainder, matched := splitRuntimeCommandKeyword(input)
+	if !matched || !strings.EqualFold(setKeyword, "set") {
+		return RuntimeCommandSpec{}, false, nil
+	}
+
+	remainder = strings.TrimSpace(remainder)
+	name, value, hasValue := strings.Cut(remainder, "=")
+	if !strings.EqualFold(strings.TrimSpace(name), spec.Option) {
+		return RuntimeCommandSpec{}, false, nil
+	}
+	if !hasValue {
+		return RuntimeCommandSpec{}, true, fmt.Errorf("%w: expected %s", errInvalidRuntimeCommand, spec.Usage)
+	}
+
+	matchedSpec := spec
+	matchedSpec.OptionValue = value
+	return matchedSpec, true, nil
+}
+
 func matchEditCommand(input string, spec RuntimeCommandSpec) (RuntimeCommandSpec, bool, error) {
 	editKeyword, remainder, matched := splitRuntimeCommandKeyword(input)
 	if !matched {
@@ -171,6 +274,83 @@ func matchEditCommand(input string, spec RuntimeCommandSpec) (RuntimeCommandSpec
 	return matchedSpec, true, nil
 }
 
+func matchExportRecordsCommand(input string, spec RuntimeCommandSpec) (RuntimeCommandSpec, bool, error) {
+	keyword, remainder, matched := splitRuntimeCommandKeyword(input)
+	if !matched || !strings.EqualFold(keyword, "export") {
+		return RuntimeCommandSpec{}, false, nil
+	}
+
+	format, path, _ := splitRuntimeCommandKeyword(strings.TrimSpace(remainder))
+	path = strings.TrimSpace(path)
+	if format == "" || path == "" {
+		return RuntimeCommandSpec{}, true, fmt.Errorf("%w: expected :export <format> <path>", errInvalidRuntimeCommand)
+	}
+
+	matchedSpec := spec
+	matchedSpec.Format = format
+	matchedSpec.Path = path
+	return matchedSpec, true, nil
+}
+
+func matchImportRecordsCommand(input string, spec RuntimeCommandSpec) (RuntimeCommandSpec, bool, error) {
+	keyword, remainder, matched := splitRuntimeCommandKeyword(input)
+	if !matched || !strings.EqualFold(keyword, "import") {
+		return RuntimeCommandSpec{}, false, nil
+	}
+
+	path := strings.TrimSpace(remainder)
+	if path == "" {
+		return RuntimeCommandSpec{}, true, fmt.Errorf("%w: expected :import <path>", errInvalidRuntimeCommand)
+	}
+
+	matchedSpec := spec
+	matchedSpec.Path = path
+	return matchedSpec, true, nil
+}
+
+func matchExportChangesCommand(input string, spec RuntimeCommandSpec) (RuntimeCommandSpec, bool, error) {
+	keyword, remainder, matched := splitRuntimeCommandKeyword(input)
+	if !matched || !strings.EqualFold(keyword, "export-changes") {
+		return RuntimeCommandSpec{}, false, nil
+	}
+
+	path := strings.TrimSpace(remainder)
+	if path == "" {
+		return RuntimeCommandSpec{}, true, fmt.Errorf("%w: expected :export-changes <path>", errInvalidRuntimeCommand)
+	}
+
+	matchedSpec := spec
+	matchedSpec.Path = path
+	return matchedSpec, true, nil
+}
+
+func matchSaveViewCommand(input string, spec RuntimeCommandSpec) (RuntimeCommandSpec, bool, error) {
+	keyword, remainder, matched := splitRuntimeCommandKeyword(input)
+	if !matched || !strings.EqualFold(keyword, "save-view") {
+		return RuntimeCommandSpec{}, false, nil
+	}
+
+	name := strings.TrimSpace(remainder)
+	if name == "" {
+		return RuntimeCommandSpec{}, true, fmt.Errorf("%w: expected :save-view <name>", errInvalidRuntimeCommand)
+	}
+
+	matchedSpec := spec
+	matchedSpec.ViewName = name
+	return matchedSpec, true, nil
+}
+
+func matchViewCommand(input string, spec RuntimeCommandSpec) (RuntimeCommandSpec, bool, error) {
+	keyword, remainder, matched := splitRuntimeCommandKeyword(input)
+	if !matched || !strings.EqualFold(keyword, "view") {
+		return RuntimeCommandSpec{}, false, nil
+	}
+
+	matchedSpec := spec
+	matchedSpec.ViewName = strings.TrimSpace(remainder)
+	return matchedSpec, true, nil
+}
+
 func splitRuntimeCommandKeyword(input string) (string, string, bool) {
 	keywordEnd := strings.IndexAny(input, " \t")
 	if keywordEnd == -1 {
@@ -50,6 +50,10 @@ var runtimeHelpKeywordSpecs = []runtimeHelpKeywordSpec{
 		bindings:    []KeyBindingID{KeyRuntimeSort},
 		description: "Open sort flow for current table.",
 	},
+	{
+		bindings:    []KeyBindingID{KeyRuntimeSavedViews},
+		description: "Pick a saved filter and sort for current table.",
+	},
 	{
 		bindings:    []KeyBindingID{KeyRuntimeRecordDetail},
 		description: "Open selected record detail view.",
@@ -58,15 +62,28 @@ var runtimeHelpKeywordSpecs = []runtimeHelpKeywordSpec{
 		bindings:    []KeyBindingID{KeyRuntimeInsert},
 		description: "Stage a new insert row.",
 	},
+	{
+		bindings:    []KeyBindingID{KeyRuntimeDuplicate},
+		description: "Stage a copy of the selected row as an insert.",
+	},
 	{
 		bindings:    []KeyBindingID{KeyRuntimeDelete},
 		description: "Toggle delete marker/remove insert.",
 	},
+	{
+		bindings:    []KeyBindingID{KeyRuntimeVisualLine},
+		description: "Select a row range for bulk delete or column edit.",
+	},
 	{
 		bindings:    []KeyBindingID{KeyRuntimeUndo, KeyRuntimeRedo},
 		joinWith:    " / ",
 		description: "Undo or redo staged action.",
 	},
+	{
+		bindings:    []KeyBindingID{KeyRuntimeYankCell, KeyRuntimeYankRow, KeyRuntimeYankPage},
+		joinWith:    " / ",
+		description: "Copy focused cell, row, or page to the clipboard.",
+	},
 	{
 		command:     RuntimeCommandActionSave,
 		description: "Save staged changes.",
@@ -141,6 +158,17 @@ func RuntimeStatusFilterPopupShortcuts() string {
 	)
 }
 
+func RuntimeStatusFilterConditionsShortcuts() string {
+	return joinShortcutSegments(
+		fmt.Sprintf("Filter: %s choose", joinKeyLabels("/", KeyPopupMoveDown, KeyPopupMoveUp)),
+		fmt.Sprintf("%s edit", keyLabel(KeyRuntimeEnter)),
+		fmt.Sprintf("%s add", keyLabel(KeyFilterAddCondition)),
+		fmt.Sprintf("%s remove", keyLabel(KeyFilterDeleteCondition)),
+		fmt.Sprintf("%s toggle AND/OR", keyLabel(KeyFilterToggleLogic)),
+		fmt.Sprintf("%s close", keyLabel(KeyRuntimeEsc)),
+	)
+}
+
 func RuntimeStatusSortPopupShortcuts() string {
 	return joinShortcutSegments(
 		fmt.Sprintf("Popup: %s apply", keyLabel(KeyRuntimeEnter)),
@@ -148,6 +176,17 @@ func RuntimeStatusSortPopupShortcuts() string {
 	)
 }
 
+func RuntimeStatusSortKeysShortcuts() string {
+	return joinShortcutSegments(
+		fmt.Sprintf("Sort: %s choose", joinKeyLabels("/", KeyPopupMoveDown, KeyPopupMoveUp)),
+		fmt.Sprintf("%s edit", keyLabel(KeyRuntimeEnter)),
+		fmt.Sprintf("%s add", keyLabel(KeySortAddKey)),
+		fmt.Sprintf("%s remove", keyLabel(KeySortDeleteKey)),
+		fmt.Sprintf("%s raise/lower priority", joinKeyLabels("/", KeySortRaisePriority, KeySortLowerPriority)),
+		fmt.Sprintf("%s close", keyLabel(KeyRuntimeEsc)),
+	)
+}
+
 func RuntimeStatusHelpPopupShortcuts() string {
 	return joinShortcutSegments(
 		fmt.Sprintf("Help: %s scroll", joinKeyLabels("/", KeyPopupMoveDown, KeyPopupMoveUp)),
@@ -155,9 +194,83 @@ func RuntimeStatusHelpPopupShortcuts() string {
 	)
 }
 
+// RuntimeSavePreviewSummaryLine explains the SQL preview popup controls; a
+// confirming preview starts the save on Enter.
+func RuntimeSavePreviewSummaryLine(statementCount int, confirm bool) string {
+	scroll := fmt.Sprintf("%s, %s scroll", joinKeyLabels("/", KeyPopupMoveDown, KeyPopupMoveUp), joinKeyLabels("/", KeyRuntimePageDown, KeyRuntimePageUp))
+	if confirm {
+		return fmt.Sprintf(
+			"%d statement(s) in one transaction. %s saves, %s cancels; %s.",
+			statementCount,
+			keyLabel(KeyRuntimeEnter),
+			keyLabel(KeyRuntimeEsc),
+			scroll,
+		)
+	}
+	return fmt.Sprintf("%d statement(s) in one transaction. %s; %s closes.", statementCount, scroll, keyLabel(KeyRuntimeEsc))
+}
+
+func RuntimeStatusSavePreviewShortcuts(confirm bool) string {
+	if confirm {
+		return joinShortcutSegments(
+			fmt.Sprintf("Preview: %s scroll", joinKeyLabels("/", KeyPopupMoveDown, KeyPopupMoveUp)),
+			fmt.Sprintf("%s save", keyLabel(KeyRuntimeEnter)),
+			fmt.Sprintf("%s cancel", keyLabel(KeyRuntimeEsc)),
+		)
+	}
+	return joinShortcutSegments(
+		fmt.Sprintf("Preview: %s scroll", joinKeyLabels("/", KeyPopupMoveDown, KeyPopupMoveUp)),
+		fmt.Sprintf("%s close", keyLabel(KeyRuntimeEsc)),
+	)
+}
+
+// RuntimeImportMappingSummaryLine explains the column mapping step of an
+// import.
+func RuntimeImportMappingSummaryLine(lineCount int) string {
+	return fmt.Sprintf(
+		"%d line(s) read. %s choose column, %s change file column; %s stages, %s cancels.",
+		lineCount,
+		joinKeyLabels("/", KeyPopupMoveDown, KeyPopupMoveUp),
+		joinKeyLabels("/", KeyImportPreviousHeader, KeyImportNextHeader),
+		keyLabel(KeyRuntimeEnter),
+		keyLabel(KeyRuntimeEsc),
+	)
+}
+
+// RuntimeImportReportSummaryLine explains the report of the lines an import
+// rejected.
+func RuntimeImportReportSummaryLine(stagedCount, rejectedCount int) string {
+	return fmt.Sprintf(
+		"%d row(s) staged, %d line(s) rejected. %s, %s scroll; %s closes.",
+		stagedCount,
+		rejectedCount,
+		joinKeyLabels("/", KeyPopupMoveDown, KeyPopupMoveUp),
+		joinKeyLabels("/", KeyRuntimePageDown, KeyRuntimePageUp),
+		keyLabel(KeyRuntimeEsc),
+	)
+}
+
+func RuntimeStatusImportMappingShortcuts() string {
+	return joinShortcutSegments(
+		fmt.Sprintf("Import: %s choose column", joinKeyLabels("/", KeyPopupMoveDown, KeyPopupMoveUp)),
+		fmt.Sprintf("%s change file column", joinKeyLabels("/", KeyImportPreviousHeader, KeyImportNextHeader)),
+		fmt.Sprintf("%s stage", keyLabel(KeyRuntimeEnter)),
+		fmt.Sprintf("%s cancel", keyLabel(KeyRuntimeEsc)),
+	)
+}
+
+func RuntimeStatusImportReportShortcuts() string {
+	return joinShortcutSegments(
+		fmt.Sprintf("Import report: %s scroll", joinKeyLabels("/", KeyPopupMoveDown, KeyPopupMoveUp)),
+		fmt.Sprintf("%s close", keyLabel(KeyRuntimeEsc)),
+	)
+}
+
 func RuntimeStatusCommandInputShortcuts() string {
 	return joinShortcutSegments(
 		fmt.Sprintf("Command: %s run", keyLabel(KeyRuntimeEnter)),
+		fmt.Sprintf("%s history", joinKeyLabels("/", KeyHistoryPrevious, KeyHistoryNext)),
+		fmt.Sprintf("%s search history", keyLabel(KeyHistorySearch)),
 		fmt.Sprintf("%s cancel", keyLabel(KeyRuntimeEsc)),
 	)
 }
@@ -186,14 +299,28 @@ func RuntimeStatusRecordsShortcuts() string {
 		fmt.Sprintf("%s edit", keyLabel(KeyRuntimeEdit)),
 		fmt.Sprintf("%s detail", keyLabel(KeyRuntimeRecordDetail)),
 		fmt.Sprintf("%s insert", keyLabel(KeyRuntimeInsert)),
+		fmt.Sprintf("%s duplicate", keyLabel(KeyRuntimeDuplicate)),
 		fmt.Sprintf("%s delete", keyLabel(KeyRuntimeDelete)),
+		fmt.Sprintf("%s visual", keyLabel(KeyRuntimeVisualLine)),
 		fmt.Sprintf("%s undo", keyLabel(KeyRuntimeUndo)),
 		fmt.Sprintf("%s redo", keyLabel(KeyRuntimeRedo)),
+		fmt.Sprintf("%s yank", joinKeyLabels("/", KeyRuntimeYankCell, KeyRuntimeYankRow, KeyRuntimeYankPage)),
 		runtimeSaveShortcutSegment(),
 		fmt.Sprintf("%s next page", keyLabel(KeyRuntimePageDown)),
 		fmt.Sprintf("%s prev page", keyLabel(KeyRuntimePageUp)),
 		fmt.Sprintf("%s filter", keyLabel(KeyRuntimeFilter)),
 		fmt.Sprintf("%s sort", keyLabel(KeyRuntimeSort)),
+		fmt.Sprintf("%s views", keyLabel(KeyRuntimeSavedViews)),
+	)
+}
+
+func RuntimeStatusRecordsVisualShortcuts() string {
+	return joinShortcutSegments(
+		fmt.Sprintf("Visual: %s exit", joinKeyLabels("/", KeyRuntimeEsc, KeyRuntimeVisualLine)),
+		fmt.Sprintf("%s extend", joinKeyLabels("/", KeyRuntimeMoveDown, KeyRuntimeMoveUp)),
+		fmt.Sprintf("%s page", joinKeyLabels("/", KeyRuntimePageDown, KeyRuntimePageUp)),
+		fmt.Sprintf("%s set column", keyLabel(KeyRuntimeEdit)),
+		fmt.Sprintf("%s delete", keyLabel(KeyRuntimeDelete)),
 	)
 }
 
@@ -206,6 +333,37 @@ func RuntimeStatusRecordDetailShortcuts() string {
 	)
 }
 
+// RuntimeSQLConsoleHintLine tells how to run a statement before the SQL
+// console has a result to summarize.
+func RuntimeSQLConsoleHintLine() string {
+	return fmt.Sprintf(
+		"%s runs the statement. %s switches to results.",
+		keyLabel(KeySQLConsoleRun),
+		keyLabel(KeySQLConsoleSwitchFocus),
+	)
+}
+
+func RuntimeStatusSQLEditorShortcuts() string {
+	return joinShortcutSegments(
+		fmt.Sprintf("SQL: %s run", keyLabel(KeySQLConsoleRun)),
+		fmt.Sprintf("%s newline", keyLabel(KeySQLConsoleNewline)),
+		fmt.Sprintf("%s history at first/last line", joinKeyLabels("/", KeyHistoryPrevious, KeyHistoryNext)),
+		fmt.Sprintf("%s search history", keyLabel(KeyHistorySearch)),
+		fmt.Sprintf("%s results", keyLabel(KeySQLConsoleSwitchFocus)),
+		fmt.Sprintf("%s close", keyLabel(KeyRuntimeEsc)),
+	)
+}
+
+func RuntimeStatusSQLResultsShortcuts() string {
+	return joinShortcutSegments(
+		fmt.Sprintf("SQL results: %s editor", keyLabel(KeySQLConsoleSwitchFocus)),
+		fmt.Sprintf("%s move", joinKeyLabels("/", KeyRuntimeMoveDown, KeyRuntimeMoveUp)),
+		fmt.Sprintf("%s next page", keyLabel(KeyRuntimePageDown)),
+		fmt.Sprintf("%s prev page", keyLabel(KeyRuntimePageUp)),
+		fmt.Sprintf("%s close", keyLabel(KeyRuntimeEsc)),
+	)
+}
+
 func RuntimeStatusContextHelpHint() string {
 	return keyLabel(KeyRuntimeOpenContextHelp)
 }
@@ -14,6 +14,11 @@ func TestResolveRuntimeCommand_ResolvesAliasesCaseInsensitive(t *testing.T) {
 		force       bool
 		connString  string
 		recordLimit int
+		path        string
+		viewName    string
+		format      string
+		option      string
+		optionValue string
 	}{
 		{name: "help full", input: ":help", action: RuntimeCommandActionOpenHelp},
 		{name: "help alias uppercase", input: ":H", action: RuntimeCommandActionOpenHelp},
@@ -39,6 +44,19 @@ func TestResolveRuntimeCommand_ResolvesAliasesCaseInsensitive(t *testing.T) {
 		{name: "set limit zero stays parser-valid", input: ":set limit=0", action: RuntimeCommandActionSetRecordLimit, recordLimit: 0},
 		{name: "set limit negative stays parser-valid", input: ":set limit=-1", action: RuntimeCommandActionSetRecordLimit, recordLimit: -1},
 		{name: "set limit oversized stays parser-valid", input: ":set limit=100000000", action: RuntimeCommandActionSetRecordLimit, recordLimit: 100000000},
+		{name: "preview", input: ":preview", action: RuntimeCommandActionPreviewSave},
+		{name: "export changes", input: ":export-changes /tmp/fix copy.sql ", action: RuntimeCommandActionExportChanges, path: "/tmp/fix copy.sql"},
+		{name: "sql console", input: ":sql", action: RuntimeCommandActionOpenSQLConsole},
+		{name: "save view", input: ":save-view  open orders ", action: RuntimeCommandActionSaveView, viewName: "open orders"},
+		{name: "apply view", input: ":view open orders", action: RuntimeCommandActionOpenView, viewName: "open orders"},
+		{name: "view picker", input: ":view", action: RuntimeCommandActionOpenView},
+		{name: "export records", input: ":export CSV  /tmp/users copy.csv", action: RuntimeCommandActionExportRecords, format: "CSV", path: "/tmp/users copy.csv"},
+		{name: "set export blob", input: ":set exportblob=hex", action: RuntimeCommandActionSetOption, option: "exportblob", optionValue: "hex"},
+		{name: "set export null keeps text", input: ":SET ExportNull=\\N", action: RuntimeCommandActionSetOption, option: "exportnull", optionValue: `\N`},
+		{name: "set export null empty", input: ":set exportnull=", action: RuntimeCommandActionSetOption, option: "exportnull"},
+		{name: "import records", input: ":import  /tmp/new users.csv ", action: RuntimeCommandActionImportRecords, path: "/tmp/new users.csv"},
+		{name: "set import null", input: ":set importnull=NULL", action: RuntimeCommandActionSetOption, option: "importnull", optionValue: "NULL"},
+		{name: "set yank format", input: ":set yankformat=json", action: RuntimeCommandActionSetOption, option: "yankformat", optionValue: "json"},
 	}
 
 	for _, tc := range tests {
@@ -64,6 +82,21 @@ func TestResolveRuntimeCommand_ResolvesAliasesCaseInsensitive(t *testing.T) {
 			if command.RecordLimit != tc.recordLimit {
 				t.Fatalf("expected record limit %d for %q, got %d", tc.recordLimit, tc.input, command.RecordLimit)
 			}
+			if command.Path != tc.path {
+				t.Fatalf("expected path %q for %q, got %q", tc.path, tc.input, command.Path)
+			}
+			if command.ViewName != tc.viewName {
+				t.Fatalf("expected view name %q for %q, got %q", tc.viewName, tc.input, command.ViewName)
+			}
+			if command.Format != tc.format {
+				t.Fatalf("expected format %q for %q, got %q", tc.format, tc.input, command.Format)
+			}
+			if tc.option != "" && command.Option != tc.option {
+				t.Fatalf("expected option %q for %q, got %q", tc.option, tc.input, command.Option)
+			}
+			if command.OptionValue != tc.optionValue {
+				t.Fatalf("expected option value %q for %q, got %q", tc.optionValue, tc.input, command.OptionValue)
+			}
 		})
 	}
 }
@@ -119,6 +152,65 @@ func TestParseRuntimeCommand_RejectsInvalidSetLimitFormsWithValidationError(t *t
 	}
 }
 
+func TestParseRuntimeCommand_RejectsExportChangesWithoutPath(t *testing.T) {
+	// Arrange
+	input := ":export-changes  "
+
+	// Act
+	_, err := ParseRuntimeCommand(input)
+
+	// Assert
+	if !errors.Is(err, errInvalidRuntimeCommand) {
+		t.Fatalf("expected invalid runtime command error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), ":export-changes <path>") {
+		t.Fatalf("expected syntax hint, got %v", err)
+	}
+}
+
+func TestParseRuntimeCommand_RejectsIncompleteFileAndSetOptionForms(t *testing.T) {
+	tests := []struct {
+		input string
+		hint  string
+	}{
+		{input: ":export", hint: ":export <format> <path>"},
+		{input: ":export csv", hint: ":export <format> <path>"},
+		{input: ":import ", hint: ":import <path>"},
+		{input: ":set exportblob", hint: ":set exportblob=<base64|hex|omit>"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			// Act
+			_, err := ParseRuntimeCommand(tc.input)
+
+			// Assert
+			if !errors.Is(err, errInvalidRuntimeCommand) {
+				t.Fatalf("expected invalid runtime command error for %q, got %v", tc.input, err)
+			}
+			if !strings.Contains(err.Error(), tc.hint) {
+				t.Fatalf("expected syntax hint %q, got %v", tc.hint, err)
+			}
+		})
+	}
+}
+
+func TestParseRuntimeCommand_RejectsSaveViewWithoutName(t *testing.T) {
+	// Arrange
+	input := ":save-view"
+
+	// Act
+	_, err := ParseRuntimeCommand(input)
+
+	// Assert
+	if !errors.Is(err, errInvalidRuntimeCommand) {
+		t.Fatalf("expected invalid runtime command error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), ":save-view <name>") {
+		t.Fatalf("expected syntax hint, got %v", err)
+	}
+}
+
 func TestRuntimeHelpPopupSummaryLine_IsDeterministic(t *testing.T) {
 	// Arrange
 
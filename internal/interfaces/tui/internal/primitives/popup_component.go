@@ -340,3 +340,13 @@ func clampPopupWidth(totalWidth, defaultWidth, minWidth, maxWidth int) int {
 	}
 	return width
 }
+
+// StandardizedPopupContentWidth returns the row text width of a popup, so
+// callers can wrap long rows before rendering them.
+func StandardizedPopupContentWidth(totalWidth int, spec StandardizedPopupSpec) int {
+	width := resolvePopupWidth(totalWidth, spec) - 2 - (popupContentSidePadding * 2)
+	if width < 1 {
+		return 1
+	}
+	return width
+}
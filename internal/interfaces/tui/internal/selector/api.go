@@ -23,9 +23,12 @@ const (
 )
 
 type DatabaseOption struct {
-	Name       string
-	ConnString string
-	Source     DatabaseOptionSource
+	Name        string
+	ConnString  string
+	Source      DatabaseOptionSource
+	ReadOnly    bool
+	ConfirmSave bool
+	Pragmas     dto.ConnectionPragmas
 
 	configIndex int
 	canEdit     bool
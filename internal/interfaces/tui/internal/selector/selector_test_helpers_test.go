@@ -75,7 +75,12 @@ func (f *fakeSelectorManager) LoadState(ctx context.Context, input dto.DatabaseS
 
 	entries := make([]port.ConfigEntry, len(f.entries))
 	for i, entry := range f.entries {
-		entries[i] = port.ConfigEntry{Name: entry.Name, DBPath: entry.Path}
+		entries[i] = port.ConfigEntry{
+			Name:     entry.Name,
+			DBPath:   entry.Path,
+			ReadOnly: entry.ReadOnly,
+			Pragmas:  port.ConnectionPragmas(entry.Pragmas),
+		}
 	}
 
 	store := fakeSelectorManagerConfigStore{
@@ -155,3 +160,11 @@ func (f *fakeSelectorManagerConfigStore) ActivePath(_ context.Context) (string,
 	}
 	return f.activePath, nil
 }
+
+func (f *fakeSelectorManagerConfigStore) ListViews(_ context.Context, _ int) ([]port.ConfigView, error) {
+	return nil, nil
+}
+
+func (f *fakeSelectorManagerConfigStore) SaveView(_ context.Context, _ int, _ port.ConfigView) error {
+	return nil
+}
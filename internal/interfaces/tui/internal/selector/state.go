@@ -32,6 +32,9 @@ type selectorForm struct {
 	activeField  selectorInputField
 	nameValue    string
 	pathValue    string
+	readOnly     bool
+	confirmSave  bool
+	pragmas      dto.ConnectionPragmas
 	errorMessage string
 }
 
@@ -338,6 +341,9 @@ func mapDatabaseSelectorOptions(options []dto.DatabaseSelectorOption) []Database
 			Name:        option.Name,
 			ConnString:  option.ConnString,
 			Source:      source,
+			ReadOnly:    option.ReadOnly,
+			ConfirmSave: option.ConfirmSave,
+			Pragmas:     option.Pragmas,
 			configIndex: option.ConfigIndex,
 			canEdit:     option.CanEdit,
 			canDelete:   option.CanDelete,
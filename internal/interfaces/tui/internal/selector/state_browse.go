@@ -53,6 +53,9 @@ func (m *databaseSelectorModel) openEditForm() {
 		activeField: selectorInputName,
 		nameValue:   selected.Name,
 		pathValue:   selected.ConnString,
+		readOnly:    selected.ReadOnly,
+		confirmSave: selected.ConfirmSave,
+		pragmas:     selected.Pragmas,
 	}
 }
 
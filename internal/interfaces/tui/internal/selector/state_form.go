@@ -65,8 +65,11 @@ func (m *databaseSelectorModel) submitForm() (tea.Model, tea.Cmd) {
 	}
 
 	entry := dto.ConfigDatabase{
-		Name: name,
-		Path: path,
+		Name:        name,
+		Path:        path,
+		ReadOnly:    m.form.readOnly,
+		ConfirmSave: m.form.confirmSave,
+		Pragmas:     m.form.pragmas,
 	}
 
 	var err error
@@ -76,6 +76,33 @@ func TestDatabaseSelector_EditUpdatesEntry(t *testing.T) {
 	}
 }
 
+func TestDatabaseSelector_EditPreservesReadOnlyModeAndPragmas(t *testing.T) {
+	// Arrange
+	pragmas := dto.ConnectionPragmas{BusyTimeout: 5000, ForeignKeys: true}
+	manager := &fakeSelectorManager{
+		entries: []dto.ConfigDatabase{
+			{Name: "prod", Path: "/tmp/prod.sqlite", ReadOnly: true, Pragmas: pragmas},
+		},
+	}
+	model := newTestSelectorModel(t, manager)
+
+	// Act
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	model = typeText(model, "-snapshot")
+	model = sendKey(model, tea.KeyMsg{Type: tea.KeyEnter})
+
+	// Assert
+	if len(manager.updated) != 1 {
+		t.Fatalf("expected one update call, got %d", len(manager.updated))
+	}
+	if !manager.updated[0].entry.ReadOnly || manager.updated[0].entry.Pragmas != pragmas {
+		t.Fatalf("expected edit to keep read-only mode and pragmas, got %#v", manager.updated[0].entry)
+	}
+	if !model.options[0].ReadOnly {
+		t.Fatal("expected refreshed option to stay read-only")
+	}
+}
+
 func TestDatabaseSelector_FormSubmissionKeepsFormOpenOnConnectionValidationError(t *testing.T) {
 	for _, tc := range []struct {
 		name             string
@@ -31,6 +31,7 @@ const (
 	filterSelectColumn filterStep = iota
 	filterSelectOperator
 	filterInputValue
+	filterSelectCondition
 )
 
 type sortStep int
@@ -38,16 +39,22 @@ type sortStep int
 const (
 	sortSelectColumn sortStep = iota
 	sortSelectDirection
+	sortSelectNulls
+	sortSelectCollation
+	sortSelectKey
 )
 
 type filterPopup struct {
-	active        bool
-	step          filterStep
-	columnIndex   int
-	operatorIndex int
-	input         string
-	operators     []dto.Operator
-	cursor        int
+	active         bool
+	step           filterStep
+	columnIndex    int
+	operatorIndex  int
+	input          string
+	values         []string
+	operators      []dto.Operator
+	cursor         int
+	conditionIndex int
+	editing        bool
 }
 
 type sortPopup struct {
@@ -55,6 +62,10 @@ type sortPopup struct {
 	step           sortStep
 	columnIndex    int
 	directionIndex int
+	nullsIndex     int
+	collationIndex int
+	keyIndex       int
+	editing        bool
 }
 
 type commandInput struct {
@@ -63,6 +74,8 @@ type commandInput struct {
 	value         string
 	cursor        int
 	pendingStatus string
+	recall        historyRecall
+	search        historySearch
 }
 
 type commandInputMode int
@@ -78,6 +91,37 @@ type helpPopup struct {
 	context      helpPopupContext
 }
 
+// savePreviewPopup lists the statements a save would execute. A preview
+// opened by a save waits for confirmation before the save starts.
+type savePreviewPopup struct {
+	active       bool
+	scrollOffset int
+	statements   []dto.WriteStatement
+	confirm      bool
+	intent       usecase.RuntimeSaveIntent
+}
+
+type importStep int
+
+const (
+	importStepMapping importStep = iota
+	importStepReport
+)
+
+// importPopup maps the headers of a read import file to the columns of the
+// table it is staged into, then reports the lines that were rejected.
+type importPopup struct {
+	active       bool
+	step         importStep
+	table        string
+	file         dto.ImportFile
+	mapping      []string
+	columnIndex  int
+	scrollOffset int
+	stagedCount  int
+	errors       []dto.ImportLineError
+}
+
 type helpPopupContext int
 
 const (
@@ -92,6 +136,9 @@ const (
 	helpPopupContextConfirmPopup
 	helpPopupContextCommandInput
 	helpPopupContextHelpPopup
+	helpPopupContextSavePreview
+	helpPopupContextSQLConsole
+	helpPopupContextImportPopup
 )
 
 type recordDetailState struct {
@@ -99,6 +146,36 @@ type recordDetailState struct {
 	scrollOffset int
 }
 
+type sqlConsoleFocus int
+
+const (
+	sqlConsoleFocusEditor sqlConsoleFocus = iota
+	sqlConsoleFocusResults
+)
+
+// sqlConsoleState keeps the SQL console editor and its last result. The
+// editor text and result survive closing the console so it reopens where the
+// user left off.
+type sqlConsoleState struct {
+	active      bool
+	focus       sqlConsoleFocus
+	input       string
+	cursor      int
+	running     bool
+	requestID   int
+	statement   string
+	result      dto.SQLResult
+	hasResult   bool
+	pageIndex   int
+	selection   int
+	dataChanged bool
+	// schemaChanged marks a statement that may have changed the schema, such
+	// as DDL, so the table list is reloaded along with the data.
+	schemaChanged bool
+	recall        historyRecall
+	search        historySearch
+}
+
 type editPopup struct {
 	active       bool
 	rowIndex     int
@@ -108,6 +185,9 @@ type editPopup struct {
 	optionIndex  int
 	isNull       bool
 	errorMessage string
+	// bulkTargets holds the rows of a visual selection the value is set for;
+	// it is empty when the popup edits a single cell.
+	bulkTargets []dto.BulkEditTarget
 }
 
 type confirmOption struct {
@@ -119,6 +199,7 @@ type confirmPopup struct {
 	active   bool
 	title    string
 	message  string
+	details  []string
 	options  []confirmOption
 	selected int
 	modal    bool
@@ -151,8 +232,19 @@ type Model struct {
 	listTables                  listTablesUseCase
 	getSchema                   getSchemaUseCase
 	listRecords                 listRecordsUseCase
+	countRecords                countRecordsUseCase
 	listOperators               listOperatorsUseCase
 	saveChanges                 saveChangesUseCase
+	previewChanges              previewChangesUseCase
+	exportChanges               exportChangesUseCase
+	exportRecords               exportRecordsUseCase
+	importRecords               importRecordsUseCase
+	yankRecords                 yankRecordsUseCase
+	readRecord                  readRecordUseCase
+	clipboard                   clipboardWriter
+	runSQL                      runSQLUseCase
+	history                     historyUseCase
+	savedViews                  savedViewsUseCase
 	saveWorkflow                *usecase.RuntimeSaveWorkflow
 	recordLimitPolicy           *usecase.RuntimeRecordLimitPolicy
 	navigationWorkflow          *usecase.RuntimeNavigationWorkflow
@@ -160,11 +252,13 @@ type Model struct {
 	translator                  *usecase.StagedChangesTranslator
 	recordAccessResolver        *usecase.PersistedRecordAccessResolver
 	stagingPolicy               *usecase.StagingPolicy
-	stagingSession              *usecase.StagingSession
+	stagingWorkspace            *usecase.StagingWorkspace
 	stagingSnapshot             dto.StagingSnapshot
 	runtimeSession              *RuntimeSessionState
 	runtimeDatabaseSelectorDeps *RuntimeDatabaseSelectorDeps
 	runtimeClose                func()
+	readOnly                    bool
+	confirmSave                 bool
 	styles                      primitives.RenderStyles
 	exitResult                  RuntimeExitResult
 
@@ -187,7 +281,13 @@ type getSchemaUseCase interface {
 }
 
 type listRecordsUseCase interface {
-	Execute(ctx context.Context, tableName string, offset, limit int, filter *dto.Filter, sort *dto.Sort) (dto.RecordPage, error)
+	Execute(ctx context.Context, tableName string, offset, limit int, filter *dto.FilterGroup, sorts []dto.Sort) (dto.RecordPage, error)
+	ExecuteFromCursor(ctx context.Context, tableName, cursor string, limit int, filter *dto.FilterGroup, sorts []dto.Sort) (dto.RecordPage, error)
+}
+
+type countRecordsUseCase interface {
+	Execute(ctx context.Context, tableName string, filter *dto.FilterGroup) (int, error)
+	Estimate(ctx context.Context, tableName string, filter *dto.FilterGroup) (int, bool, error)
 }
 
 type listOperatorsUseCase interface {
@@ -195,7 +295,50 @@ type listOperatorsUseCase interface {
 }
 
 type saveChangesUseCase interface {
-	ExecuteDTO(ctx context.Context, tableName string, changes dto.TableChanges) (int, error)
+	ExecuteAllDTO(ctx context.Context, changeSets []dto.TableChangeSet) (int, error)
+}
+
+type previewChangesUseCase interface {
+	ExecuteDTO(ctx context.Context, changeSets []dto.TableChangeSet) ([]dto.WriteStatement, error)
+}
+
+type exportChangesUseCase interface {
+	ExecuteDTO(ctx context.Context, path string, changeSets []dto.TableChangeSet) (int, error)
+}
+
+type exportRecordsUseCase interface {
+	Execute(ctx context.Context, export dto.RecordExport) (int, error)
+}
+
+type importRecordsUseCase interface {
+	Read(ctx context.Context, path string) (dto.ImportFile, error)
+	MatchColumns(schema dto.Schema, headers []string) []string
+	BuildInserts(schema dto.Schema, file dto.ImportFile, mapping []string, nullText string) (dto.ImportResult, error)
+}
+
+type readRecordUseCase interface {
+	Execute(ctx context.Context, tableName string, identity dto.RecordIdentity) ([]dto.StagedValue, error)
+}
+
+type yankRecordsUseCase interface {
+	Cell(ctx context.Context, table string, columns []dto.SchemaColumn, row dto.YankRow, column int) (string, error)
+	Rows(ctx context.Context, yank dto.RecordYank) (string, error)
+}
+
+type runSQLUseCase interface {
+	Execute(ctx context.Context, statement string, limit int) (dto.SQLResult, error)
+	ExecutePage(ctx context.Context, statement string, offset, limit int) (dto.SQLResult, error)
+}
+
+type savedViewsUseCase interface {
+	List(ctx context.Context, table string) ([]string, error)
+	Save(ctx context.Context, view dto.SavedView) error
+	Load(ctx context.Context, table, name string) (dto.SavedView, error)
+}
+
+type historyUseCase interface {
+	Load(ctx context.Context, kind dto.HistoryKind) ([]string, error)
+	Record(ctx context.Context, kind dto.HistoryKind, entry string) ([]string, error)
 }
 
 func NewModel(ctx context.Context, runtimeDeps RuntimeRunDeps, runtimeSession *RuntimeSessionState) *Model {
@@ -209,6 +352,7 @@ func NewModel(ctx context.Context, runtimeDeps RuntimeRunDeps, runtimeSession *R
 		ctx:            ctx,
 		runtimeSession: runtimeSession,
 		styles:         detectRenderStyles(),
+		clipboard:      newOSC52Clipboard(),
 		exitResult:     runtimeExitResultQuit(),
 		read: runtimeReadState{
 			focus:            FocusTables,
@@ -222,5 +366,8 @@ func NewModel(ctx context.Context, runtimeDeps RuntimeRunDeps, runtimeSession *R
 }
 
 func (m *Model) Init() tea.Cmd {
-	return loadTablesCmd(m.runtimeReadContext(), m.listTables, m.runtimeBundleToken)
+	return tea.Batch(
+		loadTablesCmd(m.runtimeReadContext(), m.listTables, m.runtimeBundleToken),
+		m.loadHistoryCmds(),
+	)
 }
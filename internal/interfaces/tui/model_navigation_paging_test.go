@@ -59,7 +59,7 @@ func TestHandleKey_CtrlFInRecordsLoadsNextPage(t *testing.T) {
 			Rows: []dto.RecordRow{
 				{Values: []string{"21", "alice"}},
 			},
-			TotalCount: 45,
+			HasMore: true,
 		},
 	}
 	model := &Model{
@@ -105,7 +105,7 @@ func TestHandleKey_CtrlBInRecordsLoadsPreviousPage(t *testing.T) {
 			Rows: []dto.RecordRow{
 				{Values: []string{"1", "alice"}},
 			},
-			TotalCount: 45,
+			HasMore: true,
 		},
 	}
 	model := &Model{
@@ -186,3 +186,83 @@ func TestHandleKey_CtrlFDoesNotGoBeyondLastPage(t *testing.T) {
 		t.Fatalf("expected to stay on last page, got %d", model.read.recordPageIndex)
 	}
 }
+
+func TestHandleKey_CtrlFSeeksFromNextCursorAndStoresPageCursors(t *testing.T) {
+	// Arrange
+	recordsSpy := &spyListRecordsUseCase{
+		page: dto.RecordPage{
+			Rows: []dto.RecordRow{
+				{Values: []string{"21", "alice"}},
+			},
+			HasMore:    true,
+			NextCursor: "after-21",
+			PrevCursor: "before-21",
+		},
+	}
+	model := &Model{
+		ctx:         context.Background(),
+		listRecords: recordsSpy,
+		read: runtimeReadState{
+			viewMode:         ViewRecords,
+			focus:            FocusContent,
+			tables:           []dto.Table{{Name: "users"}},
+			recordPageIndex:  0,
+			recordTotalPages: 3,
+			recordTotalCount: 45,
+			recordNextCursor: "after-20",
+		},
+	}
+
+	// Act
+	_, cmd := model.handleKey(tea.KeyMsg{Type: tea.KeyCtrlF})
+	if cmd == nil {
+		t.Fatal("expected command to load next page")
+	}
+	model.Update(cmd())
+
+	// Assert
+	if recordsSpy.lastCursor != "after-20" {
+		t.Fatalf("expected next page to seek from cursor %q, got %q", "after-20", recordsSpy.lastCursor)
+	}
+	if model.read.recordPageIndex != 1 {
+		t.Fatalf("expected current page index 1, got %d", model.read.recordPageIndex)
+	}
+	if model.read.recordNextCursor != "after-21" || model.read.recordPrevCursor != "before-21" {
+		t.Fatalf("expected page cursors to be stored, got next=%q prev=%q", model.read.recordNextCursor, model.read.recordPrevCursor)
+	}
+}
+
+func TestHandleKey_CtrlBSeeksFromPrevCursorBeyondSecondPage(t *testing.T) {
+	// Arrange
+	recordsSpy := &spyListRecordsUseCase{
+		page: dto.RecordPage{HasMore: true},
+	}
+	model := &Model{
+		ctx:         context.Background(),
+		listRecords: recordsSpy,
+		read: runtimeReadState{
+			viewMode:         ViewRecords,
+			focus:            FocusContent,
+			tables:           []dto.Table{{Name: "users"}},
+			recordPageIndex:  2,
+			recordTotalPages: 3,
+			recordTotalCount: 45,
+			recordPrevCursor: "before-41",
+		},
+	}
+
+	// Act
+	_, cmd := model.handleKey(tea.KeyMsg{Type: tea.KeyCtrlB})
+	if cmd == nil {
+		t.Fatal("expected command to load previous page")
+	}
+	model.Update(cmd())
+
+	// Assert
+	if recordsSpy.lastCursor != "before-41" {
+		t.Fatalf("expected previous page to seek from cursor %q, got %q", "before-41", recordsSpy.lastCursor)
+	}
+	if model.read.recordPageIndex != 1 {
+		t.Fatalf("expected current page index 1, got %d", model.read.recordPageIndex)
+	}
+}
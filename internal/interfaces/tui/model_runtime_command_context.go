@@ -9,10 +9,16 @@ func (m *Model) nonBlockingRuntimeCommandContextActive() bool {
 	switch {
 	case m.ui.saveInFlight:
 		return false
+	case m.ui.savePreviewInFlight:
+		return false
 	case m.ui.runtimeSwitchInFlight:
 		return false
 	case m.overlay.helpPopup.active:
 		return false
+	case m.overlay.savePreview.active:
+		return false
+	case m.overlay.importPopup.active:
+		return false
 	case m.overlay.filterPopup.active:
 		return false
 	case m.overlay.sortPopup.active:
@@ -21,6 +27,8 @@ func (m *Model) nonBlockingRuntimeCommandContextActive() bool {
 		return false
 	case m.overlay.confirmPopup.active:
 		return false
+	case m.overlay.sqlConsole.active:
+		return true
 	case m.overlay.recordDetail.active:
 		return true
 	case m.read.focus == FocusTables:
@@ -37,14 +37,26 @@ func (m *Model) handleConfirmPopupKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	case primitives.KeyMatches(primitives.KeyConfirmCancel, key):
 		m.closeConfirmPopup()
+		m.overlay.pendingViewPicker = false
+		if m.overlay.pendingSaveConflict != nil {
+			return m.resolveSaveConflict(saveConflictDecisionCancel)
+		}
 		return m, nil
 	case primitives.KeyMatches(primitives.KeyConfirmAccept, key):
 		if len(m.overlay.confirmPopup.options) == 0 {
 			m.closeConfirmPopup()
+			m.overlay.pendingSaveConflict = nil
 			return m, nil
 		}
 		decisionID := m.overlay.confirmPopup.options[clamp(m.overlay.confirmPopup.selected, 0, len(m.overlay.confirmPopup.options)-1)].decisionID
 		m.closeConfirmPopup()
+		if m.overlay.pendingSaveConflict != nil {
+			return m.resolveSaveConflict(decisionID)
+		}
+		if m.overlay.pendingViewPicker {
+			m.overlay.pendingViewPicker = false
+			return m.requestOpenView(decisionID)
+		}
 		pending := m.ui.pendingNavigation
 		m.ui.pendingNavigation = nil
 		pendingCommandInput := m.ui.pendingCommandInput
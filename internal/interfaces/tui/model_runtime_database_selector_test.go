@@ -49,6 +49,14 @@ func (f *fakeRuntimeSelectorConfigStore) ActivePath(ctx context.Context) (string
 	return "/tmp/config.json", nil
 }
 
+func (f *fakeRuntimeSelectorConfigStore) ListViews(_ context.Context, _ int) ([]port.ConfigView, error) {
+	return nil, nil
+}
+
+func (f *fakeRuntimeSelectorConfigStore) SaveView(_ context.Context, _ int, _ port.ConfigView) error {
+	return nil
+}
+
 type fakeRuntimeSelectorConnectionChecker struct{}
 
 func (fakeRuntimeSelectorConnectionChecker) CanConnect(ctx context.Context, dbPath string) error {
@@ -117,15 +125,15 @@ func TestHandleKey_RuntimeDatabaseSelectorEscClosesPopupAndPreservesRuntimeState
 			recordSelection:  3,
 			recordColumn:     1,
 			recordFieldFocus: true,
-			currentFilter: &dto.Filter{
+			currentFilter: singleConditionFilter(dto.Filter{
 				Column:   "name",
 				Operator: dto.Operator{Name: "Equals", Kind: dto.OperatorKindEq, RequiresValue: true},
 				Value:    "alice",
-			},
-			currentSort: &dto.Sort{
+			}),
+			currentSort: []dto.Sort{{
 				Column:    "id",
 				Direction: dto.SortDirectionDesc,
-			},
+			}},
 		},
 		runtimeDatabaseSelectorDeps: runtimeDatabaseSelectorDepsForTest(current),
 	}
@@ -421,15 +429,15 @@ func TestHandleKey_RuntimeDatabaseSelectorSelectionDoesNotResetCurrentRuntimeSta
 			recordSelection:  3,
 			recordColumn:     1,
 			recordFieldFocus: true,
-			currentFilter: &dto.Filter{
+			currentFilter: singleConditionFilter(dto.Filter{
 				Column:   "name",
 				Operator: dto.Operator{Name: "Equals", Kind: dto.OperatorKindEq, RequiresValue: true},
 				Value:    "alice",
-			},
-			currentSort: &dto.Sort{
+			}),
+			currentSort: []dto.Sort{{
 				Column:    "id",
 				Direction: dto.SortDirectionDesc,
-			},
+			}},
 		},
 		runtimeDatabaseSelectorDeps: runtimeDatabaseSelectorDepsForTest(current, switched),
 	}
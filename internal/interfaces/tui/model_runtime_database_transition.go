@@ -21,9 +21,6 @@ func (m *Model) executeRuntimeNavigationNextAction(action usecase.RuntimeNavigat
 			m.ui.statusMessage = fmt.Sprintf("Error: target table %q is no longer available", action.TargetTableName)
 			return m, nil
 		}
-		if action.ClearDirtyState {
-			m.clearStagedState()
-		}
 		m.read.selectedTable = targetIndex
 		m.resetTableContext()
 		return m, m.loadViewForSelection()
@@ -88,9 +85,12 @@ func (m *Model) configuredRuntimeDatabaseOptions() ([]usecase.RuntimeDatabaseOpt
 	options := make([]usecase.RuntimeDatabaseOption, len(entries))
 	for i, entry := range entries {
 		options[i] = usecase.RuntimeDatabaseOption{
-			Name:       entry.Name,
-			ConnString: entry.Path,
-			Source:     usecase.RuntimeDatabaseOptionSourceConfig,
+			Name:        entry.Name,
+			ConnString:  entry.Path,
+			Source:      usecase.RuntimeDatabaseOptionSourceConfig,
+			ReadOnly:    entry.ReadOnly,
+			ConfirmSave: entry.ConfirmSave,
+			Pragmas:     entry.Pragmas,
 		}
 	}
 	return options, nil
@@ -102,9 +102,12 @@ func runtimeDatabaseOptionFromSelectorOption(option DatabaseOption) usecase.Runt
 		source = usecase.RuntimeDatabaseOptionSourceCLI
 	}
 	return usecase.RuntimeDatabaseOption{
-		Name:       option.Name,
-		ConnString: option.ConnString,
-		Source:     source,
+		Name:        option.Name,
+		ConnString:  option.ConnString,
+		Source:      source,
+		ReadOnly:    option.ReadOnly,
+		ConfirmSave: option.ConfirmSave,
+		Pragmas:     option.Pragmas,
 	}
 }
 
@@ -122,9 +125,12 @@ func databaseOptionFromRuntimeDatabaseOption(option usecase.RuntimeDatabaseOptio
 		source = DatabaseOptionSourceCLI
 	}
 	return DatabaseOption{
-		Name:       option.Name,
-		ConnString: option.ConnString,
-		Source:     source,
+		Name:        option.Name,
+		ConnString:  option.ConnString,
+		Source:      source,
+		ReadOnly:    option.ReadOnly,
+		ConfirmSave: option.ConfirmSave,
+		Pragmas:     option.Pragmas,
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/usecase"
 	"github.com/mgierok/dbc/internal/interfaces/tui/internal/primitives"
 )
 
@@ -42,7 +43,14 @@ func (m *Model) openEditPopup() (tea.Model, tea.Cmd) {
 	if m.read.recordColumn < 0 || m.read.recordColumn >= len(m.read.schema.Columns) {
 		return m, nil
 	}
+	if !isInsert && !m.ensureCurrentTableWritable(usecase.TableWriteUpdate) {
+		return m, nil
+	}
 	column := m.read.schema.Columns[m.read.recordColumn]
+	if err := m.stagingPolicyUseCase().EnsureColumnWritable(column); err != nil {
+		m.ui.statusMessage = "Error: " + err.Error()
+		return m, nil
+	}
 	currentValue := m.visibleRowValue(m.read.recordSelection, m.read.recordColumn)
 	if isInsert {
 		if value, ok := insert.Values[m.read.recordColumn]; ok {
@@ -61,10 +69,15 @@ func (m *Model) openEditPopup() (tea.Model, tea.Cmd) {
 		}
 	}
 
+	m.overlay.editPopup = newEditPopup(m.read.recordSelection, m.read.recordColumn, column, currentValue)
+	return m, nil
+}
+
+func newEditPopup(rowIndex, columnIndex int, column dto.SchemaColumn, currentValue string) editPopup {
 	popup := editPopup{
 		active:      true,
-		rowIndex:    m.read.recordSelection,
-		columnIndex: m.read.recordColumn,
+		rowIndex:    rowIndex,
+		columnIndex: columnIndex,
 		input:       currentValue,
 		cursor:      len(currentValue),
 	}
@@ -76,8 +89,7 @@ func (m *Model) openEditPopup() (tea.Model, tea.Cmd) {
 	if column.Input.Kind == dto.ColumnInputSelect {
 		popup.optionIndex = optionIndex(column.Input.Options, currentValue)
 	}
-	m.overlay.editPopup = popup
-	return m, nil
+	return popup
 }
 
 func (m *Model) confirmEditPopup() (tea.Model, tea.Cmd) {
@@ -96,6 +108,9 @@ func (m *Model) confirmEditPopup() (tea.Model, tea.Cmd) {
 		m.overlay.editPopup.errorMessage = err.Error()
 		return m, nil
 	}
+	if len(m.overlay.editPopup.bulkTargets) > 0 {
+		return m.confirmVisualEdit(column, value)
+	}
 	if err := m.stageEdit(m.overlay.editPopup.rowIndex, m.overlay.editPopup.columnIndex, value); err != nil {
 		m.overlay.editPopup.errorMessage = err.Error()
 		return m, nil
@@ -101,7 +101,25 @@ func TestHandleKey_EInFieldFocusBlocksUneditableRows(t *testing.T) {
 					{Name: "name", Type: "TEXT"},
 				},
 			},
-			wantErr: "Error: table has no primary key",
+			wantErr: "Error: table has no primary key or rowid",
+		},
+		{
+			name: "generated column",
+			records: []dto.RecordRow{
+				{
+					Values: []string{"2"},
+					RowKey: "rowid=1",
+					Identity: dto.RecordIdentity{
+						Keys: []dto.RecordIdentityKey{{Column: "rowid", Value: dto.StagedValue{Text: "1", Raw: int64(1)}}},
+					},
+				},
+			},
+			schema: dto.Schema{
+				Columns: []dto.SchemaColumn{
+					{Name: "total", Type: "INTEGER", Generated: true},
+				},
+			},
+			wantErr: "Error: generated column is read-only: total",
 		},
 	}
 
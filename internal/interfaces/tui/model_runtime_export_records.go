@@ -0,0 +1,52 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+)
+
+// requestExportRecords writes every persisted record of the current table
+// matching the active filter and sort to path. Staged changes are not part of
+// the export.
+func (m *Model) requestExportRecords(format, path string) (tea.Model, tea.Cmd) {
+	if !m.nonBlockingRuntimeCommandContextActive() {
+		return m, nil
+	}
+	table := m.currentTableName()
+	if table == "" {
+		m.ui.statusMessage = "Error: no table selected"
+		return m, nil
+	}
+	if m.exportRecords == nil {
+		m.ui.statusMessage = "Error: export use case unavailable"
+		return m, nil
+	}
+	export := dto.RecordExport{
+		Table:  table,
+		Format: format,
+		Path:   path,
+		Sorts:  append([]dto.Sort(nil), m.read.currentSort...),
+	}
+	if m.read.currentFilter != nil {
+		group := cloneFilterGroup(m.read.currentFilter)
+		export.Filter = &group
+	}
+	if m.runtimeSession != nil {
+		export.Blob = m.runtimeSession.ExportBlob
+		export.NullText = m.runtimeSession.ExportNullText
+	}
+	m.ui.statusMessage = fmt.Sprintf("Exporting %s to %s...", table, path)
+	return m, exportRecordsCmd(m.runtimeReadContext(), m.exportRecords, export)
+}
+
+func (m *Model) handleExportRecordsMsg(msg exportRecordsMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		m.ui.statusMessage = "Error: " + msg.err.Error()
+		return m, nil
+	}
+	m.ui.statusMessage = fmt.Sprintf("Exported %d record(s) to %s", msg.count, msg.path)
+	return m, nil
+}
@@ -0,0 +1,89 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+)
+
+func newExportRecordsTestModel(exportRecords *spyExportRecordsUseCase) *Model {
+	return &Model{
+		ctx:           context.Background(),
+		exportRecords: exportRecords,
+		read: runtimeReadState{
+			viewMode: ViewRecords,
+			focus:    FocusContent,
+			tables:   []dto.Table{{Name: "users"}},
+		},
+	}
+}
+
+func TestSubmitCommandInput_ExportUsesCurrentTableFilterSortAndOptions(t *testing.T) {
+	// Arrange
+	exportRecords := &spyExportRecordsUseCase{count: 42}
+	model := newExportRecordsTestModel(exportRecords)
+	model.read.currentFilter = savedViewFilterForTest()
+	model.read.currentSort = []dto.Sort{{Column: "id", Direction: dto.SortDirectionDesc}}
+	submitRuntimeCommandForTest(model, "set exportblob=HEX")
+	submitRuntimeCommandForTest(model, `set exportnull=\N`)
+
+	// Act
+	cmd := submitRuntimeCommandForTest(model, "export csv /tmp/users.csv")
+	if cmd == nil {
+		t.Fatal("expected export command")
+	}
+	model.Update(cmd())
+
+	// Assert
+	export := exportRecords.lastExport
+	if export.Table != "users" || export.Format != "csv" || export.Path != "/tmp/users.csv" {
+		t.Fatalf("unexpected export target %+v", export)
+	}
+	assertFilterEqual(t, export.Filter, savedViewFilterForTest())
+	if !reflect.DeepEqual(export.Sorts, model.read.currentSort) {
+		t.Fatalf("expected current sort, got %+v", export.Sorts)
+	}
+	if export.Blob != "hex" || export.NullText != `\N` {
+		t.Fatalf("expected session export options, got blob=%q null=%q", export.Blob, export.NullText)
+	}
+	if model.ui.statusMessage != "Exported 42 record(s) to /tmp/users.csv" {
+		t.Fatalf("unexpected status %q", model.ui.statusMessage)
+	}
+}
+
+func TestSubmitCommandInput_ExportErrorShowsStatus(t *testing.T) {
+	// Arrange
+	exportRecords := &spyExportRecordsUseCase{err: errors.New("unknown export format: xml")}
+	model := newExportRecordsTestModel(exportRecords)
+
+	// Act
+	cmd := submitRuntimeCommandForTest(model, "export xml /tmp/users.xml")
+	if cmd == nil {
+		t.Fatal("expected export command")
+	}
+	model.Update(cmd())
+
+	// Assert
+	if model.ui.statusMessage != "Error: unknown export format: xml" {
+		t.Fatalf("unexpected status %q", model.ui.statusMessage)
+	}
+}
+
+func TestSubmitCommandInput_SetExportBlobRejectsUnknownEncoding(t *testing.T) {
+	// Arrange
+	model := newExportRecordsTestModel(&spyExportRecordsUseCase{})
+
+	// Act
+	submitRuntimeCommandForTest(model, "set exportblob=raw")
+
+	// Assert
+	if model.runtimeSession != nil && model.runtimeSession.ExportBlob != "" {
+		t.Fatalf("expected encoding to stay unset, got %q", model.runtimeSession.ExportBlob)
+	}
+	if model.ui.statusMessage != "Error: unknown BLOB export encoding: raw (expected base64, hex, or omit)" {
+		t.Fatalf("unexpected status %q", model.ui.statusMessage)
+	}
+}
@@ -38,9 +38,13 @@ func (m *Model) startSortPopup() (tea.Model, tea.Cmd) {
 }
 
 func (m *Model) openFilterPopup() {
+	step := filterSelectColumn
+	if filterGroupHasConditions(m.read.currentFilter) {
+		step = filterSelectCondition
+	}
 	m.overlay.filterPopup = filterPopup{
 		active:        true,
-		step:          filterSelectColumn,
+		step:          step,
 		columnIndex:   0,
 		operatorIndex: 0,
 		input:         "",
@@ -54,24 +58,13 @@ func (m *Model) closeFilterPopup() {
 }
 
 func (m *Model) openSortPopup() {
-	directionIndex := 0
-	columnIndex := 0
-	if m.read.currentSort != nil {
-		for i, column := range m.read.schema.Columns {
-			if column.Name == m.read.currentSort.Column {
-				columnIndex = i
-				break
-			}
-		}
-		if m.read.currentSort.Direction == dto.SortDirectionDesc {
-			directionIndex = 1
-		}
+	step := sortSelectColumn
+	if len(m.read.currentSort) > 0 {
+		step = sortSelectKey
 	}
 	m.overlay.sortPopup = sortPopup{
-		active:         true,
-		step:           sortSelectColumn,
-		columnIndex:    columnIndex,
-		directionIndex: directionIndex,
+		active: true,
+		step:   step,
 	}
 }
 
@@ -87,6 +80,8 @@ func (m *Model) handleFilterPopupKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	case primitives.KeyMatches(primitives.KeyRuntimeEnter, key):
 		return m.confirmPopupSelection()
+	case m.overlay.filterPopup.step == filterSelectCondition:
+		return m.handleFilterConditionKey(key)
 	case primitives.KeyMatches(primitives.KeyRuntimeMoveDown, key):
 		m.movePopupSelection(1)
 		return m, nil
@@ -104,8 +99,13 @@ func (m *Model) handleFilterPopupKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 	case primitives.KeyMatches(primitives.KeyInputBackspace, key):
-		if m.overlay.filterPopup.step == filterInputValue && m.overlay.filterPopup.input != "" {
+		if m.overlay.filterPopup.step != filterInputValue {
+			return m, nil
+		}
+		if m.overlay.filterPopup.input != "" {
 			m.overlay.filterPopup.input, m.overlay.filterPopup.cursor = deleteAtCursor(m.overlay.filterPopup.input, m.overlay.filterPopup.cursor)
+		} else if len(m.overlay.filterPopup.values) > 0 {
+			m.overlay.filterPopup.values = m.overlay.filterPopup.values[:len(m.overlay.filterPopup.values)-1]
 		}
 		return m, nil
 	}
@@ -125,6 +125,8 @@ func (m *Model) handleSortPopupKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	case primitives.KeyMatches(primitives.KeyRuntimeEnter, key):
 		return m.confirmSortPopupSelection()
+	case m.overlay.sortPopup.step == sortSelectKey:
+		return m.handleSortKeyListKey(key)
 	case primitives.KeyMatches(primitives.KeyRuntimeMoveDown, key):
 		m.moveSortPopupSelection(1)
 		return m, nil
@@ -136,14 +138,59 @@ func (m *Model) handleSortPopupKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	}
 }
 
+func (m *Model) handleFilterConditionKey(key string) (tea.Model, tea.Cmd) {
+	switch {
+	case primitives.KeyMatches(primitives.KeyPopupMoveDown, key):
+		m.movePopupSelection(1)
+		return m, nil
+	case primitives.KeyMatches(primitives.KeyPopupMoveUp, key):
+		m.movePopupSelection(-1)
+		return m, nil
+	case primitives.KeyMatches(primitives.KeyFilterAddCondition, key):
+		m.startFilterConditionEntry(false)
+		return m, nil
+	case primitives.KeyMatches(primitives.KeyFilterDeleteCondition, key):
+		return m.deleteSelectedFilterCondition()
+	case primitives.KeyMatches(primitives.KeyFilterToggleLogic, key):
+		return m.toggleFilterLogic()
+	default:
+		return m, nil
+	}
+}
+
 func (m *Model) confirmPopupSelection() (tea.Model, tea.Cmd) {
 	switch m.overlay.filterPopup.step {
+	case filterSelectCondition:
+		m.startFilterConditionEntry(true)
+		return m, nil
 	case filterSelectColumn:
 		return m.confirmFilterColumnSelection()
 	case filterSelectOperator:
 		return m.confirmFilterOperatorSelection()
 	case filterInputValue:
-		return m.applySelectedFilter(m.overlay.filterPopup.input)
+		return m.submitFilterValueInput()
+	default:
+		return m, nil
+	}
+}
+
+func (m *Model) handleSortKeyListKey(key string) (tea.Model, tea.Cmd) {
+	switch {
+	case primitives.KeyMatches(primitives.KeyPopupMoveDown, key):
+		m.moveSortPopupSelection(1)
+		return m, nil
+	case primitives.KeyMatches(primitives.KeyPopupMoveUp, key):
+		m.moveSortPopupSelection(-1)
+		return m, nil
+	case primitives.KeyMatches(primitives.KeySortAddKey, key):
+		m.startSortKeyEntry(false)
+		return m, nil
+	case primitives.KeyMatches(primitives.KeySortDeleteKey, key):
+		return m.deleteSelectedSortKey()
+	case primitives.KeyMatches(primitives.KeySortRaisePriority, key):
+		return m.moveSelectedSortKey(-1)
+	case primitives.KeyMatches(primitives.KeySortLowerPriority, key):
+		return m.moveSelectedSortKey(1)
 	default:
 		return m, nil
 	}
@@ -151,10 +198,19 @@ func (m *Model) confirmPopupSelection() (tea.Model, tea.Cmd) {
 
 func (m *Model) confirmSortPopupSelection() (tea.Model, tea.Cmd) {
 	switch m.overlay.sortPopup.step {
+	case sortSelectKey:
+		m.startSortKeyEntry(true)
+		return m, nil
 	case sortSelectColumn:
 		m.overlay.sortPopup.step = sortSelectDirection
 		return m, nil
 	case sortSelectDirection:
+		m.overlay.sortPopup.step = sortSelectNulls
+		return m, nil
+	case sortSelectNulls:
+		m.overlay.sortPopup.step = sortSelectCollation
+		return m, nil
+	case sortSelectCollation:
 		return m.applySelectedSort()
 	default:
 		return m, nil
@@ -163,6 +219,12 @@ func (m *Model) confirmSortPopupSelection() (tea.Model, tea.Cmd) {
 
 func (m *Model) movePopupSelection(delta int) {
 	switch m.overlay.filterPopup.step {
+	case filterSelectCondition:
+		conditions := m.currentFilterConditions()
+		if len(conditions) == 0 {
+			return
+		}
+		m.overlay.filterPopup.conditionIndex = clamp(m.overlay.filterPopup.conditionIndex+delta, 0, len(conditions)-1)
 	case filterSelectColumn:
 		if len(m.read.schema.Columns) == 0 {
 			return
@@ -178,6 +240,11 @@ func (m *Model) movePopupSelection(delta int) {
 
 func (m *Model) moveSortPopupSelection(delta int) {
 	switch m.overlay.sortPopup.step {
+	case sortSelectKey:
+		if len(m.read.currentSort) == 0 {
+			return
+		}
+		m.overlay.sortPopup.keyIndex = clamp(m.overlay.sortPopup.keyIndex+delta, 0, len(m.read.currentSort)-1)
 	case sortSelectColumn:
 		if len(m.read.schema.Columns) == 0 {
 			return
@@ -189,24 +256,36 @@ func (m *Model) moveSortPopupSelection(delta int) {
 			return
 		}
 		m.overlay.sortPopup.directionIndex = clamp(m.overlay.sortPopup.directionIndex+delta, 0, len(directions)-1)
+	case sortSelectNulls:
+		m.overlay.sortPopup.nullsIndex = clamp(m.overlay.sortPopup.nullsIndex+delta, 0, len(sortNullsOptions())-1)
+	case sortSelectCollation:
+		m.overlay.sortPopup.collationIndex = clamp(m.overlay.sortPopup.collationIndex+delta, 0, len(sortCollationOptions())-1)
 	}
 }
 
-func (m *Model) applyFilter(operator dto.Operator, value string) (tea.Model, tea.Cmd) {
+func (m *Model) applyFilter(operator dto.Operator, value string, values []string) (tea.Model, tea.Cmd) {
 	column, ok := m.selectedFilterColumn()
 	if !ok {
 		return m, nil
 	}
-	filter := &dto.Filter{
+	condition := dto.Filter{
 		Column: column.Name,
 		Operator: dto.Operator{
 			Name:          operator.Name,
 			Kind:          operator.Kind,
 			RequiresValue: operator.RequiresValue,
+			ValueMode:     operator.ValueMode,
 		},
-		Value: value,
+		Value:  value,
+		Values: append([]string(nil), values...),
+	}
+	group := cloneFilterGroup(m.read.currentFilter)
+	if m.overlay.filterPopup.editing && m.overlay.filterPopup.conditionIndex < len(group.Conditions) {
+		group.Conditions[m.overlay.filterPopup.conditionIndex] = condition
+	} else {
+		group.Conditions = append(group.Conditions, condition)
 	}
-	m.read.currentFilter = filter
+	m.read.currentFilter = &group
 	m.closeFilterPopup()
 
 	if m.read.viewMode == ViewRecords {
@@ -215,15 +294,202 @@ func (m *Model) applyFilter(operator dto.Operator, value string) (tea.Model, tea
 	return m, nil
 }
 
-func (m *Model) applySort(column string, direction dto.SortDirection) (tea.Model, tea.Cmd) {
-	m.read.currentSort = &dto.Sort{
-		Column:    column,
-		Direction: direction,
+func (m *Model) startFilterConditionEntry(editing bool) {
+	popup := &m.overlay.filterPopup
+	popup.editing = false
+	popup.columnIndex = 0
+	popup.operatorIndex = 0
+	popup.operators = nil
+	popup.input = ""
+	popup.values = nil
+	popup.cursor = 0
+	popup.step = filterSelectColumn
+	if !editing {
+		return
+	}
+	condition, ok := m.selectedFilterCondition()
+	if !ok {
+		return
+	}
+	popup.editing = true
+	for i, column := range m.read.schema.Columns {
+		if column.Name == condition.Column {
+			popup.columnIndex = i
+			break
+		}
+	}
+}
+
+func (m *Model) deleteSelectedFilterCondition() (tea.Model, tea.Cmd) {
+	conditions := m.currentFilterConditions()
+	if len(conditions) == 0 {
+		return m, nil
+	}
+	index := clamp(m.overlay.filterPopup.conditionIndex, 0, len(conditions)-1)
+	group := cloneFilterGroup(m.read.currentFilter)
+	group.Conditions = append(group.Conditions[:index], group.Conditions[index+1:]...)
+	if len(group.Conditions) == 0 && len(group.Groups) == 0 {
+		m.read.currentFilter = nil
+		m.closeFilterPopup()
+	} else {
+		m.read.currentFilter = &group
+		m.overlay.filterPopup.conditionIndex = clamp(index, 0, primitives.MaxInt(0, len(group.Conditions)-1))
+	}
+	if m.read.viewMode == ViewRecords {
+		return m, m.loadRecordsCmd(true)
+	}
+	return m, nil
+}
+
+func (m *Model) toggleFilterLogic() (tea.Model, tea.Cmd) {
+	if m.read.currentFilter == nil {
+		return m, nil
+	}
+	group := cloneFilterGroup(m.read.currentFilter)
+	if group.Logic == dto.FilterLogicOr {
+		group.Logic = dto.FilterLogicAnd
+	} else {
+		group.Logic = dto.FilterLogicOr
+	}
+	m.read.currentFilter = &group
+	if m.read.viewMode == ViewRecords {
+		return m, m.loadRecordsCmd(true)
+	}
+	return m, nil
+}
+
+func (m *Model) currentFilterConditions() []dto.Filter {
+	if m.read.currentFilter == nil {
+		return nil
+	}
+	return m.read.currentFilter.Conditions
+}
+
+func (m *Model) selectedFilterCondition() (dto.Filter, bool) {
+	conditions := m.currentFilterConditions()
+	if len(conditions) == 0 {
+		return dto.Filter{}, false
+	}
+	index := clamp(m.overlay.filterPopup.conditionIndex, 0, len(conditions)-1)
+	return conditions[index], true
+}
+
+func filterGroupHasConditions(group *dto.FilterGroup) bool {
+	return group != nil && (len(group.Conditions) > 0 || len(group.Groups) > 0)
+}
+
+// cloneFilterGroup copies the top-level condition list so that edits never
+// mutate a filter already captured by an in-flight records load.
+func cloneFilterGroup(group *dto.FilterGroup) dto.FilterGroup {
+	if group == nil {
+		return dto.FilterGroup{Logic: dto.FilterLogicAnd}
+	}
+	cloned := dto.FilterGroup{
+		Logic:      group.Logic,
+		Conditions: append([]dto.Filter(nil), group.Conditions...),
+		Groups:     group.Groups,
+	}
+	if cloned.Logic == "" {
+		cloned.Logic = dto.FilterLogicAnd
+	}
+	return cloned
+}
+
+func (m *Model) applySort(key dto.Sort) (tea.Model, tea.Cmd) {
+	editIndex := -1
+	if m.overlay.sortPopup.editing && m.overlay.sortPopup.keyIndex < len(m.read.currentSort) {
+		editIndex = m.overlay.sortPopup.keyIndex
 	}
+	sorts := make([]dto.Sort, 0, len(m.read.currentSort)+1)
+	replaced := false
+	for i, existing := range m.read.currentSort {
+		switch {
+		case i == editIndex, editIndex < 0 && existing.Column == key.Column:
+			sorts = append(sorts, key)
+			replaced = true
+		case existing.Column == key.Column:
+			// A column appears at most once in the sort list.
+			continue
+		default:
+			sorts = append(sorts, existing)
+		}
+	}
+	if !replaced {
+		sorts = append(sorts, key)
+	}
+	m.read.currentSort = sorts
 	m.closeSortPopup()
 	return m, m.loadRecordsCmd(true)
 }
 
+func (m *Model) startSortKeyEntry(editing bool) {
+	m.overlay.sortPopup = sortPopup{
+		active:   true,
+		step:     sortSelectColumn,
+		keyIndex: m.overlay.sortPopup.keyIndex,
+	}
+	if !editing || len(m.read.currentSort) == 0 {
+		return
+	}
+	popup := &m.overlay.sortPopup
+	popup.editing = true
+	key := m.read.currentSort[clamp(popup.keyIndex, 0, len(m.read.currentSort)-1)]
+	for i, column := range m.read.schema.Columns {
+		if column.Name == key.Column {
+			popup.columnIndex = i
+			break
+		}
+	}
+	for i, direction := range sortDirections() {
+		if direction == key.Direction {
+			popup.directionIndex = i
+		}
+	}
+	for i, nulls := range sortNullsOptions() {
+		if nulls == key.Nulls {
+			popup.nullsIndex = i
+		}
+	}
+	for i, collation := range sortCollationOptions() {
+		if collation == key.Collation {
+			popup.collationIndex = i
+		}
+	}
+}
+
+func (m *Model) deleteSelectedSortKey() (tea.Model, tea.Cmd) {
+	if len(m.read.currentSort) == 0 {
+		return m, nil
+	}
+	index := clamp(m.overlay.sortPopup.keyIndex, 0, len(m.read.currentSort)-1)
+	sorts := append([]dto.Sort(nil), m.read.currentSort[:index]...)
+	sorts = append(sorts, m.read.currentSort[index+1:]...)
+	if len(sorts) == 0 {
+		m.read.currentSort = nil
+		m.closeSortPopup()
+	} else {
+		m.read.currentSort = sorts
+		m.overlay.sortPopup.keyIndex = clamp(index, 0, len(sorts)-1)
+	}
+	return m, m.loadRecordsCmd(true)
+}
+
+func (m *Model) moveSelectedSortKey(delta int) (tea.Model, tea.Cmd) {
+	if len(m.read.currentSort) < 2 {
+		return m, nil
+	}
+	index := clamp(m.overlay.sortPopup.keyIndex, 0, len(m.read.currentSort)-1)
+	target := index + delta
+	if target < 0 || target >= len(m.read.currentSort) {
+		return m, nil
+	}
+	sorts := append([]dto.Sort(nil), m.read.currentSort...)
+	sorts[index], sorts[target] = sorts[target], sorts[index]
+	m.read.currentSort = sorts
+	m.overlay.sortPopup.keyIndex = target
+	return m, m.loadRecordsCmd(true)
+}
+
 func (m *Model) confirmFilterColumnSelection() (tea.Model, tea.Cmd) {
 	column, ok := m.selectedFilterColumn()
 	if !ok {
@@ -236,6 +502,14 @@ func (m *Model) confirmFilterColumnSelection() (tea.Model, tea.Cmd) {
 	}
 	m.overlay.filterPopup.operators = operators
 	m.overlay.filterPopup.operatorIndex = 0
+	if condition, ok := m.editedFilterCondition(column.Name); ok {
+		for i, operator := range operators {
+			if operator.Kind == condition.Operator.Kind {
+				m.overlay.filterPopup.operatorIndex = i
+				break
+			}
+		}
+	}
 	m.overlay.filterPopup.step = filterSelectOperator
 	return m, nil
 }
@@ -247,11 +521,58 @@ func (m *Model) confirmFilterOperatorSelection() (tea.Model, tea.Cmd) {
 	}
 	if operator.RequiresValue {
 		m.overlay.filterPopup.input = ""
-		m.overlay.filterPopup.cursor = 0
+		m.overlay.filterPopup.values = nil
+		if condition, ok := m.editedFilterConditionForOperator(operator); ok {
+			switch operator.ValueMode {
+			case dto.OperatorValueRange:
+				if len(condition.Values) > 0 {
+					m.overlay.filterPopup.input = condition.Values[0]
+				}
+			case dto.OperatorValueList:
+				m.overlay.filterPopup.values = append([]string(nil), condition.Values...)
+			default:
+				m.overlay.filterPopup.input = condition.Value
+			}
+		}
+		m.overlay.filterPopup.cursor = len(m.overlay.filterPopup.input)
 		m.overlay.filterPopup.step = filterInputValue
 		return m, nil
 	}
-	return m.applyFilter(operator, "")
+	return m.applyFilter(operator, "", nil)
+}
+
+func (m *Model) submitFilterValueInput() (tea.Model, tea.Cmd) {
+	operator, ok := m.selectedFilterOperator()
+	if !ok {
+		return m, nil
+	}
+	popup := &m.overlay.filterPopup
+	switch operator.ValueMode {
+	case dto.OperatorValueRange:
+		popup.values = append(popup.values, popup.input)
+		if len(popup.values) < 2 {
+			popup.input = ""
+			if condition, ok := m.editedFilterConditionForOperator(operator); ok && len(condition.Values) > 1 {
+				popup.input = condition.Values[1]
+			}
+			popup.cursor = len(popup.input)
+			return m, nil
+		}
+		return m.applyFilter(operator, "", popup.values)
+	case dto.OperatorValueList:
+		if popup.input != "" {
+			popup.values = append(popup.values, popup.input)
+			popup.input = ""
+			popup.cursor = 0
+			return m, nil
+		}
+		if len(popup.values) == 0 {
+			return m, nil
+		}
+		return m.applyFilter(operator, "", popup.values)
+	default:
+		return m.applySelectedFilter(popup.input)
+	}
 }
 
 func (m *Model) applySelectedFilter(value string) (tea.Model, tea.Cmd) {
@@ -259,7 +580,7 @@ func (m *Model) applySelectedFilter(value string) (tea.Model, tea.Cmd) {
 	if !ok {
 		return m, nil
 	}
-	return m.applyFilter(operator, value)
+	return m.applyFilter(operator, value, nil)
 }
 
 func (m *Model) selectedFilterColumn() (dto.SchemaColumn, bool) {
@@ -270,6 +591,29 @@ func (m *Model) selectedFilterColumn() (dto.SchemaColumn, bool) {
 	return m.read.schema.Columns[index], true
 }
 
+func (m *Model) editedFilterCondition(columnName string) (dto.Filter, bool) {
+	if !m.overlay.filterPopup.editing {
+		return dto.Filter{}, false
+	}
+	condition, ok := m.selectedFilterCondition()
+	if !ok || condition.Column != columnName {
+		return dto.Filter{}, false
+	}
+	return condition, true
+}
+
+func (m *Model) editedFilterConditionForOperator(operator dto.Operator) (dto.Filter, bool) {
+	column, ok := m.selectedFilterColumn()
+	if !ok {
+		return dto.Filter{}, false
+	}
+	condition, ok := m.editedFilterCondition(column.Name)
+	if !ok || !condition.Operator.RequiresValue || condition.Operator.ValueMode != operator.ValueMode {
+		return dto.Filter{}, false
+	}
+	return condition, true
+}
+
 func (m *Model) selectedFilterOperator() (dto.Operator, bool) {
 	if len(m.overlay.filterPopup.operators) == 0 {
 		return dto.Operator{}, false
@@ -287,7 +631,14 @@ func (m *Model) applySelectedSort() (tea.Model, tea.Cmd) {
 	if !ok {
 		return m, nil
 	}
-	return m.applySort(column.Name, direction)
+	nulls := sortNullsOptions()[clamp(m.overlay.sortPopup.nullsIndex, 0, len(sortNullsOptions())-1)]
+	collation := sortCollationOptions()[clamp(m.overlay.sortPopup.collationIndex, 0, len(sortCollationOptions())-1)]
+	return m.applySort(dto.Sort{
+		Column:    column.Name,
+		Direction: direction,
+		Nulls:     nulls,
+		Collation: collation,
+	})
 }
 
 func (m *Model) selectedSortColumn() (dto.SchemaColumn, bool) {
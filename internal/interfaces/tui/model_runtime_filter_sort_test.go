@@ -2,6 +2,7 @@ package tui
 
 import (
 	"context"
+	"reflect"
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -254,19 +255,19 @@ func TestHandleFilterPopupKey_EnterAppliesFilterWithoutValueWhenOperatorDoesNotR
 	if model.overlay.filterPopup.active {
 		t.Fatal("expected filter popup to close after apply")
 	}
-	assertFilterEqual(t, model.read.currentFilter, &dto.Filter{
+	assertFilterEqual(t, model.read.currentFilter, singleConditionFilter(dto.Filter{
 		Column:   "name",
 		Operator: dto.Operator{Name: "Is Null", Kind: dto.OperatorKindIsNull, RequiresValue: false},
 		Value:    "",
-	})
+	}))
 	if model.read.recordPageIndex != 0 {
 		t.Fatalf("expected page index reset to 0 after filter apply, got %d", model.read.recordPageIndex)
 	}
-	assertFilterEqual(t, recordsSpy.lastFilter, &dto.Filter{
+	assertFilterEqual(t, recordsSpy.lastFilter, singleConditionFilter(dto.Filter{
 		Column:   "name",
 		Operator: dto.Operator{Name: "Is Null", Kind: dto.OperatorKindIsNull, RequiresValue: false},
 		Value:    "",
-	})
+	}))
 }
 
 func TestHandleFilterPopupKey_EnterAppliesFilterWithCurrentInputValue(t *testing.T) {
@@ -313,21 +314,380 @@ func TestHandleFilterPopupKey_EnterAppliesFilterWithCurrentInputValue(t *testing
 	if model.overlay.filterPopup.active {
 		t.Fatal("expected filter popup to close after apply")
 	}
-	assertFilterEqual(t, model.read.currentFilter, &dto.Filter{
+	assertFilterEqual(t, model.read.currentFilter, singleConditionFilter(dto.Filter{
 		Column:   "name",
 		Operator: dto.Operator{Name: "Equals", Kind: dto.OperatorKindEq, RequiresValue: true},
 		Value:    "alice",
-	})
+	}))
 	if model.read.recordPageIndex != 0 {
 		t.Fatalf("expected page index reset to 0 after filter apply, got %d", model.read.recordPageIndex)
 	}
-	assertFilterEqual(t, recordsSpy.lastFilter, &dto.Filter{
+	assertFilterEqual(t, recordsSpy.lastFilter, singleConditionFilter(dto.Filter{
+		Column:   "name",
+		Operator: dto.Operator{Name: "Equals", Kind: dto.OperatorKindEq, RequiresValue: true},
+		Value:    "alice",
+	}))
+}
+
+func TestHandleKey_ShiftFOpensConditionListWhenFilterIsActive(t *testing.T) {
+	// Arrange
+	model := &Model{
+		read: runtimeReadState{
+			viewMode: ViewRecords,
+			focus:    FocusContent,
+			tables:   []dto.Table{{Name: "users"}},
+			schema: dto.Schema{
+				Columns: []dto.SchemaColumn{
+					{Name: "id", Type: "INTEGER"},
+					{Name: "name", Type: "TEXT"},
+				},
+			},
+			currentFilter: singleConditionFilter(dto.Filter{
+				Column:   "name",
+				Operator: dto.Operator{Name: "Equals", Kind: dto.OperatorKindEq, RequiresValue: true},
+				Value:    "alice",
+			}),
+		},
+	}
+
+	// Act
+	model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'F'}})
+
+	// Assert
+	if !model.overlay.filterPopup.active {
+		t.Fatal("expected filter popup to be active")
+	}
+	if model.overlay.filterPopup.step != filterSelectCondition {
+		t.Fatalf("expected condition-list step, got %v", model.overlay.filterPopup.step)
+	}
+}
+
+func TestHandleFilterPopupKey_AddAppendsConditionToActiveFilter(t *testing.T) {
+	// Arrange
+	recordsSpy := &spyListRecordsUseCase{}
+	existing := dto.Filter{
 		Column:   "name",
 		Operator: dto.Operator{Name: "Equals", Kind: dto.OperatorKindEq, RequiresValue: true},
 		Value:    "alice",
+	}
+	model := &Model{
+		ctx:           context.Background(),
+		listRecords:   recordsSpy,
+		listOperators: &spyListOperatorsUseCase{operators: []dto.Operator{{Name: "Greater Than", Kind: dto.OperatorKindGt, RequiresValue: true}}},
+		read: runtimeReadState{
+			viewMode: ViewRecords,
+			focus:    FocusContent,
+			tables:   []dto.Table{{Name: "users"}},
+			schema: dto.Schema{
+				Columns: []dto.SchemaColumn{
+					{Name: "id", Type: "INTEGER"},
+					{Name: "name", Type: "TEXT"},
+				},
+			},
+			currentFilter: singleConditionFilter(existing),
+		},
+	}
+	model.openFilterPopup()
+
+	// Act
+	model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyEnter})
+	model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyEnter})
+	model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'7'}})
+	_, cmd := model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected records reload command after filter apply")
+	}
+	model.Update(cmd())
+
+	// Assert
+	expected := &dto.FilterGroup{
+		Logic: dto.FilterLogicAnd,
+		Conditions: []dto.Filter{
+			existing,
+			{
+				Column:   "id",
+				Operator: dto.Operator{Name: "Greater Than", Kind: dto.OperatorKindGt, RequiresValue: true},
+				Value:    "7",
+			},
+		},
+	}
+	assertFilterEqual(t, model.read.currentFilter, expected)
+	assertFilterEqual(t, recordsSpy.lastFilter, expected)
+}
+
+func TestHandleFilterPopupKey_EnterEditsSelectedConditionWithPrefilledValue(t *testing.T) {
+	// Arrange
+	recordsSpy := &spyListRecordsUseCase{}
+	model := &Model{
+		ctx:         context.Background(),
+		listRecords: recordsSpy,
+		listOperators: &spyListOperatorsUseCase{operators: []dto.Operator{
+			{Name: "Equals", Kind: dto.OperatorKindEq, RequiresValue: true},
+			{Name: "Like", Kind: dto.OperatorKindLike, RequiresValue: true},
+		}},
+		read: runtimeReadState{
+			viewMode: ViewRecords,
+			focus:    FocusContent,
+			tables:   []dto.Table{{Name: "users"}},
+			schema: dto.Schema{
+				Columns: []dto.SchemaColumn{
+					{Name: "id", Type: "INTEGER"},
+					{Name: "name", Type: "TEXT"},
+				},
+			},
+			currentFilter: &dto.FilterGroup{
+				Logic: dto.FilterLogicOr,
+				Conditions: []dto.Filter{
+					{Column: "id", Operator: dto.Operator{Name: "Equals", Kind: dto.OperatorKindEq, RequiresValue: true}, Value: "1"},
+					{Column: "name", Operator: dto.Operator{Name: "Like", Kind: dto.OperatorKindLike, RequiresValue: true}, Value: "al%"},
+				},
+			},
+		},
+	}
+	model.openFilterPopup()
+
+	// Act
+	model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyEnter})
+	columnIndex := model.overlay.filterPopup.columnIndex
+	model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyEnter})
+	operatorIndex := model.overlay.filterPopup.operatorIndex
+	model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyEnter})
+	input := model.overlay.filterPopup.input
+	model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyBackspace})
+	model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i', '%'}})
+	model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyEnter})
+
+	// Assert
+	if columnIndex != 1 {
+		t.Fatalf("expected edited condition column to be preselected, got index %d", columnIndex)
+	}
+	if operatorIndex != 1 {
+		t.Fatalf("expected edited condition operator to be preselected, got index %d", operatorIndex)
+	}
+	if input != "al%" {
+		t.Fatalf("expected edited condition value to be prefilled, got %q", input)
+	}
+	assertFilterEqual(t, model.read.currentFilter, &dto.FilterGroup{
+		Logic: dto.FilterLogicOr,
+		Conditions: []dto.Filter{
+			{Column: "id", Operator: dto.Operator{Name: "Equals", Kind: dto.OperatorKindEq, RequiresValue: true}, Value: "1"},
+			{Column: "name", Operator: dto.Operator{Name: "Like", Kind: dto.OperatorKindLike, RequiresValue: true}, Value: "ali%"},
+		},
 	})
 }
 
+func TestHandleFilterPopupKey_DeleteRemovesSelectedConditionAndReloads(t *testing.T) {
+	// Arrange
+	recordsSpy := &spyListRecordsUseCase{}
+	model := &Model{
+		ctx:         context.Background(),
+		listRecords: recordsSpy,
+		read: runtimeReadState{
+			viewMode: ViewRecords,
+			focus:    FocusContent,
+			tables:   []dto.Table{{Name: "users"}},
+			currentFilter: &dto.FilterGroup{
+				Logic: dto.FilterLogicAnd,
+				Conditions: []dto.Filter{
+					{Column: "id", Operator: dto.Operator{Name: "Equals", Kind: dto.OperatorKindEq, RequiresValue: true}, Value: "1"},
+					{Column: "name", Operator: dto.Operator{Name: "Is Null", Kind: dto.OperatorKindIsNull}},
+				},
+			},
+		},
+	}
+	model.openFilterPopup()
+
+	// Act
+	_, firstCmd := model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+	popupOpenAfterFirstDelete := model.overlay.filterPopup.active
+	if firstCmd != nil {
+		model.Update(firstCmd())
+	}
+	_, secondCmd := model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+
+	// Assert
+	if firstCmd == nil || secondCmd == nil {
+		t.Fatal("expected records reload command after each condition removal")
+	}
+	if !popupOpenAfterFirstDelete {
+		t.Fatal("expected filter popup to stay open while conditions remain")
+	}
+	if model.overlay.filterPopup.active {
+		t.Fatal("expected filter popup to close after the last condition is removed")
+	}
+	if model.read.currentFilter != nil {
+		t.Fatalf("expected filter to be cleared, got %+v", model.read.currentFilter)
+	}
+}
+
+func TestHandleFilterPopupKey_ToggleSwitchesConditionLogic(t *testing.T) {
+	// Arrange
+	recordsSpy := &spyListRecordsUseCase{}
+	model := &Model{
+		ctx:         context.Background(),
+		listRecords: recordsSpy,
+		read: runtimeReadState{
+			viewMode: ViewRecords,
+			focus:    FocusContent,
+			tables:   []dto.Table{{Name: "users"}},
+			currentFilter: singleConditionFilter(dto.Filter{
+				Column:   "name",
+				Operator: dto.Operator{Name: "Is Null", Kind: dto.OperatorKindIsNull},
+			}),
+		},
+	}
+	model.openFilterPopup()
+
+	// Act
+	_, cmd := model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+
+	// Assert
+	if cmd == nil {
+		t.Fatal("expected records reload command after logic toggle")
+	}
+	if model.read.currentFilter.Logic != dto.FilterLogicOr {
+		t.Fatalf("expected OR logic after toggle, got %q", model.read.currentFilter.Logic)
+	}
+	if !model.overlay.filterPopup.active {
+		t.Fatal("expected filter popup to stay open after logic toggle")
+	}
+}
+
+func TestHandleFilterPopupKey_DeleteAndToggleInSchemaViewDoNotLoadRecords(t *testing.T) {
+	// Arrange
+	recordsSpy := &spyListRecordsUseCase{}
+	model := &Model{
+		ctx:         context.Background(),
+		listRecords: recordsSpy,
+		read: runtimeReadState{
+			viewMode: ViewSchema,
+			focus:    FocusContent,
+			tables:   []dto.Table{{Name: "users"}},
+			currentFilter: &dto.FilterGroup{
+				Logic: dto.FilterLogicAnd,
+				Conditions: []dto.Filter{
+					{Column: "id", Operator: dto.Operator{Name: "Equals", Kind: dto.OperatorKindEq, RequiresValue: true}, Value: "1"},
+					{Column: "name", Operator: dto.Operator{Name: "Is Null", Kind: dto.OperatorKindIsNull}},
+				},
+			},
+		},
+	}
+	model.openFilterPopup()
+
+	// Act
+	_, toggleCmd := model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+	_, deleteCmd := model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+
+	// Assert
+	if toggleCmd != nil || deleteCmd != nil {
+		t.Fatal("expected no records load outside the records view")
+	}
+	if model.read.recordLoading || model.read.recordRequestID != 0 {
+		t.Fatalf("expected records state untouched, got loading %v request %d", model.read.recordLoading, model.read.recordRequestID)
+	}
+	if model.read.currentFilter.Logic != dto.FilterLogicOr || len(model.read.currentFilter.Conditions) != 1 {
+		t.Fatalf("expected filter edits to apply, got %+v", model.read.currentFilter)
+	}
+}
+
+func TestHandleFilterPopupKey_ListOperatorCollectsValuesUntilEmptyEnter(t *testing.T) {
+	// Arrange
+	recordsSpy := &spyListRecordsUseCase{}
+	inOperator := dto.Operator{Name: "In", Kind: dto.OperatorKindIn, RequiresValue: true, ValueMode: dto.OperatorValueList}
+	model := &Model{
+		ctx:         context.Background(),
+		listRecords: recordsSpy,
+		read: runtimeReadState{
+			viewMode: ViewRecords,
+			focus:    FocusContent,
+			tables:   []dto.Table{{Name: "users"}},
+			schema: dto.Schema{
+				Columns: []dto.SchemaColumn{{Name: "status", Type: "TEXT"}},
+			},
+		},
+		overlay: runtimeOverlayState{
+			filterPopup: filterPopup{
+				active:    true,
+				step:      filterSelectOperator,
+				operators: []dto.Operator{inOperator},
+			},
+		},
+	}
+	typeValue := func(value string) {
+		model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(value)})
+		model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyEnter})
+	}
+
+	// Act
+	model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyEnter})
+	typeValue("done")
+	typeValue("failed")
+	typeValue("queued")
+	model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyBackspace})
+	valuesBeforeApply := append([]string(nil), model.overlay.filterPopup.values...)
+	_, cmd := model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyEnter})
+
+	// Assert
+	if !reflect.DeepEqual(valuesBeforeApply, []string{"done", "failed"}) {
+		t.Fatalf("expected backspace on empty input to drop the last value, got %v", valuesBeforeApply)
+	}
+	if cmd == nil {
+		t.Fatal("expected records reload command after filter apply")
+	}
+	assertFilterEqual(t, model.read.currentFilter, singleConditionFilter(dto.Filter{
+		Column:   "status",
+		Operator: inOperator,
+		Values:   []string{"done", "failed"},
+	}))
+}
+
+func TestHandleFilterPopupKey_RangeOperatorAppliesAfterUpperBound(t *testing.T) {
+	// Arrange
+	recordsSpy := &spyListRecordsUseCase{}
+	betweenOperator := dto.Operator{Name: "Between", Kind: dto.OperatorKindBetween, RequiresValue: true, ValueMode: dto.OperatorValueRange}
+	model := &Model{
+		ctx:         context.Background(),
+		listRecords: recordsSpy,
+		read: runtimeReadState{
+			viewMode: ViewRecords,
+			focus:    FocusContent,
+			tables:   []dto.Table{{Name: "users"}},
+			schema: dto.Schema{
+				Columns: []dto.SchemaColumn{{Name: "age", Type: "INTEGER"}},
+			},
+		},
+		overlay: runtimeOverlayState{
+			filterPopup: filterPopup{
+				active:    true,
+				step:      filterSelectOperator,
+				operators: []dto.Operator{betweenOperator},
+			},
+		},
+	}
+
+	// Act
+	model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyEnter})
+	model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("18")})
+	_, lowerCmd := model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyEnter})
+	model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("65")})
+	_, upperCmd := model.handleFilterPopupKey(tea.KeyMsg{Type: tea.KeyEnter})
+
+	// Assert
+	if lowerCmd != nil {
+		t.Fatal("expected no reload after entering only the lower bound")
+	}
+	if upperCmd == nil {
+		t.Fatal("expected records reload command after entering the upper bound")
+	}
+	assertFilterEqual(t, model.read.currentFilter, singleConditionFilter(dto.Filter{
+		Column:   "age",
+		Operator: betweenOperator,
+		Values:   []string{"18", "65"},
+	}))
+}
+
 func TestHandleFilterPopupKey_InputEditingSupportsCursorMovementAndBackspace(t *testing.T) {
 	// Arrange
 	model := &Model{
@@ -405,7 +765,7 @@ func TestHandleSortPopupKey_EnterMovesToDirectionStep(t *testing.T) {
 	}
 }
 
-func TestHandleSortPopupKey_EnterAppliesSelectedSort(t *testing.T) {
+func TestHandleSortPopupKey_EnterOnCollationStepAppliesSelectedSort(t *testing.T) {
 	// Arrange
 	recordsSpy := &spyListRecordsUseCase{
 		page: dto.RecordPage{
@@ -432,9 +792,11 @@ func TestHandleSortPopupKey_EnterAppliesSelectedSort(t *testing.T) {
 		overlay: runtimeOverlayState{
 			sortPopup: sortPopup{
 				active:         true,
-				step:           sortSelectDirection,
+				step:           sortSelectCollation,
 				columnIndex:    0,
 				directionIndex: 1,
+				nullsIndex:     2,
+				collationIndex: 1,
 			},
 		},
 	}
@@ -451,18 +813,134 @@ func TestHandleSortPopupKey_EnterAppliesSelectedSort(t *testing.T) {
 	if model.overlay.sortPopup.active {
 		t.Fatal("expected sort popup to close after apply")
 	}
-	if model.read.currentSort == nil {
-		t.Fatal("expected current sort to be set")
-	}
-	assertSortEqual(t, model.read.currentSort, &dto.Sort{
+	assertSortEqual(t, model.read.currentSort, []dto.Sort{{
 		Column:    "id",
 		Direction: dto.SortDirectionDesc,
-	})
+		Nulls:     dto.SortNullsLast,
+		Collation: dto.SortCollationNoCase,
+	}})
 	if model.read.recordPageIndex != 0 {
 		t.Fatalf("expected page index reset to 0 after sort apply, got %d", model.read.recordPageIndex)
 	}
-	assertSortEqual(t, recordsSpy.lastSort, &dto.Sort{
+	assertSortEqual(t, recordsSpy.lastSort, []dto.Sort{{
 		Column:    "id",
 		Direction: dto.SortDirectionDesc,
+		Nulls:     dto.SortNullsLast,
+		Collation: dto.SortCollationNoCase,
+	}})
+}
+
+func TestHandleSortPopupKey_EnterWalksThroughNullsAndCollationSteps(t *testing.T) {
+	// Arrange
+	model := &Model{
+		overlay: runtimeOverlayState{
+			sortPopup: sortPopup{
+				active: true,
+				step:   sortSelectDirection,
+			},
+		},
+	}
+
+	// Act
+	_, directionCmd := model.handleSortPopupKey(tea.KeyMsg{Type: tea.KeyEnter})
+	stepAfterDirection := model.overlay.sortPopup.step
+	_, nullsCmd := model.handleSortPopupKey(tea.KeyMsg{Type: tea.KeyEnter})
+
+	// Assert
+	if directionCmd != nil || nullsCmd != nil {
+		t.Fatal("expected no command before the collation step")
+	}
+	if stepAfterDirection != sortSelectNulls {
+		t.Fatalf("expected nulls step after direction, got %v", stepAfterDirection)
+	}
+	if model.overlay.sortPopup.step != sortSelectCollation {
+		t.Fatalf("expected collation step after nulls, got %v", model.overlay.sortPopup.step)
+	}
+}
+
+func TestHandleSortPopupKey_AddAppendsLowerPrioritySortKey(t *testing.T) {
+	// Arrange
+	recordsSpy := &spyListRecordsUseCase{}
+	model := &Model{
+		ctx:         context.Background(),
+		listRecords: recordsSpy,
+		read: runtimeReadState{
+			viewMode: ViewRecords,
+			focus:    FocusContent,
+			tables:   []dto.Table{{Name: "users"}},
+			schema: dto.Schema{
+				Columns: []dto.SchemaColumn{
+					{Name: "id", Type: "INTEGER"},
+					{Name: "name", Type: "TEXT"},
+				},
+			},
+			currentSort: []dto.Sort{{Column: "name", Direction: dto.SortDirectionAsc}},
+		},
+	}
+	model.openSortPopup()
+
+	// Act
+	model.handleSortPopupKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	model.handleSortPopupKey(tea.KeyMsg{Type: tea.KeyEnter})
+	model.handleSortPopupKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	model.handleSortPopupKey(tea.KeyMsg{Type: tea.KeyEnter})
+	model.handleSortPopupKey(tea.KeyMsg{Type: tea.KeyEnter})
+	_, cmd := model.handleSortPopupKey(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected records reload command after applying sort")
+	}
+	model.Update(cmd())
+
+	// Assert
+	expected := []dto.Sort{
+		{Column: "name", Direction: dto.SortDirectionAsc},
+		{Column: "id", Direction: dto.SortDirectionDesc},
+	}
+	assertSortEqual(t, model.read.currentSort, expected)
+	assertSortEqual(t, recordsSpy.lastSort, expected)
+}
+
+func TestHandleSortPopupKey_ReorderAndRemoveSortKeys(t *testing.T) {
+	// Arrange
+	recordsSpy := &spyListRecordsUseCase{}
+	model := &Model{
+		ctx:         context.Background(),
+		listRecords: recordsSpy,
+		read: runtimeReadState{
+			viewMode: ViewRecords,
+			focus:    FocusContent,
+			tables:   []dto.Table{{Name: "users"}},
+			currentSort: []dto.Sort{
+				{Column: "name", Direction: dto.SortDirectionAsc},
+				{Column: "id", Direction: dto.SortDirectionDesc, Nulls: dto.SortNullsFirst},
+			},
+		},
+	}
+	model.openSortPopup()
+
+	// Act
+	model.handleSortPopupKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	_, reorderCmd := model.handleSortPopupKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'K'}})
+	reordered := append([]dto.Sort(nil), model.read.currentSort...)
+	selectedAfterReorder := model.overlay.sortPopup.keyIndex
+	if reorderCmd != nil {
+		model.Update(reorderCmd())
+	}
+	_, removeCmd := model.handleSortPopupKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+
+	// Assert
+	assertSortEqual(t, reordered, []dto.Sort{
+		{Column: "id", Direction: dto.SortDirectionDesc, Nulls: dto.SortNullsFirst},
+		{Column: "name", Direction: dto.SortDirectionAsc},
 	})
+	if selectedAfterReorder != 0 {
+		t.Fatalf("expected selection to follow the moved key, got %d", selectedAfterReorder)
+	}
+	if reorderCmd == nil || removeCmd == nil {
+		t.Fatal("expected records reload command after reorder and removal")
+	}
+	assertSortEqual(t, model.read.currentSort, []dto.Sort{{Column: "name", Direction: dto.SortDirectionAsc}})
+	if !model.overlay.sortPopup.active {
+		t.Fatal("expected sort popup to stay open while keys remain")
+	}
 }
@@ -6,6 +6,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/mgierok/dbc/internal/application/dto"
 	"github.com/mgierok/dbc/internal/application/usecase"
 	"github.com/mgierok/dbc/internal/interfaces/tui/internal/primitives"
 )
@@ -23,9 +24,18 @@ func (m *Model) startCommandInput() (tea.Model, tea.Cmd) {
 
 func (m *Model) clearPendingRuntimeKeyState() {
 	m.overlay.pendingG = false
+	m.overlay.pendingY = false
 }
 
+// submitCommandInput records the submitted command in the history before it
+// runs, so the entry is stored even when the command quits the runtime.
 func (m *Model) submitCommandInput() (tea.Model, tea.Cmd) {
+	historyCmd := m.recordHistory(dto.HistoryKindCommands, m.overlay.commandInput.value)
+	model, cmd := m.executeCommandInput()
+	return model, tea.Sequence(historyCmd, cmd)
+}
+
+func (m *Model) executeCommandInput() (tea.Model, tea.Cmd) {
 	submittedValue := m.overlay.commandInput.value
 	command := ":" + strings.TrimSpace(submittedValue)
 
@@ -58,9 +68,42 @@ func (m *Model) submitCommandInput() (tea.Model, tea.Cmd) {
 		return m.requestRuntimeDatabaseTransition(target, commandSpec.Force, submittedValue)
 	case primitives.RuntimeCommandActionSave:
 		m.overlay.commandInput = commandInput{}
+		if !m.ensureSessionWritable() {
+			return m, nil
+		}
 		return m.requestSaveChanges()
+	case primitives.RuntimeCommandActionPreviewSave:
+		m.overlay.commandInput = commandInput{}
+		return m.requestSavePreview()
+	case primitives.RuntimeCommandActionExportRecords:
+		m.overlay.commandInput = commandInput{}
+		return m.requestExportRecords(commandSpec.Format, commandSpec.Path)
+	case primitives.RuntimeCommandActionSetOption:
+		m.overlay.commandInput = commandInput{}
+		return m.applyRuntimeOption(commandSpec.Option, commandSpec.OptionValue)
+	case primitives.RuntimeCommandActionImportRecords:
+		m.overlay.commandInput = commandInput{}
+		if !m.ensureSessionWritable() {
+			return m, nil
+		}
+		return m.requestImportRecords(commandSpec.Path)
+	case primitives.RuntimeCommandActionExportChanges:
+		m.overlay.commandInput = commandInput{}
+		return m.requestExportChanges(commandSpec.Path)
+	case primitives.RuntimeCommandActionSaveView:
+		m.overlay.commandInput = commandInput{}
+		return m.requestSaveView(commandSpec.ViewName)
+	case primitives.RuntimeCommandActionOpenView:
+		m.overlay.commandInput = commandInput{}
+		return m.requestOpenView(commandSpec.ViewName)
+	case primitives.RuntimeCommandActionOpenSQLConsole:
+		m.overlay.commandInput = commandInput{}
+		return m.openSQLConsole()
 	case primitives.RuntimeCommandActionSaveAndQuit:
 		m.overlay.commandInput = commandInput{}
+		if !m.ensureSessionWritable() {
+			return m, nil
+		}
 		return m.requestSaveAndQuit()
 	case primitives.RuntimeCommandActionQuit:
 		m.overlay.commandInput = commandInput{}
@@ -133,8 +176,14 @@ func (m *Model) currentHelpPopupContext() helpPopupContext {
 		return helpPopupContextSortPopup
 	case m.overlay.helpPopup.active:
 		return helpPopupContextHelpPopup
+	case m.overlay.savePreview.active:
+		return helpPopupContextSavePreview
+	case m.overlay.importPopup.active:
+		return helpPopupContextImportPopup
 	case m.overlay.commandInput.active:
 		return helpPopupContextCommandInput
+	case m.overlay.sqlConsole.active:
+		return helpPopupContextSQLConsole
 	case m.overlay.recordDetail.active:
 		return helpPopupContextRecordDetail
 	case m.read.focus == FocusTables:
@@ -170,6 +219,12 @@ func (m *Model) helpPopupContextTitle() string {
 		return "Context Help: Command Input"
 	case helpPopupContextHelpPopup:
 		return "Context Help: Help Popup"
+	case helpPopupContextSavePreview:
+		return "Context Help: SQL Preview"
+	case helpPopupContextSQLConsole:
+		return "Context Help: SQL Console"
+	case helpPopupContextImportPopup:
+		return "Context Help: Import"
 	default:
 		return "Context Help"
 	}
@@ -202,13 +257,31 @@ func (m *Model) helpPopupContextShortcuts() string {
 	case helpPopupContextConfirmPopup:
 		return primitives.RuntimeStatusConfirmShortcuts(len(m.overlay.confirmPopup.options) > 0)
 	case helpPopupContextFilterPopup:
+		if m.overlay.filterPopup.step == filterSelectCondition {
+			return primitives.RuntimeStatusFilterConditionsShortcuts()
+		}
 		return primitives.RuntimeStatusFilterPopupShortcuts()
 	case helpPopupContextSortPopup:
+		if m.overlay.sortPopup.step == sortSelectKey {
+			return primitives.RuntimeStatusSortKeysShortcuts()
+		}
 		return primitives.RuntimeStatusSortPopupShortcuts()
 	case helpPopupContextHelpPopup:
 		return primitives.RuntimeStatusHelpPopupShortcuts()
+	case helpPopupContextSavePreview:
+		return primitives.RuntimeStatusSavePreviewShortcuts(m.overlay.savePreview.confirm)
+	case helpPopupContextImportPopup:
+		if m.overlay.importPopup.step == importStepReport {
+			return primitives.RuntimeStatusImportReportShortcuts()
+		}
+		return primitives.RuntimeStatusImportMappingShortcuts()
 	case helpPopupContextCommandInput:
 		return primitives.RuntimeStatusCommandInputShortcuts()
+	case helpPopupContextSQLConsole:
+		if m.overlay.sqlConsole.focus == sqlConsoleFocusResults {
+			return primitives.RuntimeStatusSQLResultsShortcuts()
+		}
+		return primitives.RuntimeStatusSQLEditorShortcuts()
 	case helpPopupContextRecordDetail:
 		return primitives.RuntimeStatusRecordDetailShortcuts()
 	case helpPopupContextTables:
@@ -216,6 +289,9 @@ func (m *Model) helpPopupContextShortcuts() string {
 	case helpPopupContextSchema:
 		return primitives.RuntimeStatusSchemaShortcuts()
 	case helpPopupContextRecords:
+		if m.read.visual.active {
+			return primitives.RuntimeStatusRecordsVisualShortcuts()
+		}
 		return primitives.RuntimeStatusRecordsShortcuts()
 	default:
 		return ""
@@ -253,6 +329,14 @@ func (m *Model) handleHelpPopupKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 }
 
 func (m *Model) handleCommandInputKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	input := &m.overlay.commandInput
+	if input.search.active {
+		if value, finished := input.search.handleKey(m.ui.commandHistory, msg); finished {
+			m.setCommandInputValue(value)
+		}
+		return m, nil
+	}
+
 	key := msg.String()
 	switch {
 	case primitives.KeyMatches(primitives.KeyRuntimeEsc, key):
@@ -260,6 +344,20 @@ func (m *Model) handleCommandInputKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	case primitives.KeyMatches(primitives.KeyRuntimeEnter, key):
 		return m.submitCommandInput()
+	case primitives.KeyMatches(primitives.KeyHistoryPrevious, key):
+		if value, ok := input.recall.previous(m.ui.commandHistory, input.value); ok {
+			m.setCommandInputValue(value)
+		}
+		return m, nil
+	case primitives.KeyMatches(primitives.KeyHistoryNext, key):
+		if value, ok := input.recall.next(m.ui.commandHistory, input.value); ok {
+			m.setCommandInputValue(value)
+		}
+		return m, nil
+	case primitives.KeyMatches(primitives.KeyHistorySearch, key):
+		input.recall = historyRecall{}
+		input.search = startHistorySearch(input.value)
+		return m, nil
 	case primitives.KeyMatches(primitives.KeyInputMoveLeft, key):
 		m.overlay.commandInput.cursor = clamp(m.overlay.commandInput.cursor-1, 0, len(m.overlay.commandInput.value))
 		return m, nil
@@ -269,6 +367,7 @@ func (m *Model) handleCommandInputKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case primitives.KeyMatches(primitives.KeyInputBackspace, key):
 		if m.overlay.commandInput.value != "" {
 			m.overlay.commandInput.value, m.overlay.commandInput.cursor = deleteAtCursor(m.overlay.commandInput.value, m.overlay.commandInput.cursor)
+			input.recall = historyRecall{}
 		}
 		return m, nil
 	}
@@ -279,6 +378,7 @@ func (m *Model) handleCommandInputKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			insert = " "
 		}
 		m.overlay.commandInput.value, m.overlay.commandInput.cursor = insertAtCursor(m.overlay.commandInput.value, insert, m.overlay.commandInput.cursor)
+		input.recall = historyRecall{}
 	}
 	return m, nil
 }
@@ -0,0 +1,202 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/usecase"
+	"github.com/mgierok/dbc/internal/interfaces/tui/internal/primitives"
+)
+
+// historyRecall steps through a history, newest entry last, the way Up and
+// Down do in a shell. The text typed before recall started is restored after
+// stepping past the newest entry.
+type historyRecall struct {
+	browsing bool
+	index    int
+	draft    string
+}
+
+func (r *historyRecall) previous(entries []string, current string) (string, bool) {
+	if !r.browsing {
+		if len(entries) == 0 {
+			return current, false
+		}
+		*r = historyRecall{browsing: true, index: len(entries), draft: current}
+	}
+	if r.index == 0 {
+		return current, false
+	}
+	r.index--
+	return entries[r.index], true
+}
+
+func (r *historyRecall) next(entries []string, current string) (string, bool) {
+	if !r.browsing {
+		return current, false
+	}
+	r.index++
+	if r.index >= len(entries) {
+		draft := r.draft
+		*r = historyRecall{}
+		return draft, true
+	}
+	return entries[r.index], true
+}
+
+// historySearch is a reverse incremental search: each query finds the newest
+// entry containing it, and searching again continues with older entries.
+type historySearch struct {
+	active   bool
+	query    string
+	match    int
+	original string
+}
+
+func startHistorySearch(original string) historySearch {
+	return historySearch{active: true, match: -1, original: original}
+}
+
+func (s *historySearch) setQuery(entries []string, query string) {
+	s.query = query
+	s.match = findHistoryMatch(entries, query, len(entries)-1)
+}
+
+// older moves to the next older match, keeping the current one when there is
+// none.
+func (s *historySearch) older(entries []string) {
+	from := s.match - 1
+	if s.match < 0 {
+		from = len(entries) - 1
+	}
+	if match := findHistoryMatch(entries, s.query, from); match >= 0 {
+		s.match = match
+	}
+}
+
+func (s *historySearch) matchText(entries []string) (string, bool) {
+	if s.match < 0 || s.match >= len(entries) {
+		return "", false
+	}
+	return entries[s.match], true
+}
+
+// prompt renders the search line shown in place of the edited text.
+func (s *historySearch) prompt(entries []string) string {
+	label := "reverse-i-search"
+	match, ok := s.matchText(entries)
+	if !ok && s.query != "" {
+		label = "failing reverse-i-search"
+	}
+	return fmt.Sprintf("(%s)'%s': %s", label, s.query, match)
+}
+
+// handleKey edits the search query. It reports finished with the text to put
+// back into the input once Enter accepts the match or Esc cancels the search.
+func (s *historySearch) handleKey(entries []string, msg tea.KeyMsg) (string, bool) {
+	key := msg.String()
+	switch {
+	case primitives.KeyMatches(primitives.KeyRuntimeEsc, key):
+		original := s.original
+		*s = historySearch{}
+		return original, true
+	case primitives.KeyMatches(primitives.KeyRuntimeEnter, key):
+		value, ok := s.matchText(entries)
+		if !ok {
+			value = s.original
+		}
+		*s = historySearch{}
+		return value, true
+	case primitives.KeyMatches(primitives.KeyHistorySearch, key):
+		s.older(entries)
+	case primitives.KeyMatches(primitives.KeyInputBackspace, key):
+		query := []rune(s.query)
+		if len(query) > 0 {
+			s.setQuery(entries, string(query[:len(query)-1]))
+		}
+	case msg.Type == tea.KeyRunes:
+		s.setQuery(entries, s.query+string(msg.Runes))
+	case msg.Type == tea.KeySpace:
+		s.setQuery(entries, s.query+" ")
+	}
+	return "", false
+}
+
+func findHistoryMatch(entries []string, query string, from int) int {
+	if query == "" {
+		return -1
+	}
+	needle := strings.ToLower(query)
+	for i := from; i >= 0; i-- {
+		if i < len(entries) && strings.Contains(strings.ToLower(entries[i]), needle) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (m *Model) setCommandInputValue(value string) {
+	m.overlay.commandInput.value = value
+	m.overlay.commandInput.cursor = len(value)
+}
+
+func (m *Model) setSQLConsoleInput(value string) {
+	m.overlay.sqlConsole.input = value
+	m.overlay.sqlConsole.cursor = len(value)
+}
+
+func (m *Model) historyEntries(kind dto.HistoryKind) []string {
+	if kind == dto.HistoryKindSQL {
+		return m.ui.sqlHistory
+	}
+	return m.ui.commandHistory
+}
+
+func (m *Model) setHistoryEntries(kind dto.HistoryKind, entries []string) {
+	if kind == dto.HistoryKindSQL {
+		m.ui.sqlHistory = entries
+		return
+	}
+	m.ui.commandHistory = entries
+}
+
+func (m *Model) loadHistoryCmds() tea.Cmd {
+	if m.history == nil {
+		return nil
+	}
+	return tea.Batch(
+		loadHistoryCmd(m.runtimeReadContext(), m.history, dto.HistoryKindCommands, m.runtimeBundleToken),
+		loadHistoryCmd(m.runtimeReadContext(), m.history, dto.HistoryKindSQL, m.runtimeBundleToken),
+	)
+}
+
+// recordHistory adds entry to the in-memory history right away so it can be
+// recalled at once, and returns the command that stores it.
+func (m *Model) recordHistory(kind dto.HistoryKind, entry string) tea.Cmd {
+	entry = strings.TrimSpace(entry)
+	if entry == "" {
+		return nil
+	}
+	m.setHistoryEntries(kind, usecase.AppendHistoryEntry(m.historyEntries(kind), entry))
+	if m.history == nil {
+		return nil
+	}
+	return recordHistoryCmd(m.ctx, m.history, kind, entry, m.runtimeBundleToken)
+}
+
+// handleHistoryMsg adopts a loaded history. A failed load is reported; a
+// failed write keeps the in-memory entry without interrupting the session.
+func (m *Model) handleHistoryMsg(msg historyMsg) (tea.Model, tea.Cmd) {
+	if msg.bundleToken != m.runtimeBundleToken || !msg.load {
+		return m, nil
+	}
+	if msg.err != nil {
+		m.ui.statusMessage = "Error: " + msg.err.Error()
+		return m, nil
+	}
+	m.setHistoryEntries(msg.kind, msg.entries)
+	return m, nil
+}
@@ -0,0 +1,187 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+)
+
+func newHistoryTestModel(history *spyHistoryUseCase) *Model {
+	model := newSQLConsoleTestModel(&spyRunSQLUseCase{})
+	model.history = history
+	model.ui.commandHistory = []string{"set limit=50", "preview", "w"}
+	model.ui.sqlHistory = []string{"SELECT * FROM users", "DELETE FROM users WHERE id = 1", "SELECT 1"}
+	return model
+}
+
+func TestHandleKey_CommandInputUpAndDownRecallHistoryAndRestoreDraft(t *testing.T) {
+	// Arrange
+	model := newHistoryTestModel(&spyHistoryUseCase{})
+	model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{':'}})
+	typeCommandInputText(model, "se")
+
+	// Act
+	model.handleKey(tea.KeyMsg{Type: tea.KeyUp})
+	model.handleKey(tea.KeyMsg{Type: tea.KeyUp})
+	recalled := model.overlay.commandInput.value
+	model.handleKey(tea.KeyMsg{Type: tea.KeyDown})
+	model.handleKey(tea.KeyMsg{Type: tea.KeyDown})
+
+	// Assert
+	if recalled != "preview" {
+		t.Fatalf("expected second newest command, got %q", recalled)
+	}
+	if model.overlay.commandInput.value != "se" || model.overlay.commandInput.cursor != len("se") {
+		t.Fatalf("expected typed draft to be restored, got %+v", model.overlay.commandInput)
+	}
+}
+
+func TestHandleKey_CommandInputReverseSearchAcceptsMatchWithoutRunningIt(t *testing.T) {
+	// Arrange
+	model := newHistoryTestModel(&spyHistoryUseCase{})
+	model.ui.commandHistory = []string{"set limit=50", "preview", "set limit=10"}
+	model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{':'}})
+
+	// Act
+	model.handleKey(tea.KeyMsg{Type: tea.KeyCtrlR})
+	typeCommandInputText(model, "LIMIT")
+	model.handleKey(tea.KeyMsg{Type: tea.KeyCtrlR})
+	prompt := model.visibleCommandPrompt(80)
+	model.handleKey(tea.KeyMsg{Type: tea.KeyEnter})
+
+	// Assert
+	if prompt != "(reverse-i-search)'LIMIT': set limit=50" {
+		t.Fatalf("unexpected search prompt %q", prompt)
+	}
+	if !model.overlay.commandInput.active || model.overlay.commandInput.value != "set limit=50" {
+		t.Fatalf("expected accepted match in command input, got %+v", model.overlay.commandInput)
+	}
+}
+
+func TestHandleKey_CommandInputReverseSearchEscRestoresTypedText(t *testing.T) {
+	// Arrange
+	model := newHistoryTestModel(&spyHistoryUseCase{})
+	model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{':'}})
+	typeCommandInputText(model, "q")
+
+	// Act
+	model.handleKey(tea.KeyMsg{Type: tea.KeyCtrlR})
+	typeCommandInputText(model, "zzz")
+	prompt := model.visibleCommandPrompt(80)
+	model.handleKey(tea.KeyMsg{Type: tea.KeyEsc})
+
+	// Assert
+	if !strings.HasPrefix(prompt, "(failing reverse-i-search)") {
+		t.Fatalf("expected failing search prompt, got %q", prompt)
+	}
+	if !model.overlay.commandInput.active || model.overlay.commandInput.value != "q" {
+		t.Fatalf("expected original input after cancelled search, got %+v", model.overlay.commandInput)
+	}
+}
+
+func TestSubmitCommandInput_RecordsCommandInHistory(t *testing.T) {
+	// Arrange
+	history := &spyHistoryUseCase{}
+	model := newHistoryTestModel(history)
+
+	// Act
+	cmd := submitRuntimeCommandForTest(model, " preview ")
+	if cmd == nil {
+		t.Fatal("expected history command")
+	}
+	cmd()
+
+	// Assert
+	if history.recordCalls != 1 || history.recordKind != dto.HistoryKindCommands || history.recordEntry != "preview" {
+		t.Fatalf("expected preview to be recorded as a command, got %+v", history)
+	}
+	expected := []string{"set limit=50", "w", "preview"}
+	if strings.Join(model.ui.commandHistory, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected in-memory history %v, got %v", expected, model.ui.commandHistory)
+	}
+}
+
+func TestHandleKey_SQLConsoleRecallsHistoryOnlyFromFirstLine(t *testing.T) {
+	// Arrange
+	model := newHistoryTestModel(&spyHistoryUseCase{})
+	submitRuntimeCommandForTest(model, "sql")
+	model.overlay.sqlConsole.input = ""
+	model.overlay.sqlConsole.cursor = 0
+	typeSQLConsoleTextForTest(model, "SELECT\n2")
+
+	// Act
+	model.handleKey(tea.KeyMsg{Type: tea.KeyUp})
+	afterFirstUp := model.overlay.sqlConsole.input
+	model.handleKey(tea.KeyMsg{Type: tea.KeyUp})
+
+	// Assert
+	if afterFirstUp != "SELECT\n2" {
+		t.Fatalf("expected Up on the last line to move the cursor, got %q", afterFirstUp)
+	}
+	if model.overlay.sqlConsole.input != "SELECT 1" {
+		t.Fatalf("expected newest SQL statement, got %q", model.overlay.sqlConsole.input)
+	}
+}
+
+func TestHandleKey_SQLConsoleReverseSearchAndRunRecordsStatement(t *testing.T) {
+	// Arrange
+	history := &spyHistoryUseCase{}
+	runSQL := &spyRunSQLUseCase{}
+	model := newHistoryTestModel(history)
+	model.runSQL = runSQL
+	submitRuntimeCommandForTest(model, "sql")
+
+	// Act
+	model.handleKey(tea.KeyMsg{Type: tea.KeyCtrlR})
+	typeSQLConsoleTextForTest(model, "delete")
+	summary := model.sqlConsoleResultSummary()
+	model.handleKey(tea.KeyMsg{Type: tea.KeyEnter})
+	_, cmd := model.handleKey(tea.KeyMsg{Type: tea.KeyCtrlE})
+	if cmd == nil {
+		t.Fatal("expected run command")
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		for _, batched := range batch {
+			batched()
+		}
+	}
+
+	// Assert
+	if summary != "(reverse-i-search)'delete': DELETE FROM users WHERE id = 1" {
+		t.Fatalf("unexpected search summary %q", summary)
+	}
+	if runSQL.lastStatement != "DELETE FROM users WHERE id = 1" {
+		t.Fatalf("expected accepted match to run, got %q", runSQL.lastStatement)
+	}
+	if history.recordKind != dto.HistoryKindSQL || history.recordEntry != "DELETE FROM users WHERE id = 1" {
+		t.Fatalf("expected statement to be recorded as SQL, got %+v", history)
+	}
+	if model.ui.sqlHistory[len(model.ui.sqlHistory)-1] != "DELETE FROM users WHERE id = 1" {
+		t.Fatalf("expected statement to become newest SQL entry, got %v", model.ui.sqlHistory)
+	}
+}
+
+func TestUpdate_HistoryMsgLoadsEntriesAndReportsLoadErrors(t *testing.T) {
+	// Arrange
+	model := newHistoryTestModel(&spyHistoryUseCase{})
+	history := &spyHistoryUseCase{entries: map[dto.HistoryKind][]string{dto.HistoryKindCommands: {"q"}}}
+
+	// Act
+	model.Update(loadHistoryCmd(context.Background(), history, dto.HistoryKindCommands, model.runtimeBundleToken)())
+	history.loadErr = errors.New("permission denied")
+	model.Update(loadHistoryCmd(context.Background(), history, dto.HistoryKindSQL, model.runtimeBundleToken)())
+
+	// Assert
+	if strings.Join(model.ui.commandHistory, ",") != "q" {
+		t.Fatalf("expected loaded command history, got %v", model.ui.commandHistory)
+	}
+	if model.ui.statusMessage != "Error: permission denied" {
+		t.Fatalf("expected load error status, got %q", model.ui.statusMessage)
+	}
+}
@@ -0,0 +1,262 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/usecase"
+	"github.com/mgierok/dbc/internal/interfaces/tui/internal/primitives"
+)
+
+// requestImportRecords reads the file at path for staging into the current
+// table. The rows are staged only after the user confirms the column mapping.
+func (m *Model) requestImportRecords(path string) (tea.Model, tea.Cmd) {
+	if !m.nonBlockingRuntimeCommandContextActive() {
+		return m, nil
+	}
+	table := m.currentTableName()
+	if table == "" {
+		m.ui.statusMessage = "Error: no table selected"
+		return m, nil
+	}
+	if m.importRecords == nil {
+		m.ui.statusMessage = "Error: import use case unavailable"
+		return m, nil
+	}
+	if len(m.read.schema.Columns) == 0 {
+		m.ui.statusMessage = "Error: no schema loaded"
+		return m, nil
+	}
+	if !m.ensureCurrentTableWritable(usecase.TableWriteInsert) {
+		return m, nil
+	}
+	m.ui.statusMessage = fmt.Sprintf("Reading %s...", path)
+	return m, readImportFileCmd(m.runtimeReadContext(), m.importRecords, table, path, m.runtimeBundleToken)
+}
+
+func (m *Model) handleImportFileReadMsg(msg importFileReadMsg) (tea.Model, tea.Cmd) {
+	if msg.bundleToken != m.runtimeBundleToken || msg.table != m.currentTableName() {
+		return m, nil
+	}
+	if msg.err != nil {
+		m.ui.statusMessage = "Error: " + msg.err.Error()
+		return m, nil
+	}
+	if len(msg.file.Rows) == 0 {
+		m.ui.statusMessage = fmt.Sprintf("No records in %s", msg.file.Path)
+		return m, nil
+	}
+	m.overlay.importPopup = importPopup{
+		active:  true,
+		step:    importStepMapping,
+		table:   msg.table,
+		file:    msg.file,
+		mapping: m.importRecords.MatchColumns(m.read.schema, msg.file.Headers),
+	}
+	m.ui.statusMessage = ""
+	return m, nil
+}
+
+func (m *Model) handleImportPopupKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.overlay.importPopup.step == importStepReport {
+		return m.handleImportReportKey(msg)
+	}
+	key := msg.String()
+
+	switch {
+	case primitives.KeyMatches(primitives.KeyRuntimeEsc, key):
+		m.overlay.importPopup = importPopup{}
+		m.ui.statusMessage = "Import cancelled"
+	case primitives.KeyMatches(primitives.KeyRuntimeEnter, key):
+		return m.stageImportedRecords()
+	case primitives.KeyMatches(primitives.KeyPopupMoveDown, key):
+		m.moveImportColumn(1)
+	case primitives.KeyMatches(primitives.KeyPopupMoveUp, key):
+		m.moveImportColumn(-1)
+	case primitives.KeyMatches(primitives.KeyPopupJumpTop, key):
+		m.overlay.importPopup.columnIndex = 0
+	case primitives.KeyMatches(primitives.KeyPopupJumpBottom, key):
+		m.overlay.importPopup.columnIndex = len(m.overlay.importPopup.mapping) - 1
+	case primitives.KeyMatches(primitives.KeyImportNextHeader, key):
+		m.cycleImportHeader(1)
+	case primitives.KeyMatches(primitives.KeyImportPreviousHeader, key):
+		m.cycleImportHeader(-1)
+	}
+	return m, nil
+}
+
+func (m *Model) handleImportReportKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+
+	switch {
+	case primitives.KeyMatches(primitives.KeyRuntimeEsc, key), primitives.KeyMatches(primitives.KeyRuntimeEnter, key):
+		m.overlay.importPopup = importPopup{}
+	case primitives.KeyMatches(primitives.KeyPopupMoveDown, key):
+		m.moveImportReportScroll(1)
+	case primitives.KeyMatches(primitives.KeyPopupMoveUp, key):
+		m.moveImportReportScroll(-1)
+	case primitives.KeyMatches(primitives.KeyRuntimePageDown, key):
+		m.moveImportReportScroll(m.importPopupVisibleLines())
+	case primitives.KeyMatches(primitives.KeyRuntimePageUp, key):
+		m.moveImportReportScroll(-m.importPopupVisibleLines())
+	case primitives.KeyMatches(primitives.KeyPopupJumpTop, key):
+		m.overlay.importPopup.scrollOffset = 0
+	case primitives.KeyMatches(primitives.KeyPopupJumpBottom, key):
+		m.overlay.importPopup.scrollOffset = m.importReportMaxOffset()
+	}
+	return m, nil
+}
+
+func (m *Model) moveImportColumn(delta int) {
+	popup := &m.overlay.importPopup
+	popup.columnIndex = clamp(popup.columnIndex+delta, 0, len(popup.mapping)-1)
+}
+
+// cycleImportHeader maps the selected column to the next or previous file
+// header, passing through the unmapped state. Generated columns stay
+// unmapped.
+func (m *Model) cycleImportHeader(delta int) {
+	popup := &m.overlay.importPopup
+	if popup.columnIndex < 0 || popup.columnIndex >= len(popup.mapping) || popup.columnIndex >= len(m.read.schema.Columns) {
+		return
+	}
+	if m.read.schema.Columns[popup.columnIndex].Generated {
+		return
+	}
+	choices := append([]string{""}, popup.file.Headers...)
+	current := 0
+	for i, header := range choices {
+		if header == popup.mapping[popup.columnIndex] {
+			current = i
+			break
+		}
+	}
+	next := (current + delta + len(choices)) % len(choices)
+	popup.mapping[popup.columnIndex] = choices[next]
+}
+
+// stageImportedRecords stages the valid lines of the import as pending
+// inserts in one undoable step and reports the rejected ones.
+func (m *Model) stageImportedRecords() (tea.Model, tea.Cmd) {
+	popup := m.overlay.importPopup
+	if popup.table != m.currentTableName() {
+		m.overlay.importPopup = importPopup{}
+		return m, nil
+	}
+	nullText := ""
+	if m.runtimeSession != nil {
+		nullText = m.runtimeSession.ImportNullText
+	}
+	result, err := m.importRecords.BuildInserts(m.read.schema, popup.file, popup.mapping, nullText)
+	if err != nil {
+		m.ui.statusMessage = "Error: " + err.Error()
+		return m, nil
+	}
+	if _, err := m.stagingSessionUseCase().AddInserts(result.Inserts); err != nil {
+		m.ui.statusMessage = "Error: " + err.Error()
+		return m, nil
+	}
+	m.syncStagingSnapshot()
+	m.ui.statusMessage = fmt.Sprintf("Staged %d row(s) from %s", len(result.Inserts), popup.file.Path)
+	if len(result.Errors) > 0 {
+		m.ui.statusMessage = fmt.Sprintf("%s; %d line(s) rejected", m.ui.statusMessage, rejectedImportLineCount(result.Errors))
+		m.overlay.importPopup = importPopup{
+			active:      true,
+			step:        importStepReport,
+			table:       popup.table,
+			stagedCount: len(result.Inserts),
+			errors:      result.Errors,
+		}
+	} else {
+		m.overlay.importPopup = importPopup{}
+	}
+	if len(result.Inserts) == 0 {
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	if m.read.viewMode != ViewRecords {
+		m.read.viewMode = ViewRecords
+		m.closeRecordDetail()
+		cmd = m.loadRecordsCmd(true)
+	}
+	m.read.focus = FocusContent
+	m.read.recordSelection = 0
+	m.read.recordColumn = m.defaultRecordColumnForRow(0)
+	m.read.recordFieldFocus = false
+	return m, cmd
+}
+
+func rejectedImportLineCount(lineErrors []dto.ImportLineError) int {
+	lines := make(map[int]bool, len(lineErrors))
+	for _, lineError := range lineErrors {
+		lines[lineError.Line] = true
+	}
+	return len(lines)
+}
+
+func (m *Model) moveImportReportScroll(delta int) {
+	m.overlay.importPopup.scrollOffset = clamp(m.overlay.importPopup.scrollOffset+delta, 0, m.importReportMaxOffset())
+}
+
+func (m *Model) importPopupVisibleLines() int {
+	return m.savePreviewVisibleLines()
+}
+
+func (m *Model) importReportMaxOffset() int {
+	maxOffset := len(m.importReportContentLines(m.savePreviewTotalWidth())) - m.importPopupVisibleLines()
+	if maxOffset < 0 {
+		return 0
+	}
+	return maxOffset
+}
+
+func importPopupSpec() primitives.StandardizedPopupSpec {
+	return primitives.StandardizedPopupSpec{
+		ShowScrollIndicator: true,
+		DefaultWidth:        80,
+		MinWidth:            30,
+		MaxWidth:            100,
+	}
+}
+
+// importMappingRows lists every table column with the file header it reads
+// from.
+func (m *Model) importMappingRows() []primitives.StandardizedPopupRow {
+	popup := m.overlay.importPopup
+	lines := make([]primitives.SemanticLine, 0, len(m.read.schema.Columns))
+	for i, column := range m.read.schema.Columns {
+		source := "(skip)"
+		switch {
+		case column.Generated:
+			source = "(generated)"
+		case i < len(popup.mapping) && popup.mapping[i] != "":
+			source = popup.mapping[i]
+		}
+		text := primitives.SanitizeDisplayText(fmt.Sprintf("%s (%s) <- %s", column.Name, column.Type, source), primitives.DisplaySanitizeSingleLine)
+		lines = append(lines, primitives.SemanticText(primitives.SemanticRoleBody, text))
+	}
+	selected := -1
+	if len(lines) > 0 {
+		selected = clamp(popup.columnIndex, 0, len(lines)-1)
+	}
+	return primitives.PopupSemanticSelectableRows(lines, selected)
+}
+
+// importReportContentLines lists each rejected line with the reason, wrapped
+// to the popup width.
+func (m *Model) importReportContentLines(totalWidth int) []string {
+	width := primitives.StandardizedPopupContentWidth(totalWidth, importPopupSpec())
+	lines := make([]string, 0, len(m.overlay.importPopup.errors))
+	for _, lineError := range m.overlay.importPopup.errors {
+		text := fmt.Sprintf("Line %d: %s", lineError.Line, lineError.Message)
+		if lineError.Column != "" {
+			text = fmt.Sprintf("Line %d, %s: %s", lineError.Line, lineError.Column, lineError.Message)
+		}
+		text = primitives.SanitizeDisplayText(text, primitives.DisplaySanitizeSingleLine)
+		lines = append(lines, primitives.WrapTextToWidth(text, width)...)
+	}
+	return lines
+}
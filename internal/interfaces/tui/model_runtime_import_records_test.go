@@ -0,0 +1,158 @@
+package tui
+
+import (
+	"context"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+)
+
+func importFileForTest() dto.ImportFile {
+	return dto.ImportFile{
+		Format:  "csv",
+		Headers: []string{"Name", "years"},
+		Rows: []dto.ImportRow{
+			{Line: 2, Values: map[string]dto.ImportValue{"Name": {Text: "alice"}, "years": {Text: "30"}}},
+			{Line: 3, Values: map[string]dto.ImportValue{"Name": {Text: "bob"}, "years": {Text: "old"}}},
+			{Line: 4, Err: "expected 2 fields, got 1"},
+			{Line: 5, Values: map[string]dto.ImportValue{"Name": {Text: "carol"}, "years": {Text: ""}}},
+		},
+	}
+}
+
+func newImportRecordsTestModel(importRecords *spyImportRecordsUseCase) *Model {
+	return &Model{
+		ctx:           context.Background(),
+		importRecords: importRecords,
+		read: runtimeReadState{
+			viewMode: ViewRecords,
+			focus:    FocusContent,
+			tables:   []dto.Table{{Name: "users"}},
+			schema: dto.Schema{Columns: []dto.SchemaColumn{
+				{Name: "id", Type: "INTEGER", PrimaryKey: true, AutoIncrement: true},
+				{Name: "name", Type: "TEXT"},
+				{Name: "age", Type: "INTEGER", Nullable: true},
+			}},
+		},
+	}
+}
+
+func openImportMappingForTest(t *testing.T, model *Model) {
+	t.Helper()
+	cmd := submitRuntimeCommandForTest(model, "import /tmp/users.csv")
+	if cmd == nil {
+		t.Fatal("expected read command")
+	}
+	model.Update(cmd())
+	if !model.overlay.importPopup.active || model.overlay.importPopup.step != importStepMapping {
+		t.Fatal("expected column mapping popup")
+	}
+}
+
+func TestSubmitCommandInput_ImportStagesMappedLinesAndReportsRejectedOnes(t *testing.T) {
+	// Arrange
+	importRecords := &spyImportRecordsUseCase{file: importFileForTest()}
+	model := newImportRecordsTestModel(importRecords)
+	submitRuntimeCommandForTest(model, "set importnull=")
+	openImportMappingForTest(t, model)
+	mapping := append([]string(nil), model.overlay.importPopup.mapping...)
+
+	// Act
+	model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'G'}})
+	model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'l'}})
+	model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'l'}})
+	model.handleKey(tea.KeyMsg{Type: tea.KeyEnter})
+
+	// Assert
+	if mapping[0] != "" || mapping[1] != "Name" || mapping[2] != "" {
+		t.Fatalf("expected name to be matched ignoring case, got %v", mapping)
+	}
+	if importRecords.lastPath != "/tmp/users.csv" {
+		t.Fatalf("expected file path to be read, got %q", importRecords.lastPath)
+	}
+	inserts := model.currentStagingSnapshot().PendingInserts
+	if len(inserts) != 2 {
+		t.Fatalf("expected two staged inserts, got %d", len(inserts))
+	}
+	if inserts[0].Values[1].Value.Text != "alice" || inserts[0].Values[2].Value.Text != "30" {
+		t.Fatalf("expected first file line on top, got %+v", inserts[0].Values)
+	}
+	if !inserts[1].Values[2].Value.IsNull {
+		t.Fatalf("expected empty age to be read as NULL, got %+v", inserts[1].Values[2].Value)
+	}
+	popup := model.overlay.importPopup
+	if !popup.active || popup.step != importStepReport || len(popup.errors) != 2 {
+		t.Fatalf("expected report of two rejected lines, got %+v", popup)
+	}
+	if model.ui.statusMessage != "Staged 2 row(s) from /tmp/users.csv; 2 line(s) rejected" {
+		t.Fatalf("unexpected status %q", model.ui.statusMessage)
+	}
+	report := model.importReportContentLines(120)
+	if len(report) != 2 || report[0] != "Line 3, age: invalid integer value: invalid value" || report[1] != "Line 4: expected 2 fields, got 1" {
+		t.Fatalf("unexpected report %v", report)
+	}
+}
+
+func TestHandleKey_ImportIsUndoneInOneStep(t *testing.T) {
+	// Arrange
+	model := newImportRecordsTestModel(&spyImportRecordsUseCase{file: importFileForTest()})
+	if _, err := model.stagingSessionUseCase().AddInsert(model.read.schema); err != nil {
+		t.Fatalf("expected insert add, got %v", err)
+	}
+	openImportMappingForTest(t, model)
+	model.handleKey(tea.KeyMsg{Type: tea.KeyEnter})
+	model.handleKey(tea.KeyMsg{Type: tea.KeyEsc})
+	model.syncStagingSnapshot()
+	staged := len(model.currentStagingSnapshot().PendingInserts)
+
+	// Act
+	model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'u'}})
+
+	// Assert
+	if staged != 4 {
+		t.Fatalf("expected three imported rows and one existing insert, got %d", staged)
+	}
+	if got := len(model.currentStagingSnapshot().PendingInserts); got != 1 {
+		t.Fatalf("expected undo to remove every imported row, got %d inserts", got)
+	}
+}
+
+func TestHandleKey_ImportMappingEscCancelsWithoutStaging(t *testing.T) {
+	// Arrange
+	model := newImportRecordsTestModel(&spyImportRecordsUseCase{file: importFileForTest()})
+	openImportMappingForTest(t, model)
+
+	// Act
+	model.handleKey(tea.KeyMsg{Type: tea.KeyEsc})
+
+	// Assert
+	if model.overlay.importPopup.active {
+		t.Fatal("expected import popup to close")
+	}
+	if len(model.currentStagingSnapshot().PendingInserts) != 0 {
+		t.Fatal("expected nothing to be staged")
+	}
+	if model.ui.statusMessage != "Import cancelled" {
+		t.Fatalf("unexpected status %q", model.ui.statusMessage)
+	}
+}
+
+func TestSubmitCommandInput_ImportRefusedInReadOnlySession(t *testing.T) {
+	// Arrange
+	importRecords := &spyImportRecordsUseCase{file: importFileForTest()}
+	model := newImportRecordsTestModel(importRecords)
+	model.readOnly = true
+
+	// Act
+	cmd := submitRuntimeCommandForTest(model, "import /tmp/users.csv")
+
+	// Assert
+	if cmd != nil || importRecords.lastPath != "" {
+		t.Fatal("expected import not to read the file")
+	}
+	if model.ui.statusMessage == "" {
+		t.Fatal("expected read-only status")
+	}
+}
@@ -7,7 +7,7 @@ import (
 )
 
 func (m *Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	if m.ui.saveInFlight || m.ui.runtimeSwitchInFlight {
+	if m.ui.saveInFlight || m.ui.savePreviewInFlight || m.ui.runtimeSwitchInFlight {
 		return m, nil
 	}
 
@@ -15,6 +15,10 @@ func (m *Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleRuntimeDatabaseSelectorKey(msg)
 	}
 
+	if m.sqlConsoleEditorFocused() {
+		return m.handleSQLConsoleEditorKey(msg)
+	}
+
 	key := msg.String()
 
 	if primitives.KeyMatches(primitives.KeyRuntimeOpenContextHelp, key) {
@@ -33,6 +37,12 @@ func (m *Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if m.overlay.helpPopup.active {
 		return m.handleHelpPopupKey(msg)
 	}
+	if m.overlay.savePreview.active {
+		return m.handleSavePreviewKey(msg)
+	}
+	if m.overlay.importPopup.active {
+		return m.handleImportPopupKey(msg)
+	}
 	if m.overlay.editPopup.active {
 		return m.handleEditPopupKey(msg)
 	}
@@ -48,6 +58,9 @@ func (m *Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if m.overlay.commandInput.active {
 		return m.handleCommandInputKey(msg)
 	}
+	if m.overlay.sqlConsole.active {
+		return m.handleSQLConsoleResultsKey(msg)
+	}
 	if m.overlay.recordDetail.active {
 		return m.handleRecordDetailKey(msg)
 	}
@@ -59,6 +72,39 @@ func (m *Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 		m.overlay.pendingG = false
 	}
+	if m.overlay.pendingY {
+		m.overlay.pendingY = false
+		switch {
+		case primitives.KeyMatches(primitives.KeyRuntimeYankRow, key):
+			return m.requestYank(yankTargetRow)
+		case primitives.KeyMatches(primitives.KeyRuntimeYankCell, key):
+			return m.requestYank(yankTargetCell)
+		case primitives.KeyMatches(primitives.KeyRuntimeYankPage, key):
+			return m.requestYank(yankTargetPage)
+		}
+	}
+
+	if m.read.visual.active {
+		switch {
+		case primitives.KeyMatches(primitives.KeyRuntimeVisualLine, key),
+			primitives.KeyMatches(primitives.KeyRuntimeEsc, key):
+			m.exitVisualSelection()
+			return m, nil
+		case primitives.KeyMatches(primitives.KeyRuntimeDelete, key):
+			if !m.ensureSessionWritable() {
+				return m, nil
+			}
+			return m.deleteVisualSelection()
+		case primitives.KeyMatches(primitives.KeyRuntimeEdit, key):
+			if !m.read.recordFieldFocus {
+				return m.enableRecordFieldFocus()
+			}
+			if !m.ensureSessionWritable() {
+				return m, nil
+			}
+			return m.openVisualEditPopup()
+		}
+	}
 
 	switch {
 	case primitives.KeyMatches(primitives.KeyRuntimeJumpTopPending, key):
@@ -66,6 +112,11 @@ func (m *Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	case primitives.KeyMatches(primitives.KeyRuntimeJumpBottom, key):
 		return m.jumpBottom()
+	case primitives.KeyMatches(primitives.KeyRuntimeYankPending, key):
+		if m.read.viewMode == ViewRecords && m.read.focus == FocusContent {
+			m.overlay.pendingY = true
+		}
+		return m, nil
 	case primitives.KeyMatches(primitives.KeyRuntimeEnter, key):
 		if m.read.viewMode == ViewRecords && m.read.focus == FocusContent {
 			return m.openRecordDetail()
@@ -76,6 +127,9 @@ func (m *Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			if !m.read.recordFieldFocus {
 				return m.enableRecordFieldFocus()
 			}
+			if !m.ensureSessionWritable() {
+				return m, nil
+			}
 			return m.openEditPopup()
 		}
 		return m, nil
@@ -94,12 +148,27 @@ func (m *Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.startFilterPopup()
 	case primitives.KeyMatches(primitives.KeyRuntimeSort, key):
 		return m.startSortPopup()
+	case primitives.KeyMatches(primitives.KeyRuntimeSavedViews, key):
+		return m.startSavedViewPicker()
 	case primitives.KeyMatches(primitives.KeyRuntimeRecordDetail, key):
 		return m.openRecordDetail()
 	case primitives.KeyMatches(primitives.KeyRuntimeInsert, key):
+		if !m.ensureSessionWritable() {
+			return m, nil
+		}
 		return m.addPendingInsert()
+	case primitives.KeyMatches(primitives.KeyRuntimeDuplicate, key):
+		if !m.ensureSessionWritable() {
+			return m, nil
+		}
+		return m.duplicateSelectedRow()
 	case primitives.KeyMatches(primitives.KeyRuntimeDelete, key):
+		if !m.ensureSessionWritable() {
+			return m, nil
+		}
 		return m.toggleDeleteSelection()
+	case primitives.KeyMatches(primitives.KeyRuntimeVisualLine, key):
+		return m.toggleVisualSelection()
 	case primitives.KeyMatches(primitives.KeyRuntimeUndo, key):
 		return m.undoStagedAction()
 	case primitives.KeyMatches(primitives.KeyRuntimeRedo, key):
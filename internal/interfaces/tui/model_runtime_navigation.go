@@ -95,25 +95,33 @@ func (m *Model) pageUp() (tea.Model, tea.Cmd) {
 }
 
 func (m *Model) nextRecordPage() (tea.Model, tea.Cmd) {
-	if m.read.recordTotalPages <= 1 {
+	if m.read.recordLoading {
 		return m, nil
 	}
-	if m.read.recordPageIndex >= m.read.recordTotalPages-1 {
+	if m.read.recordCountStatus != recordCountExact {
+		// Until the exact count arrives, the loaded page decides whether
+		// another one exists.
+		if !m.read.recordHasMore {
+			return m, nil
+		}
+	} else if m.read.recordPageIndex >= m.read.recordTotalPages-1 {
 		return m, nil
 	}
 	m.read.recordPageIndex++
-	return m, m.loadRecordsCmd(false)
+	return m, m.loadRecordsFromCursorCmd(m.read.recordNextCursor)
 }
 
 func (m *Model) prevRecordPage() (tea.Model, tea.Cmd) {
-	if m.read.recordTotalPages <= 1 {
-		return m, nil
-	}
-	if m.read.recordPageIndex <= 0 {
+	if m.read.recordPageIndex <= 0 || m.read.recordLoading {
 		return m, nil
 	}
 	m.read.recordPageIndex--
-	return m, m.loadRecordsCmd(false)
+	if m.read.recordPageIndex == 0 {
+		// The first page is always addressable directly; seeking backwards
+		// could come up short if rows were inserted before the cursor.
+		return m, m.loadRecordsCmd(false)
+	}
+	return m, m.loadRecordsFromCursorCmd(m.read.recordPrevCursor)
 }
 
 func (m *Model) jumpTop() (tea.Model, tea.Cmd) {
@@ -152,7 +160,7 @@ func (m *Model) setTableSelection(index int) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 	targetTableName := m.read.tables[index].Name
-	plan := m.navigationWorkflowUseCase().PlanTableSwitch(targetTableName, m.hasDirtyEdits(), m.dirtyEditCount())
+	plan := m.navigationWorkflowUseCase().PlanTableSwitch(targetTableName)
 	return m.applyRuntimeNavigationPlan(plan, "")
 }
 
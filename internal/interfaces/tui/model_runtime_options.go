@@ -0,0 +1,45 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/mgierok/dbc/internal/application/usecase"
+)
+
+// applyRuntimeOption stores a ":set <option>=<value>" setting for the rest of
+// the app session.
+func (m *Model) applyRuntimeOption(option, value string) (tea.Model, tea.Cmd) {
+	if m.runtimeSession == nil {
+		m.runtimeSession = &RuntimeSessionState{}
+	}
+	switch option {
+	case "exportblob":
+		encoding := strings.ToLower(strings.TrimSpace(value))
+		if err := usecase.ValidateExportBlobEncoding(encoding); err != nil {
+			m.ui.statusMessage = "Error: " + err.Error()
+			return m, nil
+		}
+		m.runtimeSession.ExportBlob = encoding
+		m.ui.statusMessage = fmt.Sprintf("Export BLOB encoding set to %s", encoding)
+	case "exportnull":
+		m.runtimeSession.ExportNullText = value
+		m.ui.statusMessage = fmt.Sprintf("Export NULL text set to %q", value)
+	case "importnull":
+		m.runtimeSession.ImportNullText = value
+		m.ui.statusMessage = fmt.Sprintf("Import NULL text set to %q", value)
+	case "yankformat":
+		format := strings.ToLower(strings.TrimSpace(value))
+		if err := usecase.ValidateYankFormat(format); err != nil {
+			m.ui.statusMessage = "Error: " + err.Error()
+			return m, nil
+		}
+		m.runtimeSession.YankFormat = format
+		m.ui.statusMessage = fmt.Sprintf("Yank format set to %s", format)
+	default:
+		m.ui.statusMessage = fmt.Sprintf("Error: unknown option %s", option)
+	}
+	return m, nil
+}
@@ -0,0 +1,125 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+)
+
+// recordCountStatus tracks how trustworthy recordTotalCount is. Counts are
+// loaded after the first page so large tables render rows without waiting.
+type recordCountStatus int
+
+const (
+	recordCountExact recordCountStatus = iota
+	recordCountCounting
+	recordCountEstimated
+	// recordCountUnknown follows a failed count: only the loaded pages bound
+	// the total.
+	recordCountUnknown
+)
+
+type recordCountMsg struct {
+	bundleToken int
+	tableName   string
+	requestID   int
+	count       int
+	estimated   bool
+	err         error
+}
+
+// invalidateRecordCount abandons any running count and queues a new one to
+// start once the next records page arrives.
+func (m *Model) invalidateRecordCount() {
+	m.cancelRecordCount()
+	m.read.recordCountRequestID++
+	m.read.recordCountStatus = recordCountCounting
+	m.read.recordCountQueued = true
+	m.read.recordTotalCount = 0
+	m.read.recordTotalPages = 1
+}
+
+func (m *Model) cancelRecordCount() {
+	if m.read.recordCountCancel != nil {
+		m.read.recordCountCancel()
+	}
+	m.read.recordCountCtx = nil
+	m.read.recordCountCancel = nil
+}
+
+func (m *Model) startRecordCountCmd() tea.Cmd {
+	m.read.recordCountQueued = false
+	tableName := m.currentTableName()
+	if m.countRecords == nil || strings.TrimSpace(tableName) == "" {
+		return nil
+	}
+	m.cancelRecordCount()
+	m.read.recordCountCtx, m.read.recordCountCancel = context.WithCancel(m.runtimeReadContext())
+	return countRecordsCmd(
+		m.read.recordCountCtx,
+		m.countRecords,
+		tableName,
+		m.read.currentFilter,
+		true,
+		m.runtimeBundleToken,
+		m.read.recordCountRequestID,
+	)
+}
+
+func (m *Model) handleRecordCountMsg(msg recordCountMsg) (tea.Model, tea.Cmd) {
+	if msg.bundleToken != m.runtimeBundleToken {
+		return m, nil
+	}
+	if msg.tableName != m.currentTableName() || msg.requestID != m.read.recordCountRequestID {
+		return m, nil
+	}
+	if msg.err != nil {
+		m.cancelRecordCount()
+		if errors.Is(msg.err, context.Canceled) {
+			return m, nil
+		}
+		if m.read.recordCountStatus == recordCountCounting {
+			m.read.recordCountStatus = recordCountUnknown
+		}
+		m.ui.statusMessage = "Error: " + msg.err.Error()
+		return m, nil
+	}
+	m.read.recordTotalCount = msg.count
+	m.read.recordTotalPages = m.computeTotalPages(msg.count)
+	if msg.estimated {
+		m.read.recordCountStatus = recordCountEstimated
+		return m, countRecordsCmd(
+			m.read.recordCountCtx,
+			m.countRecords,
+			msg.tableName,
+			m.read.currentFilter,
+			false,
+			m.runtimeBundleToken,
+			msg.requestID,
+		)
+	}
+	m.cancelRecordCount()
+	m.read.recordCountStatus = recordCountExact
+	m.read.recordPageIndex = clamp(m.read.recordPageIndex, 0, m.read.recordTotalPages-1)
+	return m, nil
+}
+
+func countRecordsCmd(ctx context.Context, uc countRecordsUseCase, tableName string, filter *dto.FilterGroup, withEstimate bool, bundleToken, requestID int) tea.Cmd {
+	if ctx == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		if withEstimate {
+			// Estimates are best-effort; any failure falls through to the exact count.
+			if estimate, ok, err := uc.Estimate(ctx, tableName, filter); err == nil && ok {
+				return recordCountMsg{bundleToken: bundleToken, tableName: tableName, requestID: requestID, count: estimate, estimated: true}
+			}
+		}
+		count, err := uc.Execute(ctx, tableName, filter)
+		return recordCountMsg{bundleToken: bundleToken, tableName: tableName, requestID: requestID, count: count, err: err}
+	}
+}
@@ -0,0 +1,162 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+)
+
+func TestLoadRecords_DefersCountUntilPageArrivesAndShowsEstimateThenExactCount(t *testing.T) {
+	// Arrange
+	recordsSpy := &spyListRecordsUseCase{
+		page: dto.RecordPage{Rows: makeRecordRows(20), HasMore: true},
+	}
+	countSpy := &spyCountRecordsUseCase{count: 45, estimate: 40, hasEstimate: true}
+	model := &Model{
+		ctx:          context.Background(),
+		listRecords:  recordsSpy,
+		countRecords: countSpy,
+		read: runtimeReadState{
+			viewMode: ViewRecords,
+			focus:    FocusContent,
+			tables:   []dto.Table{{Name: "users"}},
+		},
+	}
+
+	// Act
+	loadCmd := model.loadRecordsCmd(true)
+	_, countCmd := model.Update(loadCmd())
+	countingStatus := stripANSI(model.renderStatus(220))
+	countCallsBeforeCount := countSpy.calls
+	_, exactCmd := model.Update(countCmd())
+	estimatedStatus := stripANSI(model.renderStatus(220))
+	if exactCmd == nil {
+		t.Fatal("expected exact count command after estimate")
+	}
+	model.Update(exactCmd())
+	exactStatus := stripANSI(model.renderStatus(220))
+
+	// Assert
+	if countCallsBeforeCount != 0 {
+		t.Fatalf("expected no count before the page arrived, got %d calls", countCallsBeforeCount)
+	}
+	if !strings.Contains(countingStatus, "Records: 20/counting…") || !strings.Contains(countingStatus, "Page: 1/≥2") {
+		t.Fatalf("expected counting placeholder, got %q", countingStatus)
+	}
+	if !strings.Contains(estimatedStatus, "Records: 20/~40") || !strings.Contains(estimatedStatus, "Page: 1/~2") {
+		t.Fatalf("expected estimated count, got %q", estimatedStatus)
+	}
+	if !strings.Contains(exactStatus, "Records: 20/45") || !strings.Contains(exactStatus, "Page: 1/3") {
+		t.Fatalf("expected exact count, got %q", exactStatus)
+	}
+	if model.read.recordCountCancel != nil {
+		t.Fatal("expected finished count to release its context")
+	}
+}
+
+func TestLoadRecords_ResetCancelsRunningCountAndIgnoresStaleResult(t *testing.T) {
+	// Arrange
+	model := &Model{
+		ctx:          context.Background(),
+		listRecords:  &spyListRecordsUseCase{page: dto.RecordPage{Rows: makeRecordRows(1)}},
+		countRecords: &spyCountRecordsUseCase{count: 7},
+		read: runtimeReadState{
+			viewMode: ViewRecords,
+			focus:    FocusContent,
+			tables:   []dto.Table{{Name: "users"}},
+		},
+	}
+	_, staleCountCmd := model.Update(model.loadRecordsCmd(true)())
+	runningCountCtx := model.read.recordCountCtx
+
+	// Act
+	reloadCmd := model.loadRecordsCmd(true)
+	model.Update(staleCountCmd())
+
+	// Assert
+	if reloadCmd == nil {
+		t.Fatal("expected records reload command")
+	}
+	if runningCountCtx == nil || !errors.Is(runningCountCtx.Err(), context.Canceled) {
+		t.Fatal("expected reset to cancel the running count")
+	}
+	if model.read.recordCountStatus != recordCountCounting {
+		t.Fatalf("expected stale count to be ignored, got status %v", model.read.recordCountStatus)
+	}
+}
+
+func TestHandleKey_CtrlFWhileCountingFollowsLoadedPageHasMore(t *testing.T) {
+	// Arrange
+	recordsSpy := &spyListRecordsUseCase{}
+	model := &Model{
+		ctx:         context.Background(),
+		listRecords: recordsSpy,
+		read: runtimeReadState{
+			viewMode:          ViewRecords,
+			focus:             FocusContent,
+			tables:            []dto.Table{{Name: "users"}},
+			recordTotalPages:  1,
+			recordCountStatus: recordCountCounting,
+			recordHasMore:     true,
+		},
+	}
+
+	// Act
+	_, cmd := model.handleKey(tea.KeyMsg{Type: tea.KeyCtrlF})
+	pageAfterNext := model.read.recordPageIndex
+	model.read.recordLoading = false
+	model.read.recordHasMore = false
+	_, blockedCmd := model.handleKey(tea.KeyMsg{Type: tea.KeyCtrlF})
+
+	// Assert
+	if cmd == nil || pageAfterNext != 1 {
+		t.Fatalf("expected next page load while counting, got page %d", pageAfterNext)
+	}
+	if blockedCmd != nil {
+		t.Fatal("expected no next page when the loaded page has no more rows")
+	}
+}
+
+func TestUpdate_RecordCountErrorLeavesCountingAndCancelledCountIsSilent(t *testing.T) {
+	// Arrange
+	model := &Model{
+		read: runtimeReadState{
+			viewMode:             ViewRecords,
+			focus:                FocusContent,
+			tables:               []dto.Table{{Name: "users"}},
+			records:              makeRecordRows(20),
+			recordHasMore:        true,
+			recordCountStatus:    recordCountCounting,
+			recordCountRequestID: 3,
+		},
+	}
+
+	// Act
+	model.Update(recordCountMsg{tableName: "users", requestID: 3, err: context.Canceled})
+	cancelledStatus := model.ui.statusMessage
+	cancelledCount := model.read.recordCountStatus
+	model.Update(recordCountMsg{tableName: "users", requestID: 3, err: errors.New("disk I/O error")})
+	failedStatus := stripANSI(model.renderStatus(220))
+
+	// Assert
+	if cancelledStatus != "" {
+		t.Fatalf("expected cancelled count to leave status untouched, got %q", cancelledStatus)
+	}
+	if cancelledCount != recordCountCounting {
+		t.Fatalf("expected cancelled count to keep the placeholder, got status %v", cancelledCount)
+	}
+	if model.ui.statusMessage != "Error: disk I/O error" {
+		t.Fatalf("expected count error status, got %q", model.ui.statusMessage)
+	}
+	if model.read.recordCountStatus != recordCountUnknown {
+		t.Fatalf("expected failed count to leave counting, got status %v", model.read.recordCountStatus)
+	}
+	if !strings.Contains(failedStatus, "Records: 20/?") || !strings.Contains(failedStatus, "Page: 1/≥2") {
+		t.Fatalf("expected unknown count summary, got %q", failedStatus)
+	}
+}
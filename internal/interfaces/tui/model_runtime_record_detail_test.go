@@ -156,8 +156,8 @@ func TestHandleKey_RecordDetailSetLimitCommandPreservesReloadBehavior(t *testing
 	// Arrange
 	recordsSpy := &spyListRecordsUseCase{
 		page: dto.RecordPage{
-			Rows:       makeRecordRows(10),
-			TotalCount: 45,
+			Rows:    makeRecordRows(10),
+			HasMore: true,
 		},
 	}
 	runtimeSession := &RuntimeSessionState{}
@@ -14,14 +14,15 @@ func TestSubmitCommandInput_SetLimitInRecordsModeReloadsFromFirstPage(t *testing
 	// Arrange
 	recordsSpy := &spyListRecordsUseCase{
 		page: dto.RecordPage{
-			Rows:       makeRecordRows(10),
-			TotalCount: 45,
+			Rows:    makeRecordRows(10),
+			HasMore: true,
 		},
 	}
 	runtimeSession := &RuntimeSessionState{}
 	model := &Model{
 		ctx:            context.Background(),
 		listRecords:    recordsSpy,
+		countRecords:   &spyCountRecordsUseCase{count: 45},
 		runtimeSession: runtimeSession,
 		read: runtimeReadState{
 			viewMode:         ViewRecords,
@@ -44,7 +45,11 @@ func TestSubmitCommandInput_SetLimitInRecordsModeReloadsFromFirstPage(t *testing
 	if cmd == nil {
 		t.Fatal("expected records reload command after setting record limit")
 	}
-	model.Update(cmd())
+	_, countCmd := model.Update(cmd())
+	if countCmd == nil {
+		t.Fatal("expected deferred count command after records reload")
+	}
+	model.Update(countCmd())
 
 	// Assert
 	if runtimeSession.RecordsPageLimit != 10 {
@@ -80,8 +85,8 @@ func TestSubmitCommandInput_SetLimitOutsideRecordsForcesNextRecordsEntryToReload
 	// Arrange
 	recordsSpy := &spyListRecordsUseCase{
 		page: dto.RecordPage{
-			Rows:       makeRecordRows(15),
-			TotalCount: 32,
+			Rows:    makeRecordRows(15),
+			HasMore: true,
 		},
 	}
 	model := &Model{
@@ -0,0 +1,135 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+)
+
+var errSavedViewsUnavailable = errors.New("saved views unavailable")
+
+func (m *Model) startSavedViewPicker() (tea.Model, tea.Cmd) {
+	if m.read.viewMode != ViewRecords || m.read.focus != FocusContent {
+		return m, nil
+	}
+	if m.currentTableName() == "" {
+		return m, nil
+	}
+	return m.requestOpenView("")
+}
+
+// requestSaveView stores the filter and sort of the current table under name.
+func (m *Model) requestSaveView(name string) (tea.Model, tea.Cmd) {
+	table := m.currentTableName()
+	if table == "" {
+		m.ui.statusMessage = "Error: no table selected"
+		return m, nil
+	}
+	if m.savedViews == nil {
+		m.ui.statusMessage = "Error: " + errSavedViewsUnavailable.Error()
+		return m, nil
+	}
+	view := dto.SavedView{
+		Name:  name,
+		Table: table,
+		Sorts: append([]dto.Sort(nil), m.read.currentSort...),
+	}
+	if m.read.currentFilter != nil {
+		group := cloneFilterGroup(m.read.currentFilter)
+		view.Filter = &group
+	}
+	m.ui.statusMessage = fmt.Sprintf("Saving view %q...", name)
+	return m, saveViewCmd(m.ctx, m.savedViews, view, m.runtimeBundleToken)
+}
+
+// requestOpenView applies the saved view called name, or lists the views of
+// the current table in a picker when name is empty.
+func (m *Model) requestOpenView(name string) (tea.Model, tea.Cmd) {
+	table := m.currentTableName()
+	if table == "" {
+		m.ui.statusMessage = "Error: no table selected"
+		return m, nil
+	}
+	if m.savedViews == nil {
+		m.ui.statusMessage = "Error: " + errSavedViewsUnavailable.Error()
+		return m, nil
+	}
+	if name == "" {
+		return m, listSavedViewsCmd(m.runtimeReadContext(), m.savedViews, table, m.runtimeBundleToken)
+	}
+	return m, loadSavedViewCmd(m.runtimeReadContext(), m.savedViews, table, name, m.runtimeBundleToken)
+}
+
+func (m *Model) handleSavedViewSavedMsg(msg savedViewSavedMsg) (tea.Model, tea.Cmd) {
+	if msg.bundleToken != m.runtimeBundleToken {
+		return m, nil
+	}
+	if msg.err != nil {
+		m.ui.statusMessage = "Error: " + msg.err.Error()
+		return m, nil
+	}
+	m.ui.statusMessage = fmt.Sprintf("View %q saved", msg.name)
+	return m, nil
+}
+
+func (m *Model) handleSavedViewListMsg(msg savedViewListMsg) (tea.Model, tea.Cmd) {
+	if msg.bundleToken != m.runtimeBundleToken || msg.table != m.currentTableName() {
+		return m, nil
+	}
+	if msg.err != nil {
+		m.ui.statusMessage = "Error: " + msg.err.Error()
+		return m, nil
+	}
+	if len(msg.names) == 0 {
+		m.ui.statusMessage = fmt.Sprintf("No saved views for %s", msg.table)
+		return m, nil
+	}
+	options := make([]confirmOption, 0, len(msg.names))
+	for _, name := range msg.names {
+		options = append(options, confirmOption{label: name, decisionID: name})
+	}
+	m.openModalConfirmPopupWithOptions(
+		"Saved Views",
+		fmt.Sprintf("Apply a saved filter and sort to %s.", msg.table),
+		options,
+		0,
+	)
+	m.overlay.pendingViewPicker = true
+	return m, nil
+}
+
+// handleSavedViewLoadedMsg replaces the filter and sort of the table the view
+// was saved for and reloads its records from the first page.
+func (m *Model) handleSavedViewLoadedMsg(msg savedViewLoadedMsg) (tea.Model, tea.Cmd) {
+	if msg.bundleToken != m.runtimeBundleToken {
+		return m, nil
+	}
+	if msg.err != nil {
+		m.ui.statusMessage = "Error: " + msg.err.Error()
+		return m, nil
+	}
+	if msg.view.Table != m.currentTableName() {
+		return m, nil
+	}
+	m.read.currentFilter = nil
+	if msg.view.Filter != nil {
+		group := cloneFilterGroup(msg.view.Filter)
+		m.read.currentFilter = &group
+	}
+	m.read.currentSort = append([]dto.Sort(nil), msg.view.Sorts...)
+	m.read.viewMode = ViewRecords
+	m.read.focus = FocusContent
+	m.read.recordFieldFocus = false
+	m.closeRecordDetail()
+	m.ui.statusMessage = fmt.Sprintf("View %q applied", msg.view.Name)
+
+	var cmds []tea.Cmd
+	if len(m.read.schema.Columns) == 0 {
+		cmds = append(cmds, m.loadSchemaCmd())
+	}
+	cmds = append(cmds, m.loadRecordsCmd(true))
+	return m, tea.Batch(cmds...)
+}
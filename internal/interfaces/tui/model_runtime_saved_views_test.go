@@ -0,0 +1,155 @@
+package tui
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+)
+
+func savedViewFilterForTest() *dto.FilterGroup {
+	return singleConditionFilter(dto.Filter{
+		Column:   "status",
+		Operator: dto.Operator{Name: "Equals", Kind: dto.OperatorKindEq, RequiresValue: true},
+		Value:    "active",
+	})
+}
+
+func newSavedViewsTestModel(savedViews *spySavedViewsUseCase) *Model {
+	return &Model{
+		ctx:        context.Background(),
+		savedViews: savedViews,
+		read: runtimeReadState{
+			viewMode: ViewRecords,
+			focus:    FocusContent,
+			tables:   []dto.Table{{Name: "users"}},
+			schema:   dto.Schema{Columns: []dto.SchemaColumn{{Name: "id"}, {Name: "status"}}},
+		},
+	}
+}
+
+func TestSubmitCommandInput_SaveViewStoresCurrentFilterAndSort(t *testing.T) {
+	// Arrange
+	savedViews := &spySavedViewsUseCase{}
+	model := newSavedViewsTestModel(savedViews)
+	model.read.currentFilter = savedViewFilterForTest()
+	model.read.currentSort = []dto.Sort{{Column: "id", Direction: dto.SortDirectionDesc}}
+
+	// Act
+	cmd := submitRuntimeCommandForTest(model, "save-view active")
+	if cmd == nil {
+		t.Fatal("expected save command")
+	}
+	model.Update(cmd())
+
+	// Assert
+	if len(savedViews.saved) != 1 {
+		t.Fatalf("expected one saved view, got %d", len(savedViews.saved))
+	}
+	saved := savedViews.saved[0]
+	if saved.Name != "active" || saved.Table != "users" {
+		t.Fatalf("expected view active for users, got %+v", saved)
+	}
+	assertFilterEqual(t, saved.Filter, savedViewFilterForTest())
+	if !reflect.DeepEqual(saved.Sorts, model.read.currentSort) {
+		t.Fatalf("expected current sort to be saved, got %+v", saved.Sorts)
+	}
+	if model.ui.statusMessage != `View "active" saved` {
+		t.Fatalf("unexpected status %q", model.ui.statusMessage)
+	}
+}
+
+func TestSubmitCommandInput_ViewAppliesSavedFilterAndSort(t *testing.T) {
+	// Arrange
+	sorts := []dto.Sort{{Column: "id", Direction: dto.SortDirectionAsc}}
+	savedViews := &spySavedViewsUseCase{views: []dto.SavedView{
+		{Name: "active", Table: "users", Filter: savedViewFilterForTest(), Sorts: sorts},
+	}}
+	model := newSavedViewsTestModel(savedViews)
+	model.read.viewMode = ViewSchema
+	model.read.focus = FocusTables
+
+	// Act
+	cmd := submitRuntimeCommandForTest(model, "view active")
+	if cmd == nil {
+		t.Fatal("expected load command")
+	}
+	_, reload := model.Update(cmd())
+
+	// Assert
+	assertFilterEqual(t, model.read.currentFilter, savedViewFilterForTest())
+	if !reflect.DeepEqual(model.read.currentSort, sorts) {
+		t.Fatalf("expected saved sort, got %+v", model.read.currentSort)
+	}
+	if model.read.viewMode != ViewRecords || model.read.focus != FocusContent {
+		t.Fatalf("expected records view with content focus, got %v/%v", model.read.viewMode, model.read.focus)
+	}
+	if reload == nil {
+		t.Fatal("expected records to be reloaded")
+	}
+	if model.ui.statusMessage != `View "active" applied` {
+		t.Fatalf("unexpected status %q", model.ui.statusMessage)
+	}
+}
+
+func TestHandleKey_SavedViewsPickerAppliesSelectedView(t *testing.T) {
+	// Arrange
+	savedViews := &spySavedViewsUseCase{views: []dto.SavedView{
+		{Name: "active", Table: "users", Filter: savedViewFilterForTest()},
+		{Name: "newest", Table: "users", Sorts: []dto.Sort{{Column: "id", Direction: dto.SortDirectionDesc}}},
+		{Name: "open", Table: "orders"},
+	}}
+	model := newSavedViewsTestModel(savedViews)
+	model.read.currentFilter = savedViewFilterForTest()
+
+	// Act
+	_, listCmd := model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'P'}})
+	if listCmd == nil {
+		t.Fatal("expected list command")
+	}
+	model.Update(listCmd())
+	options := model.overlay.confirmPopup.options
+	model.handleKey(tea.KeyMsg{Type: tea.KeyDown})
+	_, loadCmd := model.handleKey(tea.KeyMsg{Type: tea.KeyEnter})
+	if loadCmd == nil {
+		t.Fatal("expected load command")
+	}
+	model.Update(loadCmd())
+
+	// Assert
+	if len(options) != 2 || options[0].label != "active" || options[1].label != "newest" {
+		t.Fatalf("expected views of current table in picker, got %+v", options)
+	}
+	if model.overlay.confirmPopup.active || model.overlay.pendingViewPicker {
+		t.Fatal("expected picker to be closed")
+	}
+	if model.read.currentFilter != nil {
+		t.Fatalf("expected filter to be cleared by view without filter, got %+v", model.read.currentFilter)
+	}
+	if len(model.read.currentSort) != 1 || model.read.currentSort[0].Column != "id" {
+		t.Fatalf("expected sort of picked view, got %+v", model.read.currentSort)
+	}
+}
+
+func TestHandleKey_SavedViewsPickerReportsTableWithoutViews(t *testing.T) {
+	// Arrange
+	model := newSavedViewsTestModel(&spySavedViewsUseCase{})
+
+	// Act
+	_, cmd := model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'P'}})
+	if cmd == nil {
+		t.Fatal("expected list command")
+	}
+	model.Update(cmd())
+
+	// Assert
+	if model.overlay.confirmPopup.active {
+		t.Fatal("expected no picker without saved views")
+	}
+	if model.ui.statusMessage != "No saved views for users" {
+		t.Fatalf("unexpected status %q", model.ui.statusMessage)
+	}
+}
@@ -0,0 +1,420 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/interfaces/tui/internal/primitives"
+)
+
+const sqlConsoleTabReplacement = "    "
+
+// openSQLConsole takes over the content panel with the SQL console. The
+// editor keeps its text from the previous visit.
+func (m *Model) openSQLConsole() (tea.Model, tea.Cmd) {
+	if !m.nonBlockingRuntimeCommandContextActive() {
+		return m, nil
+	}
+	m.clearPendingRuntimeKeyState()
+	m.closeRecordDetail()
+	m.overlay.sqlConsole.active = true
+	m.overlay.sqlConsole.focus = sqlConsoleFocusEditor
+	return m, nil
+}
+
+// closeSQLConsole returns to the browsing view and reloads it when a console
+// statement may have changed the data behind it.
+func (m *Model) closeSQLConsole() (tea.Model, tea.Cmd) {
+	m.overlay.sqlConsole.active = false
+	m.overlay.sqlConsole.focus = sqlConsoleFocusEditor
+	return m, m.reloadAfterSQLConsoleChanges()
+}
+
+func (m *Model) reloadAfterSQLConsoleChanges() tea.Cmd {
+	if !m.overlay.sqlConsole.dataChanged {
+		return nil
+	}
+	m.overlay.sqlConsole.dataChanged = false
+	if m.overlay.sqlConsole.schemaChanged {
+		m.overlay.sqlConsole.schemaChanged = false
+		m.stagingWorkspaceUseCase().ForgetSchemas()
+		return reloadTablesCmd(m.runtimeReadContext(), m.listTables, m.runtimeBundleToken)
+	}
+	return m.loadViewForSelection()
+}
+
+// sqlConsoleEditorFocused reports whether keys should be typed into the
+// editor rather than treated as runtime shortcuts.
+func (m *Model) sqlConsoleEditorFocused() bool {
+	return m.overlay.sqlConsole.active &&
+		m.overlay.sqlConsole.focus == sqlConsoleFocusEditor &&
+		!m.overlay.commandInput.active &&
+		m.nonBlockingRuntimeCommandContextActive()
+}
+
+func (m *Model) runSQLConsoleStatement() (tea.Model, tea.Cmd) {
+	if m.overlay.sqlConsole.running {
+		return m, nil
+	}
+	if m.runSQL == nil {
+		m.ui.statusMessage = "Error: SQL use case unavailable"
+		return m, nil
+	}
+	limit := m.effectiveRecordLimit()
+	m.overlay.sqlConsole.recall = historyRecall{}
+	historyCmd := m.recordHistory(dto.HistoryKindSQL, m.overlay.sqlConsole.input)
+	return m, tea.Batch(m.startSQLConsoleRequest(m.overlay.sqlConsole.input, 0, limit, false), historyCmd)
+}
+
+func (m *Model) pageSQLConsoleResults(delta int) (tea.Model, tea.Cmd) {
+	console := m.overlay.sqlConsole
+	if console.running || !console.hasResult || console.result.Kind != dto.SQLStatementQuery || m.runSQL == nil {
+		return m, nil
+	}
+	pageIndex := console.pageIndex + delta
+	if pageIndex < 0 || (delta > 0 && !console.result.HasMore) {
+		return m, nil
+	}
+	limit := m.effectiveRecordLimit()
+	return m, m.startSQLConsoleRequest(console.statement, pageIndex, limit, true)
+}
+
+func (m *Model) startSQLConsoleRequest(statement string, pageIndex, limit int, page bool) tea.Cmd {
+	m.overlay.sqlConsole.running = true
+	m.overlay.sqlConsole.requestID++
+	return sqlConsoleCmd(
+		m.runtimeReadContext(),
+		m.runSQL,
+		statement,
+		pageIndex,
+		limit,
+		page,
+		m.runtimeBundleToken,
+		m.overlay.sqlConsole.requestID,
+	)
+}
+
+func (m *Model) handleSQLConsoleMsg(msg sqlConsoleMsg) (tea.Model, tea.Cmd) {
+	if msg.bundleToken != m.runtimeBundleToken || msg.requestID != m.overlay.sqlConsole.requestID {
+		return m, nil
+	}
+	m.overlay.sqlConsole.running = false
+	if msg.err != nil {
+		m.ui.statusMessage = "Error: " + msg.err.Error()
+		return m, nil
+	}
+
+	console := &m.overlay.sqlConsole
+	console.statement = msg.statement
+	console.result = msg.result
+	console.hasResult = true
+	console.pageIndex = msg.pageIndex
+	console.selection = 0
+	elapsed := formatSQLConsoleElapsed(msg.result.Elapsed)
+	switch msg.result.Kind {
+	case dto.SQLStatementQuery:
+		m.ui.statusMessage = fmt.Sprintf("%d row(s) in %s", len(msg.result.Rows), elapsed)
+		return m, nil
+	case dto.SQLStatementModify:
+		m.ui.statusMessage = fmt.Sprintf("%d row(s) affected in %s", msg.result.RowsAffected, elapsed)
+	default:
+		m.ui.statusMessage = "Statement executed in " + elapsed
+		console.schemaChanged = true
+	}
+	console.dataChanged = true
+	if !console.active {
+		return m, m.reloadAfterSQLConsoleChanges()
+	}
+	return m, nil
+}
+
+func formatSQLConsoleElapsed(elapsed time.Duration) string {
+	if elapsed < time.Millisecond {
+		return elapsed.Round(time.Microsecond).String()
+	}
+	return elapsed.Round(time.Millisecond).String()
+}
+
+func (m *Model) handleSQLConsoleEditorKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	console := &m.overlay.sqlConsole
+	if console.search.active {
+		if value, finished := console.search.handleKey(m.ui.sqlHistory, msg); finished {
+			m.setSQLConsoleInput(value)
+		}
+		return m, nil
+	}
+
+	key := msg.String()
+	switch {
+	case primitives.KeyMatches(primitives.KeyRuntimeEsc, key):
+		return m.closeSQLConsole()
+	case primitives.KeyMatches(primitives.KeySQLConsoleRun, key):
+		return m.runSQLConsoleStatement()
+	case primitives.KeyMatches(primitives.KeySQLConsoleSwitchFocus, key):
+		console.focus = sqlConsoleFocusResults
+		return m, nil
+	case primitives.KeyMatches(primitives.KeySQLConsoleNewline, key):
+		console.input, console.cursor = insertAtCursor(console.input, "\n", console.cursor)
+		console.recall = historyRecall{}
+		return m, nil
+	case primitives.KeyMatches(primitives.KeyHistorySearch, key):
+		console.recall = historyRecall{}
+		console.search = startHistorySearch(console.input)
+		return m, nil
+	case primitives.KeyMatches(primitives.KeyInputMoveLeft, key):
+		console.cursor = previousRuneOffset(console.input, console.cursor)
+		return m, nil
+	case primitives.KeyMatches(primitives.KeyInputMoveRight, key):
+		console.cursor = nextRuneOffset(console.input, console.cursor)
+		return m, nil
+	case primitives.KeyMatches(primitives.KeySQLConsoleMoveUp, key):
+		if onFirstLine(console.input, console.cursor) {
+			if value, ok := console.recall.previous(m.ui.sqlHistory, console.input); ok {
+				m.setSQLConsoleInput(value)
+			}
+			return m, nil
+		}
+		console.cursor = moveCursorVertically(console.input, console.cursor, -1)
+		return m, nil
+	case primitives.KeyMatches(primitives.KeySQLConsoleMoveDown, key):
+		if onLastLine(console.input, console.cursor) {
+			if value, ok := console.recall.next(m.ui.sqlHistory, console.input); ok {
+				m.setSQLConsoleInput(value)
+			}
+			return m, nil
+		}
+		console.cursor = moveCursorVertically(console.input, console.cursor, 1)
+		return m, nil
+	case primitives.KeyMatches(primitives.KeyInputBackspace, key):
+		cursor := clamp(console.cursor, 0, len(console.input))
+		previous := previousRuneOffset(console.input, cursor)
+		console.input = console.input[:previous] + console.input[cursor:]
+		console.cursor = previous
+		console.recall = historyRecall{}
+		return m, nil
+	}
+
+	if msg.Type == tea.KeyRunes || msg.Type == tea.KeySpace {
+		insert := string(msg.Runes)
+		if msg.Type == tea.KeySpace {
+			insert = " "
+		}
+		insert = strings.NewReplacer("\r\n", "\n", "\r", "\n", "\t", sqlConsoleTabReplacement).Replace(insert)
+		console.input, console.cursor = insertAtCursor(console.input, insert, console.cursor)
+		console.recall = historyRecall{}
+	}
+	return m, nil
+}
+
+func (m *Model) handleSQLConsoleResultsKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+	rowCount := len(m.overlay.sqlConsole.result.Rows)
+	switch {
+	case primitives.KeyMatches(primitives.KeyRuntimeEsc, key):
+		return m.closeSQLConsole()
+	case primitives.KeyMatches(primitives.KeySQLConsoleSwitchFocus, key):
+		m.overlay.sqlConsole.focus = sqlConsoleFocusEditor
+	case primitives.KeyMatches(primitives.KeyPopupMoveDown, key):
+		m.overlay.sqlConsole.selection = clamp(m.overlay.sqlConsole.selection+1, 0, primitives.MaxInt(0, rowCount-1))
+	case primitives.KeyMatches(primitives.KeyPopupMoveUp, key):
+		m.overlay.sqlConsole.selection = clamp(m.overlay.sqlConsole.selection-1, 0, primitives.MaxInt(0, rowCount-1))
+	case primitives.KeyMatches(primitives.KeyPopupJumpTop, key):
+		m.overlay.sqlConsole.selection = 0
+	case primitives.KeyMatches(primitives.KeyPopupJumpBottom, key):
+		m.overlay.sqlConsole.selection = primitives.MaxInt(0, rowCount-1)
+	case primitives.KeyMatches(primitives.KeyRuntimePageDown, key):
+		return m.pageSQLConsoleResults(1)
+	case primitives.KeyMatches(primitives.KeyRuntimePageUp, key):
+		return m.pageSQLConsoleResults(-1)
+	}
+	return m, nil
+}
+
+func previousRuneOffset(value string, cursor int) int {
+	cursor = clamp(cursor, 0, len(value))
+	if cursor == 0 {
+		return 0
+	}
+	_, size := utf8.DecodeLastRuneInString(value[:cursor])
+	return cursor - size
+}
+
+func nextRuneOffset(value string, cursor int) int {
+	cursor = clamp(cursor, 0, len(value))
+	if cursor == len(value) {
+		return cursor
+	}
+	_, size := utf8.DecodeRuneInString(value[cursor:])
+	return cursor + size
+}
+
+// moveCursorVertically moves cursor to the adjacent line, keeping its rune
+// column where that line is long enough.
+// onFirstLine and onLastLine tell whether Up and Down should recall history
+// instead of moving between the lines of a multi-line statement.
+func onFirstLine(value string, cursor int) bool {
+	return !strings.Contains(value[:clamp(cursor, 0, len(value))], "\n")
+}
+
+func onLastLine(value string, cursor int) bool {
+	return !strings.Contains(value[clamp(cursor, 0, len(value)):], "\n")
+}
+
+func moveCursorVertically(value string, cursor, delta int) int {
+	cursor = clamp(cursor, 0, len(value))
+	lineStart := strings.LastIndex(value[:cursor], "\n") + 1
+	column := utf8.RuneCountInString(value[lineStart:cursor])
+
+	var targetStart int
+	if delta < 0 {
+		if lineStart == 0 {
+			return 0
+		}
+		targetStart = strings.LastIndex(value[:lineStart-1], "\n") + 1
+	} else {
+		lineEnd := strings.Index(value[cursor:], "\n")
+		if lineEnd == -1 {
+			return len(value)
+		}
+		targetStart = cursor + lineEnd + 1
+	}
+
+	targetEnd := strings.Index(value[targetStart:], "\n")
+	if targetEnd == -1 {
+		targetEnd = len(value)
+	} else {
+		targetEnd += targetStart
+	}
+	offset := targetStart
+	for i := 0; i < column && offset < targetEnd; i++ {
+		offset = nextRuneOffset(value, offset)
+	}
+	return offset
+}
+
+func (m *Model) renderSQLConsole(width, height int) []string {
+	return m.renderSQLConsoleWithStyles(width, height, m.styles)
+}
+
+// renderSQLConsoleWithStyles stacks the editor, a result summary line, and
+// the result grid, which reuses the records grid formatting.
+func (m *Model) renderSQLConsoleWithStyles(width, height int, styles primitives.RenderStyles) []string {
+	const (
+		minEditorHeight = 3
+		maxEditorHeight = 10
+	)
+
+	console := m.overlay.sqlConsole
+	editorLines := strings.Split(console.input, "\n")
+	editorHeight := clamp(len(editorLines), minEditorHeight, primitives.MaxInt(minEditorHeight, primitives.MinInt(maxEditorHeight, height/3)))
+	lines := make([]string, 0, height)
+	lines = append(lines, m.sqlConsoleEditorLines(editorLines, width, editorHeight, styles)...)
+	lines = append(lines, styles.Render(primitives.SemanticRoleMuted, strings.Repeat(primitives.FrameHorizontal, width)))
+	lines = append(lines, primitives.PadRight(styles.Render(primitives.SemanticRoleSummary, truncateToWidth(m.sqlConsoleResultSummary(), width)), width))
+
+	gridHeight := height - len(lines)
+	if gridHeight > 0 {
+		lines = append(lines, m.sqlConsoleGridLines(width, gridHeight, styles)...)
+	}
+	return primitives.PadLines(lines, height, width)
+}
+
+func (m *Model) sqlConsoleEditorLines(editorLines []string, width, height int, styles primitives.RenderStyles) []string {
+	console := m.overlay.sqlConsole
+	cursor := clamp(console.cursor, 0, len(console.input))
+	cursorLine := strings.Count(console.input[:cursor], "\n")
+	cursorColumn := cursor - (strings.LastIndex(console.input[:cursor], "\n") + 1)
+	showCaret := console.focus == sqlConsoleFocusEditor
+
+	gutterWidth := len(fmt.Sprint(len(editorLines))) + 1
+	textWidth := primitives.MaxInt(1, width-gutterWidth)
+	start := primitives.ScrollStart(cursorLine, height, len(editorLines))
+	end := primitives.MinInt(len(editorLines), start+height)
+	lines := make([]string, 0, height)
+	for i := start; i < end; i++ {
+		text := editorLines[i]
+		if showCaret && i == cursorLine {
+			text = text[:cursorColumn] + "|" + text[cursorColumn:]
+		}
+		text = primitives.SanitizeDisplayText(text, primitives.DisplaySanitizeSingleLine)
+		gutter := styles.Render(primitives.SemanticRoleMuted, primitives.PadRight(fmt.Sprint(i+1), gutterWidth))
+		lines = append(lines, primitives.PadRight(gutter+styles.Render(primitives.SemanticRoleBody, primitives.Truncate(text, textWidth)), width))
+	}
+	return primitives.PadLines(lines, height, width)
+}
+
+func (m *Model) sqlConsoleResultSummary() string {
+	console := m.overlay.sqlConsole
+	switch {
+	case console.search.active:
+		return strings.ReplaceAll(console.search.prompt(m.ui.sqlHistory), "\n", " ")
+	case console.running:
+		return "Running…"
+	case !console.hasResult:
+		return primitives.RuntimeSQLConsoleHintLine()
+	}
+
+	elapsed := formatSQLConsoleElapsed(console.result.Elapsed)
+	switch console.result.Kind {
+	case dto.SQLStatementQuery:
+		more := ""
+		if console.result.HasMore {
+			more = ", more available"
+		}
+		return fmt.Sprintf("Page %d: %d row(s)%s in %s", console.pageIndex+1, len(console.result.Rows), more, elapsed)
+	case dto.SQLStatementModify:
+		return fmt.Sprintf("%d row(s) affected in %s", console.result.RowsAffected, elapsed)
+	default:
+		return "Statement executed in " + elapsed
+	}
+}
+
+func (m *Model) sqlConsoleGridLines(width, height int, styles primitives.RenderStyles) []string {
+	console := m.overlay.sqlConsole
+	lines := make([]string, 0, height)
+	if !console.hasResult || console.result.Kind != dto.SQLStatementQuery {
+		return primitives.PadLines(lines, height, width)
+	}
+	if len(console.result.Columns) == 0 {
+		lines = append(lines, primitives.PadRight(styles.Render(primitives.SemanticRoleBody, "No columns returned."), width))
+		return primitives.PadLines(lines, height, width)
+	}
+
+	const recordSelectionPrefixWidth = 2
+
+	rowWidth := primitives.MaxInt(1, width-recordSelectionPrefixWidth)
+	columnWidths := allocateColumnWidths(rowWidth, len(console.result.Columns))
+	headerPrefix := strings.Repeat(" ", recordSelectionPrefixWidth)
+	for _, headerRow := range formatRecordsHeaderRows(console.result.Columns, columnWidths, styles) {
+		lines = append(lines, primitives.PadRight(headerPrefix+headerRow, width))
+	}
+
+	listHeight := height - len(lines)
+	if listHeight < 1 {
+		return primitives.PadLines(lines, height, width)
+	}
+	rows := console.result.Rows
+	if len(rows) == 0 {
+		lines = append(lines, primitives.PadRight(styles.Render(primitives.SemanticRoleBody, "No rows."), width))
+		return primitives.PadLines(lines, height, width)
+	}
+
+	start := primitives.ScrollStart(console.selection, listHeight, len(rows))
+	end := primitives.MinInt(len(rows), start+listHeight)
+	for i := start; i < end; i++ {
+		prefix := primitives.SelectionUnselectedPrefix()
+		role := primitives.SemanticRoleBody
+		if console.focus == sqlConsoleFocusResults && i == console.selection {
+			prefix = primitives.SelectionSelectedPrefix()
+			role = primitives.SemanticRoleSelected
+		}
+		row := formatRecordRow(rows[i].Values, columnWidths, -1)
+		lines = append(lines, styles.Render(role, primitives.PadRight(prefix+row, width)))
+	}
+	return primitives.PadLines(lines, height, width)
+}
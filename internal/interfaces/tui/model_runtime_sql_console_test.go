@@ -0,0 +1,290 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+)
+
+func newSQLConsoleTestModel(runSQL *spyRunSQLUseCase) *Model {
+	return &Model{
+		ctx:    context.Background(),
+		runSQL: runSQL,
+		read: runtimeReadState{
+			viewMode: ViewSchema,
+			focus:    FocusTables,
+			tables:   []dto.Table{{Name: "users"}},
+		},
+	}
+}
+
+func typeSQLConsoleTextForTest(model *Model, text string) {
+	model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(text)})
+}
+
+func TestSubmitCommandInput_SQLOpensConsoleWithEditorFocus(t *testing.T) {
+	// Arrange
+	model := newSQLConsoleTestModel(&spyRunSQLUseCase{})
+
+	// Act
+	submitRuntimeCommandForTest(model, "sql")
+
+	// Assert
+	if !model.overlay.sqlConsole.active || model.overlay.sqlConsole.focus != sqlConsoleFocusEditor {
+		t.Fatalf("expected SQL console with editor focus, got %+v", model.overlay.sqlConsole)
+	}
+	if model.contentPanelTitle() != "SQL Console" {
+		t.Fatalf("expected SQL Console title, got %q", model.contentPanelTitle())
+	}
+}
+
+func TestHandleKey_SQLConsoleEditorTypesRuntimeShortcutKeys(t *testing.T) {
+	// Arrange
+	model := newSQLConsoleTestModel(&spyRunSQLUseCase{})
+	submitRuntimeCommandForTest(model, "sql")
+
+	// Act
+	typeSQLConsoleTextForTest(model, "SELECT ':?'")
+	model.handleKey(tea.KeyMsg{Type: tea.KeyEnter})
+	typeSQLConsoleTextForTest(model, "q")
+
+	// Assert
+	if model.overlay.sqlConsole.input != "SELECT ':?'\nq" {
+		t.Fatalf("unexpected editor text %q", model.overlay.sqlConsole.input)
+	}
+	if model.overlay.commandInput.active || model.overlay.helpPopup.active {
+		t.Fatal("expected editor to keep : and ? as text")
+	}
+}
+
+func TestHandleKey_SQLConsoleEditorMovesCursorAcrossLines(t *testing.T) {
+	// Arrange
+	model := newSQLConsoleTestModel(&spyRunSQLUseCase{})
+	submitRuntimeCommandForTest(model, "sql")
+	typeSQLConsoleTextForTest(model, "SELECT\n1")
+
+	// Act
+	model.handleKey(tea.KeyMsg{Type: tea.KeyUp})
+	typeSQLConsoleTextForTest(model, "é")
+	model.handleKey(tea.KeyMsg{Type: tea.KeyBackspace})
+	model.handleKey(tea.KeyMsg{Type: tea.KeyBackspace})
+
+	// Assert
+	if model.overlay.sqlConsole.input != "ELECT\n1" {
+		t.Fatalf("unexpected editor text %q", model.overlay.sqlConsole.input)
+	}
+	if model.overlay.sqlConsole.cursor != 0 {
+		t.Fatalf("expected cursor at start, got %d", model.overlay.sqlConsole.cursor)
+	}
+}
+
+func TestHandleKey_SQLConsoleRunShowsQueryResultGrid(t *testing.T) {
+	// Arrange
+	runSQL := &spyRunSQLUseCase{result: dto.SQLResult{
+		Kind:    dto.SQLStatementQuery,
+		Columns: []string{"id", "name"},
+		Rows:    []dto.RecordRow{{Values: []string{"1", "alice"}}, {Values: []string{"2", "<blob 4 bytes>"}}},
+		HasMore: true,
+		Elapsed: 3 * time.Millisecond,
+	}}
+	model := newSQLConsoleTestModel(runSQL)
+	model.ui.width = 100
+	model.ui.height = 24
+	submitRuntimeCommandForTest(model, "sql")
+	typeSQLConsoleTextForTest(model, "SELECT id, name FROM users")
+
+	// Act
+	_, cmd := model.handleKey(tea.KeyMsg{Type: tea.KeyCtrlE})
+	if cmd == nil {
+		t.Fatal("expected SQL command")
+	}
+	model.Update(cmd())
+
+	// Assert
+	if runSQL.lastStatement != "SELECT id, name FROM users" || runSQL.lastLimit != model.effectiveRecordLimit() {
+		t.Fatalf("unexpected SQL call %+v", runSQL)
+	}
+	if model.ui.statusMessage != "2 row(s) in 3ms" {
+		t.Fatalf("unexpected status %q", model.ui.statusMessage)
+	}
+	view := stripANSI(model.View())
+	for _, expected := range []string{"SQL Console", "name", "alice", "<blob 4 bytes>", "Page 1: 2 row(s), more available"} {
+		if !strings.Contains(view, expected) {
+			t.Fatalf("expected view to contain %q, got %q", expected, view)
+		}
+	}
+}
+
+func TestHandleKey_SQLConsoleResultsPageDownReadsNextPage(t *testing.T) {
+	// Arrange
+	runSQL := &spyRunSQLUseCase{result: dto.SQLResult{Kind: dto.SQLStatementQuery, Columns: []string{"id"}}}
+	model := newSQLConsoleTestModel(runSQL)
+	model.overlay.sqlConsole = sqlConsoleState{
+		active:    true,
+		focus:     sqlConsoleFocusResults,
+		statement: "SELECT id FROM users",
+		hasResult: true,
+		result:    dto.SQLResult{Kind: dto.SQLStatementQuery, Columns: []string{"id"}, HasMore: true},
+	}
+
+	// Act
+	_, cmd := model.handleKey(tea.KeyMsg{Type: tea.KeyCtrlF})
+	if cmd == nil {
+		t.Fatal("expected page command")
+	}
+	model.Update(cmd())
+
+	// Assert
+	limit := model.effectiveRecordLimit()
+	if runSQL.pageCalls != 1 || runSQL.lastOffset != limit || runSQL.lastLimit != limit {
+		t.Fatalf("expected second page at offset %d, got %+v", limit, runSQL)
+	}
+	if model.overlay.sqlConsole.pageIndex != 1 {
+		t.Fatalf("expected page index 1, got %d", model.overlay.sqlConsole.pageIndex)
+	}
+}
+
+func TestHandleKey_SQLConsoleResultsDoNotPageStatementsWithoutRows(t *testing.T) {
+	// Arrange
+	runSQL := &spyRunSQLUseCase{}
+	model := newSQLConsoleTestModel(runSQL)
+	model.overlay.sqlConsole = sqlConsoleState{
+		active:    true,
+		focus:     sqlConsoleFocusResults,
+		statement: "DELETE FROM users",
+		hasResult: true,
+		result:    dto.SQLResult{Kind: dto.SQLStatementModify, RowsAffected: 2},
+	}
+
+	// Act
+	_, cmd := model.handleKey(tea.KeyMsg{Type: tea.KeyCtrlF})
+
+	// Assert
+	if cmd != nil || runSQL.pageCalls != 0 {
+		t.Fatal("expected no page request for a modifying statement")
+	}
+}
+
+func TestHandleKey_SQLConsoleModifyReportsAffectedRowsAndReloadsOnClose(t *testing.T) {
+	// Arrange
+	runSQL := &spyRunSQLUseCase{result: dto.SQLResult{
+		Kind:         dto.SQLStatementModify,
+		RowsAffected: 2,
+		Elapsed:      12 * time.Millisecond,
+	}}
+	model := newSQLConsoleTestModel(runSQL)
+	getSchema := &stubGetSchemaUseCase{}
+	model.getSchema = getSchema
+	submitRuntimeCommandForTest(model, "sql")
+	typeSQLConsoleTextForTest(model, "DELETE FROM users")
+	_, cmd := model.handleKey(tea.KeyMsg{Type: tea.KeyCtrlE})
+	model.Update(cmd())
+
+	// Act
+	_, reloadCmd := model.handleKey(tea.KeyMsg{Type: tea.KeyEsc})
+
+	// Assert
+	if model.ui.statusMessage != "2 row(s) affected in 12ms" {
+		t.Fatalf("unexpected status %q", model.ui.statusMessage)
+	}
+	if model.overlay.sqlConsole.active {
+		t.Fatal("expected Esc to close the SQL console")
+	}
+	if reloadCmd == nil {
+		t.Fatal("expected closing the console to reload the current view")
+	}
+	if _, ok := reloadCmd().(schemaMsg); !ok || getSchema.lastTableName != "users" {
+		t.Fatalf("expected users schema reload, got table %q", getSchema.lastTableName)
+	}
+	if model.overlay.sqlConsole.input != "DELETE FROM users" {
+		t.Fatalf("expected editor text to survive closing, got %q", model.overlay.sqlConsole.input)
+	}
+}
+
+func TestHandleKey_SQLConsoleDDLReloadsTablesAndForgetsSchemasOnClose(t *testing.T) {
+	// Arrange
+	runSQL := &spyRunSQLUseCase{result: dto.SQLResult{Kind: dto.SQLStatementOther, Elapsed: time.Millisecond}}
+	model := newSQLConsoleTestModel(runSQL)
+	listTables := &stubListTablesUseCase{tables: []dto.Table{{Name: "accounts"}, {Name: "users"}}}
+	getSchema := &stubGetSchemaUseCase{}
+	model.listTables = listTables
+	model.getSchema = getSchema
+	workspace := model.stagingWorkspaceUseCase()
+	workspace.SetSchema("orders", dto.Schema{Columns: []dto.SchemaColumn{
+		{Name: "id", Type: "INTEGER", PrimaryKey: true},
+		{Name: "note", Type: "TEXT", Nullable: true},
+	}})
+	identity := dto.RecordIdentity{Keys: []dto.RecordIdentityKey{{Column: "id", Value: dto.StagedValue{Text: "1", Raw: int64(1)}}}}
+	if err := workspace.Session("orders").StagePersistedEdit("id=1", identity, 1, dto.StagedValue{Text: "old"}, dto.StagedValue{Text: "new", Raw: "new"}); err != nil {
+		t.Fatalf("expected staged edit, got %v", err)
+	}
+	submitRuntimeCommandForTest(model, "sql")
+	typeSQLConsoleTextForTest(model, "ALTER TABLE orders ADD COLUMN total INTEGER")
+	_, cmd := model.handleKey(tea.KeyMsg{Type: tea.KeyCtrlE})
+	model.Update(cmd())
+
+	// Act
+	_, reloadCmd := model.handleKey(tea.KeyMsg{Type: tea.KeyEsc})
+
+	// Assert
+	if reloadCmd == nil {
+		t.Fatal("expected closing the console to reload the table list")
+	}
+	_, schemaCmd := model.Update(reloadCmd())
+	if listTables.calls != 1 {
+		t.Fatalf("expected one table list reload, got %d", listTables.calls)
+	}
+	if got := model.currentTableName(); got != "users" {
+		t.Fatalf("expected users to stay selected after the reload, got %q", got)
+	}
+	if schemaCmd == nil {
+		t.Fatal("expected the reloaded table list to reload the current view")
+	}
+	if _, ok := schemaCmd().(schemaMsg); !ok || getSchema.lastTableName != "users" {
+		t.Fatalf("expected users schema reload, got table %q", getSchema.lastTableName)
+	}
+	if _, err := workspace.BuildDatabaseChanges(); err == nil || !strings.Contains(err.Error(), `schema for table "orders" unavailable`) {
+		t.Fatalf("expected staged orders changes to wait for a fresh schema, got %v", err)
+	}
+}
+
+func TestHandleKey_SQLConsoleErrorShowsStatus(t *testing.T) {
+	// Arrange
+	runSQL := &spyRunSQLUseCase{err: errors.New("no such table: missing")}
+	model := newSQLConsoleTestModel(runSQL)
+	submitRuntimeCommandForTest(model, "sql")
+	typeSQLConsoleTextForTest(model, "SELECT * FROM missing")
+
+	// Act
+	_, cmd := model.handleKey(tea.KeyMsg{Type: tea.KeyCtrlE})
+	model.Update(cmd())
+
+	// Assert
+	if model.ui.statusMessage != "Error: no such table: missing" {
+		t.Fatalf("unexpected status %q", model.ui.statusMessage)
+	}
+	if model.overlay.sqlConsole.running {
+		t.Fatal("expected console to stop running after an error")
+	}
+}
+
+func TestHandleKey_SQLConsoleResultsOpenContextHelp(t *testing.T) {
+	// Arrange
+	model := newSQLConsoleTestModel(&spyRunSQLUseCase{})
+	submitRuntimeCommandForTest(model, "sql")
+	model.handleKey(tea.KeyMsg{Type: tea.KeyTab})
+
+	// Act
+	model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'?'}})
+
+	// Assert
+	if !model.overlay.helpPopup.active || model.helpPopupContextTitle() != "Context Help: SQL Console" {
+		t.Fatalf("expected SQL console help, got %+v", model.overlay.helpPopup)
+	}
+}
@@ -1,6 +1,8 @@
 package tui
 
 import (
+	"context"
+
 	"github.com/mgierok/dbc/internal/application/dto"
 	"github.com/mgierok/dbc/internal/application/usecase"
 )
@@ -21,14 +23,25 @@ type runtimeReadState struct {
 	recordPageIndex  int
 	recordTotalPages int
 	recordTotalCount int
+	recordHasMore    bool
+	recordNextCursor string
+	recordPrevCursor string
 	recordSelection  int
 	recordColumn     int
 	recordRequestID  int
 	recordLoading    bool
 	recordFieldFocus bool
 
-	currentFilter *dto.Filter
-	currentSort   *dto.Sort
+	recordCountStatus    recordCountStatus
+	recordCountQueued    bool
+	recordCountRequestID int
+	recordCountCtx       context.Context
+	recordCountCancel    context.CancelFunc
+
+	currentFilter *dto.FilterGroup
+	currentSort   []dto.Sort
+
+	visual visualSelection
 }
 
 // runtimeOverlayState keeps popup/input state and overlay-specific deferred
@@ -38,14 +51,20 @@ type runtimeOverlayState struct {
 	sortPopup        sortPopup
 	commandInput     commandInput
 	helpPopup        helpPopup
+	savePreview      savePreviewPopup
+	importPopup      importPopup
 	recordDetail     recordDetailState
+	sqlConsole       sqlConsoleState
 	editPopup        editPopup
 	confirmPopup     confirmPopup
 	databaseSelector runtimeDatabaseSelectorPopup
 
-	pendingFilterOpen bool
-	pendingSortOpen   bool
-	pendingG          bool
+	pendingFilterOpen   bool
+	pendingSortOpen     bool
+	pendingG            bool
+	pendingY            bool
+	pendingSaveConflict *saveConflictState
+	pendingViewPicker   bool
 }
 
 // runtimeUIState keeps terminal/session-shell state together so display sizing
@@ -56,9 +75,12 @@ type runtimeUIState struct {
 
 	statusMessage            string
 	saveInFlight             bool
+	savePreviewInFlight      bool
 	pendingSaveSuccessAction usecase.RuntimeSaveSuccessAction
 	runtimeSwitchInFlight    bool
 	openConfigSelector       bool
 	pendingNavigation        *usecase.PendingRuntimeNavigation
 	pendingCommandInput      string
+	commandHistory           []string
+	sqlHistory               []string
 }
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/usecase"
 )
 
 func (m *Model) applyRuntimeRunDeps(runtimeDeps RuntimeRunDeps) {
@@ -14,13 +15,45 @@ func (m *Model) applyRuntimeRunDeps(runtimeDeps RuntimeRunDeps) {
 	m.listTables = runtimeDeps.ListTables
 	m.getSchema = runtimeDeps.GetSchema
 	m.listRecords = runtimeDeps.ListRecords
+	if runtimeDeps.CountRecords != nil {
+		m.countRecords = runtimeDeps.CountRecords
+	}
 	m.listOperators = runtimeDeps.ListOperators
 	m.saveChanges = runtimeDeps.SaveChanges
+	if runtimeDeps.PreviewChanges != nil {
+		m.previewChanges = runtimeDeps.PreviewChanges
+	}
+	if runtimeDeps.ExportChanges != nil {
+		m.exportChanges = runtimeDeps.ExportChanges
+	}
+	if runtimeDeps.ExportRecords != nil {
+		m.exportRecords = runtimeDeps.ExportRecords
+	}
+	if runtimeDeps.ImportRecords != nil {
+		m.importRecords = runtimeDeps.ImportRecords
+	}
+	if runtimeDeps.YankRecords != nil {
+		m.yankRecords = runtimeDeps.YankRecords
+	}
+	if runtimeDeps.ReadRecord != nil {
+		m.readRecord = runtimeDeps.ReadRecord
+	}
+	if runtimeDeps.RunSQL != nil {
+		m.runSQL = runtimeDeps.RunSQL
+	}
+	if runtimeDeps.History != nil {
+		m.history = runtimeDeps.History
+	}
+	if runtimeDeps.SavedViews != nil {
+		m.savedViews = runtimeDeps.SavedViews
+	}
 	m.saveWorkflow = runtimeDeps.SaveWorkflow
 	m.recordLimitPolicy = runtimeDeps.RecordLimitPolicy
 	m.navigationWorkflow = runtimeDeps.NavigationWorkflow
 	m.databaseTargetResolver = runtimeDeps.DatabaseTargetResolver
 	m.translator = runtimeDeps.Translator
+	m.readOnly = runtimeDeps.ReadOnly
+	m.confirmSave = runtimeDeps.ConfirmSave
 	m.runtimeDatabaseSelectorDeps = runtimeDeps.DatabaseSelector
 	m.runtimeClose = runtimeDeps.Close
 }
@@ -96,7 +129,10 @@ func (m *Model) contentSelection() int {
 func (m *Model) contentMaxIndex() int {
 	switch m.read.viewMode {
 	case ViewSchema:
-		return len(m.read.schema.Columns) - 1
+		if len(m.read.schema.Columns) == 0 {
+			return -1
+		}
+		return len(schemaViewLines(m.read.schema)) - 1
 	case ViewRecords:
 		return m.totalRecordRows() - 1
 	default:
@@ -105,13 +141,37 @@ func (m *Model) contentMaxIndex() int {
 }
 
 func (m *Model) currentTableName() string {
+	return m.currentTable().Name
+}
+
+func (m *Model) currentTable() dto.Table {
 	if len(m.read.tables) == 0 {
-		return ""
+		return dto.Table{}
 	}
 	if m.read.selectedTable < 0 || m.read.selectedTable >= len(m.read.tables) {
-		return ""
+		return dto.Table{}
+	}
+	return m.read.tables[m.read.selectedTable]
+}
+
+// ensureSessionWritable reports a status error when the session was opened
+// read-only.
+func (m *Model) ensureSessionWritable() bool {
+	if m.readOnly {
+		m.ui.statusMessage = "Error: " + usecase.ErrReadOnlySession.Error()
+		return false
+	}
+	return true
+}
+
+// ensureCurrentTableWritable reports a status error when the selected table
+// is a view without an INSTEAD OF trigger for operation.
+func (m *Model) ensureCurrentTableWritable(operation usecase.TableWriteOperation) bool {
+	if err := m.stagingPolicyUseCase().EnsureWritable(m.currentTable(), operation); err != nil {
+		m.ui.statusMessage = "Error: " + err.Error()
+		return false
 	}
-	return m.read.tables[m.read.selectedTable].Name
+	return true
 }
 
 func (m *Model) commandInputValueWithCaret() string {
@@ -161,6 +221,14 @@ func sortDirections() []dto.SortDirection {
 	return []dto.SortDirection{dto.SortDirectionAsc, dto.SortDirectionDesc}
 }
 
+func sortNullsOptions() []dto.SortNulls {
+	return []dto.SortNulls{dto.SortNullsDefault, dto.SortNullsFirst, dto.SortNullsLast}
+}
+
+func sortCollationOptions() []dto.SortCollation {
+	return []dto.SortCollation{dto.SortCollationDefault, dto.SortCollationNoCase, dto.SortCollationRTrim}
+}
+
 func containsInt(values []int, target int) bool {
 	return indexOfInt(values, target) >= 0
 }
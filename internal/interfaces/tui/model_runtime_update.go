@@ -13,6 +13,9 @@ import (
 type tablesMsg struct {
 	bundleToken int
 	tables      []dto.Table
+	// reload marks a table list read again during the runtime, which keeps
+	// the selected table while it still exists.
+	reload bool
 }
 
 type schemaMsg struct {
@@ -33,6 +36,85 @@ type saveChangesMsg struct {
 	err   error
 }
 
+type savePreviewMsg struct {
+	statements []dto.WriteStatement
+	err        error
+	confirm    bool
+	intent     usecase.RuntimeSaveIntent
+}
+
+type exportChangesMsg struct {
+	path  string
+	count int
+	err   error
+}
+
+type exportRecordsMsg struct {
+	path  string
+	count int
+	err   error
+}
+
+type duplicateRecordReadMsg struct {
+	bundleToken int
+	table       string
+	rowKey      string
+	values      []dto.StagedValue
+	err         error
+}
+
+type yankMsg struct {
+	bundleToken int
+	label       string
+	text        string
+	err         error
+}
+
+type importFileReadMsg struct {
+	bundleToken int
+	table       string
+	file        dto.ImportFile
+	err         error
+}
+
+type sqlConsoleMsg struct {
+	bundleToken int
+	requestID   int
+	statement   string
+	pageIndex   int
+	result      dto.SQLResult
+	err         error
+}
+
+type savedViewSavedMsg struct {
+	bundleToken int
+	name        string
+	err         error
+}
+
+type savedViewListMsg struct {
+	bundleToken int
+	table       string
+	names       []string
+	err         error
+}
+
+type savedViewLoadedMsg struct {
+	bundleToken int
+	view        dto.SavedView
+	err         error
+}
+
+// historyMsg carries a loaded history, or the outcome of recording an entry
+// when load is false.
+type historyMsg struct {
+	bundleToken int
+	kind        dto.HistoryKind
+	entries     []string
+	load        bool
+	err         error
+}
+
 type errMsg struct {
 	bundleToken int
 	err         error
@@ -51,11 +133,15 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.bundleToken != m.runtimeBundleToken {
 			return m, nil
 		}
+		previous := m.currentTableName()
 		m.read.tables = msg.tables
 		if len(m.read.tables) == 0 {
 			m.ui.statusMessage = "No tables found"
 			return m, nil
 		}
+		if msg.reload {
+			return m, m.reselectTableAfterReload(previous)
+		}
 		m.read.selectedTable = 0
 		return m, m.loadSchemaCmd()
 	case schemaMsg:
@@ -66,6 +152,7 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 		m.read.schema = msg.schema
+		m.stagingWorkspaceUseCase().SetSchema(msg.tableName, msg.schema)
 		m.read.schemaIndex = 0
 		if m.read.recordColumn >= len(m.read.schema.Columns) {
 			m.read.recordColumn = 0
@@ -91,14 +178,46 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		m.read.recordLoading = false
 		m.read.records = msg.page.Rows
-		m.read.recordTotalCount = msg.page.TotalCount
-		m.read.recordTotalPages = m.computeTotalPages(msg.page.TotalCount)
-		m.read.recordPageIndex = clamp(m.read.recordPageIndex, 0, m.read.recordTotalPages-1)
+		m.read.recordHasMore = msg.page.HasMore
+		m.read.recordNextCursor = msg.page.NextCursor
+		m.read.recordPrevCursor = msg.page.PrevCursor
+		m.rememberVisualRows()
+		if m.read.recordCountStatus == recordCountExact {
+			m.read.recordPageIndex = clamp(m.read.recordPageIndex, 0, m.read.recordTotalPages-1)
+		}
 		m.normalizeRecordSelection()
+		if m.read.recordCountQueued {
+			return m, m.startRecordCountCmd()
+		}
 		return m, nil
+	case recordCountMsg:
+		return m.handleRecordCountMsg(msg)
+	case savePreviewMsg:
+		return m.handleSavePreviewMsg(msg)
+	case exportChangesMsg:
+		return m.handleExportChangesMsg(msg)
+	case exportRecordsMsg:
+		return m.handleExportRecordsMsg(msg)
+	case importFileReadMsg:
+		return m.handleImportFileReadMsg(msg)
+	case yankMsg:
+		return m.handleYankMsg(msg)
+	case duplicateRecordReadMsg:
+		return m.handleDuplicateRecordReadMsg(msg)
+	case sqlConsoleMsg:
+		return m.handleSQLConsoleMsg(msg)
+	case historyMsg:
+		return m.handleHistoryMsg(msg)
+	case savedViewSavedMsg:
+		return m.handleSavedViewSavedMsg(msg)
+	case savedViewListMsg:
+		return m.handleSavedViewListMsg(msg)
+	case savedViewLoadedMsg:
+		return m.handleSavedViewLoadedMsg(msg)
 	case saveChangesMsg:
 		m.ui.saveInFlight = false
-		decision := m.saveWorkflowUseCase().ResolveResult(m.ui.pendingSaveSuccessAction, msg.count, msg.err)
+		successAction := m.ui.pendingSaveSuccessAction
+		decision := m.saveWorkflowUseCase().ResolveResult(successAction, msg.count, msg.err)
 		if decision.ClearPendingSaveAction {
 			m.ui.pendingSaveSuccessAction = usecase.RuntimeSaveSuccessActionNone
 		}
@@ -109,11 +228,14 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if navigationDecision.Pending == nil {
 				m.ui.pendingCommandInput = ""
 			}
-			if msg.err != nil && m.ui.pendingCommandInput != "" {
-				m.restoreEditingCommandInput(m.ui.pendingCommandInput)
-			}
 			if msg.err != nil {
 				m.ui.statusMessage = decision.StatusMessage
+				if m.openSaveConflictReport(msg.err, successAction) {
+					return m, nil
+				}
+				if m.ui.pendingCommandInput != "" {
+					m.restoreEditingCommandInput(m.ui.pendingCommandInput)
+				}
 				return m, nil
 			}
 			if decision.ClearStaging {
@@ -125,6 +247,7 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		if msg.err != nil {
 			m.ui.statusMessage = decision.StatusMessage
+			m.openSaveConflictReport(msg.err, successAction)
 			return m, nil
 		}
 		if decision.ClearStaging {
@@ -161,7 +284,7 @@ func (m *Model) resetTableContext() {
 	m.read.schemaIndex = 0
 	m.resetReadRecordBrowsingState()
 	m.resetTableOverlayState()
-	m.clearStagedState()
+	m.syncStagingSnapshot()
 }
 
 func (m *Model) resetReadRecordBrowsingState() {
@@ -169,10 +292,17 @@ func (m *Model) resetReadRecordBrowsingState() {
 	m.read.recordPageIndex = 0
 	m.read.recordTotalPages = 1
 	m.read.recordTotalCount = 0
+	m.read.recordHasMore = false
+	m.cancelRecordCount()
+	m.read.recordCountStatus = recordCountExact
+	m.read.recordCountQueued = false
+	m.read.recordNextCursor = ""
+	m.read.recordPrevCursor = ""
 	m.read.recordSelection = 0
 	m.read.recordColumn = 0
 	m.read.recordLoading = false
 	m.read.recordFieldFocus = false
+	m.exitVisualSelection()
 	m.closeRecordDetail()
 }
 
@@ -187,15 +317,30 @@ func (m *Model) resetTableOverlayState() {
 	m.overlay.filterPopup = filterPopup{}
 	m.overlay.sortPopup = sortPopup{}
 	m.overlay.helpPopup = helpPopup{}
+	m.overlay.savePreview = savePreviewPopup{}
+	m.overlay.importPopup = importPopup{}
 	m.overlay.recordDetail = recordDetailState{}
 	m.overlay.editPopup = editPopup{}
 	m.overlay.confirmPopup = confirmPopup{}
 	m.overlay.databaseSelector = runtimeDatabaseSelectorPopup{}
 	m.overlay.pendingFilterOpen = false
 	m.overlay.pendingSortOpen = false
+	m.overlay.pendingSaveConflict = nil
+	m.overlay.pendingViewPicker = false
 	m.ui.openConfigSelector = false
 }
 
+// reselectTableAfterReload selects previous again in the reloaded table list
+// and reloads its view, or falls back to the first table when it is gone.
+func (m *Model) reselectTableAfterReload(previous string) tea.Cmd {
+	m.read.selectedTable = m.indexOfTableByName(previous)
+	if m.read.selectedTable < 0 {
+		m.read.selectedTable = 0
+		m.resetTableContext()
+	}
+	return m.loadViewForSelection()
+}
+
 func (m *Model) loadViewForSelection() tea.Cmd {
 	if m.read.viewMode == ViewRecords {
 		return tea.Batch(m.loadSchemaCmd(), m.loadRecordsCmd(true))
@@ -217,8 +362,25 @@ func (m *Model) loadRecordsCmd(reset bool) tea.Cmd {
 		return nil
 	}
 	if reset {
+		// Positions of a visual selection refer to the previous result.
+		m.exitVisualSelection()
 		m.read.recordPageIndex = 0
+		m.invalidateRecordCount()
 	}
+	return m.loadRecordsPageCmd(tableName, "")
+}
+
+// loadRecordsFromCursorCmd loads an adjacent page by seeking from a cursor of
+// the current page; an empty cursor falls back to the page-index offset.
+func (m *Model) loadRecordsFromCursorCmd(cursor string) tea.Cmd {
+	tableName := m.currentTableName()
+	if strings.TrimSpace(tableName) == "" {
+		return nil
+	}
+	return m.loadRecordsPageCmd(tableName, cursor)
+}
+
+func (m *Model) loadRecordsPageCmd(tableName, cursor string) tea.Cmd {
 	if m.read.recordLoading {
 		return nil
 	}
@@ -232,6 +394,7 @@ func (m *Model) loadRecordsCmd(reset bool) tea.Cmd {
 		m.listRecords,
 		tableName,
 		offset,
+		cursor,
 		recordLimit,
 		m.read.currentFilter,
 		m.read.currentSort,
@@ -265,6 +428,18 @@ func loadTablesCmd(ctx context.Context, uc listTablesUseCase, bundleToken int) t
 	}
 }
 
+func reloadTablesCmd(ctx context.Context, uc listTablesUseCase, bundleToken int) tea.Cmd {
+	load := loadTablesCmd(ctx, uc, bundleToken)
+	return func() tea.Msg {
+		msg := load()
+		if tables, ok := msg.(tablesMsg); ok {
+			tables.reload = true
+			return tables
+		}
+		return msg
+	}
+}
+
 func loadSchemaCmd(ctx context.Context, uc getSchemaUseCase, tableName string, bundleToken int) tea.Cmd {
 	return func() tea.Msg {
 		schema, err := uc.Execute(ctx, tableName)
@@ -275,9 +450,17 @@ func loadSchemaCmd(ctx context.Context, uc getSchemaUseCase, tableName string, b
 	}
 }
 
-func loadRecordsCmd(ctx context.Context, uc listRecordsUseCase, tableName string, offset, limit int, filter *dto.Filter, sort *dto.Sort, bundleToken, requestID int) tea.Cmd {
+func loadRecordsCmd(ctx context.Context, uc listRecordsUseCase, tableName string, offset int, cursor string, limit int, filter *dto.FilterGroup, sorts []dto.Sort, bundleToken, requestID int) tea.Cmd {
 	return func() tea.Msg {
-		page, err := uc.Execute(ctx, tableName, offset, limit, filter, sort)
+		var (
+			page dto.RecordPage
+			err  error
+		)
+		if cursor != "" {
+			page, err = uc.ExecuteFromCursor(ctx, tableName, cursor, limit, filter, sorts)
+		} else {
+			page, err = uc.Execute(ctx, tableName, offset, limit, filter, sorts)
+		}
 		if err != nil {
 			return errMsg{bundleToken: bundleToken, err: err}
 		}
@@ -285,9 +468,117 @@ func loadRecordsCmd(ctx context.Context, uc listRecordsUseCase, tableName string
 	}
 }
 
-func saveChangesCmd(ctx context.Context, uc saveChangesUseCase, tableName string, changes dto.TableChanges) tea.Cmd {
+func saveChangesCmd(ctx context.Context, uc saveChangesUseCase, changeSets []dto.TableChangeSet) tea.Cmd {
 	return func() tea.Msg {
-		count, err := uc.ExecuteDTO(ctx, tableName, changes)
+		count, err := uc.ExecuteAllDTO(ctx, changeSets)
 		return saveChangesMsg{count: count, err: err}
 	}
 }
+
+func savePreviewCmd(ctx context.Context, uc previewChangesUseCase, changeSets []dto.TableChangeSet, confirm bool, intent usecase.RuntimeSaveIntent) tea.Cmd {
+	return func() tea.Msg {
+		statements, err := uc.ExecuteDTO(ctx, changeSets)
+		return savePreviewMsg{statements: statements, err: err, confirm: confirm, intent: intent}
+	}
+}
+
+func exportRecordsCmd(ctx context.Context, uc exportRecordsUseCase, export dto.RecordExport) tea.Cmd {
+	return func() tea.Msg {
+		count, err := uc.Execute(ctx, export)
+		return exportRecordsMsg{path: export.Path, count: count, err: err}
+	}
+}
+
+func readDuplicateRecordCmd(ctx context.Context, uc readRecordUseCase, table, rowKey string, identity dto.RecordIdentity, bundleToken int) tea.Cmd {
+	return func() tea.Msg {
+		values, err := uc.Execute(ctx, table, identity)
+		return duplicateRecordReadMsg{bundleToken: bundleToken, table: table, rowKey: rowKey, values: values, err: err}
+	}
+}
+
+func yankCellCmd(ctx context.Context, uc yankRecordsUseCase, table string, columns []dto.SchemaColumn, row dto.YankRow, column int, label string, bundleToken int) tea.Cmd {
+	return func() tea.Msg {
+		text, err := uc.Cell(ctx, table, columns, row, column)
+		return yankMsg{bundleToken: bundleToken, label: label, text: text, err: err}
+	}
+}
+
+func yankRowsCmd(ctx context.Context, uc yankRecordsUseCase, yank dto.RecordYank, label string, bundleToken int) tea.Cmd {
+	return func() tea.Msg {
+		text, err := uc.Rows(ctx, yank)
+		return yankMsg{bundleToken: bundleToken, label: label, text: text, err: err}
+	}
+}
+
+func readImportFileCmd(ctx context.Context, uc importRecordsUseCase, table, path string, bundleToken int) tea.Cmd {
+	return func() tea.Msg {
+		file, err := uc.Read(ctx, path)
+		return importFileReadMsg{bundleToken: bundleToken, table: table, file: file, err: err}
+	}
+}
+
+func exportChangesCmd(ctx context.Context, uc exportChangesUseCase, path string, changeSets []dto.TableChangeSet) tea.Cmd {
+	return func() tea.Msg {
+		count, err := uc.ExecuteDTO(ctx, path, changeSets)
+		return exportChangesMsg{path: path, count: count, err: err}
+	}
+}
+
+// sqlConsoleCmd runs statement, or with page set reads page pageIndex of an
+// earlier query without running it again.
+func sqlConsoleCmd(ctx context.Context, uc runSQLUseCase, statement string, pageIndex, limit int, page bool, bundleToken, requestID int) tea.Cmd {
+	return func() tea.Msg {
+		var (
+			result dto.SQLResult
+			err    error
+		)
+		if page {
+			result, err = uc.ExecutePage(ctx, statement, pageIndex*limit, limit)
+		} else {
+			result, err = uc.Execute(ctx, statement, limit)
+		}
+		return sqlConsoleMsg{
+			bundleToken: bundleToken,
+			requestID:   requestID,
+			statement:   statement,
+			pageIndex:   pageIndex,
+			result:      result,
+			err:         err,
+		}
+	}
+}
+
+func loadHistoryCmd(ctx context.Context, uc historyUseCase, kind dto.HistoryKind, bundleToken int) tea.Cmd {
+	return func() tea.Msg {
+		entries, err := uc.Load(ctx, kind)
+		return historyMsg{bundleToken: bundleToken, kind: kind, entries: entries, load: true, err: err}
+	}
+}
+
+func recordHistoryCmd(ctx context.Context, uc historyUseCase, kind dto.HistoryKind, entry string, bundleToken int) tea.Cmd {
+	return func() tea.Msg {
+		entries, err := uc.Record(ctx, kind, entry)
+		return historyMsg{bundleToken: bundleToken, kind: kind, entries: entries, err: err}
+	}
+}
+
+func saveViewCmd(ctx context.Context, uc savedViewsUseCase, view dto.SavedView, bundleToken int) tea.Cmd {
+	return func() tea.Msg {
+		err := uc.Save(ctx, view)
+		return savedViewSavedMsg{bundleToken: bundleToken, name: view.Name, err: err}
+	}
+}
+
+func listSavedViewsCmd(ctx context.Context, uc savedViewsUseCase, table string, bundleToken int) tea.Cmd {
+	return func() tea.Msg {
+		names, err := uc.List(ctx, table)
+		return savedViewListMsg{bundleToken: bundleToken, table: table, names: names, err: err}
+	}
+}
+
+func loadSavedViewCmd(ctx context.Context, uc savedViewsUseCase, table, name string, bundleToken int) tea.Cmd {
+	return func() tea.Msg {
+		view, err := uc.Load(ctx, table, name)
+		return savedViewLoadedMsg{bundleToken: bundleToken, view: view, err: err}
+	}
+}
@@ -3,6 +3,7 @@ package tui
 import (
 	"context"
 	"errors"
+	"reflect"
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -17,6 +18,16 @@ type stubGetSchemaUseCase struct {
 	err           error
 }
 
+type stubListTablesUseCase struct {
+	calls  int
+	tables []dto.Table
+}
+
+func (s *stubListTablesUseCase) Execute(ctx context.Context) ([]dto.Table, error) {
+	s.calls++
+	return s.tables, nil
+}
+
 type stubListRecordsUseCase struct {
 	lastTableName string
 	page          dto.RecordPage
@@ -31,7 +42,7 @@ func (s *stubGetSchemaUseCase) Execute(ctx context.Context, tableName string) (d
 	return s.schema, nil
 }
 
-func (s *stubListRecordsUseCase) Execute(ctx context.Context, tableName string, offset, limit int, filter *dto.Filter, sort *dto.Sort) (dto.RecordPage, error) {
+func (s *stubListRecordsUseCase) Execute(ctx context.Context, tableName string, offset, limit int, filter *dto.FilterGroup, sorts []dto.Sort) (dto.RecordPage, error) {
 	s.lastTableName = tableName
 	if s.err != nil {
 		return dto.RecordPage{}, s.err
@@ -39,6 +50,10 @@ func (s *stubListRecordsUseCase) Execute(ctx context.Context, tableName string,
 	return s.page, nil
 }
 
+func (s *stubListRecordsUseCase) ExecuteFromCursor(ctx context.Context, tableName, cursor string, limit int, filter *dto.FilterGroup, sorts []dto.Sort) (dto.RecordPage, error) {
+	return s.Execute(ctx, tableName, 0, limit, filter, sorts)
+}
+
 func TestUpdate_TablesMsgStoresTablesSelectsFirstTableAndStartsSchemaLoad(t *testing.T) {
 	// Arrange
 	getSchema := &stubGetSchemaUseCase{
@@ -110,7 +125,7 @@ func TestUpdate_RecordsMsgIgnoresStaleRequestIDAndPreservesCurrentRecords(t *tes
 			Rows: []dto.RecordRow{
 				{Values: []string{"stale"}},
 			},
-			TotalCount: 99,
+			HasMore: true,
 		},
 	}
 
@@ -238,8 +253,8 @@ func TestUpdate_SaveChangesMsgShowsSavedRowsStatusAndReloadsRecords(t *testing.T
 	// Arrange
 	listRecords := &stubListRecordsUseCase{
 		page: dto.RecordPage{
-			Rows:       []dto.RecordRow{{Values: []string{"1", "alice"}}},
-			TotalCount: 1,
+			Rows:    []dto.RecordRow{{Values: []string{"1", "alice"}}},
+			HasMore: true,
 		},
 	}
 	model := withTestStaging(&Model{
@@ -372,15 +387,15 @@ func TestUpdate_TablesMsgStartsSchemaLoadWithoutAnyReloadRestoreState(t *testing
 			focus:           FocusContent,
 			viewMode:        ViewRecords,
 			recordPageIndex: 2,
-			currentFilter: &dto.Filter{
+			currentFilter: singleConditionFilter(dto.Filter{
 				Column:   "name",
 				Operator: dto.Operator{Name: "Equals", Kind: dto.OperatorKindEq, RequiresValue: true},
 				Value:    "alice",
-			},
-			currentSort: &dto.Sort{
+			}),
+			currentSort: []dto.Sort{{
 				Column:    "id",
 				Direction: dto.SortDirectionDesc,
-			},
+			}},
 		},
 	}
 
@@ -405,15 +420,15 @@ func TestUpdate_TablesMsgStartsSchemaLoadWithoutAnyReloadRestoreState(t *testing
 	if model.read.recordPageIndex != 2 {
 		t.Fatalf("expected page index to stay unchanged before any records reset, got %d", model.read.recordPageIndex)
 	}
-	assertFilterEqual(t, model.read.currentFilter, &dto.Filter{
+	assertFilterEqual(t, model.read.currentFilter, singleConditionFilter(dto.Filter{
 		Column:   "name",
 		Operator: dto.Operator{Name: "Equals", Kind: dto.OperatorKindEq, RequiresValue: true},
 		Value:    "alice",
-	})
-	assertSortEqual(t, model.read.currentSort, &dto.Sort{
+	}))
+	assertSortEqual(t, model.read.currentSort, []dto.Sort{{
 		Column:    "id",
 		Direction: dto.SortDirectionDesc,
-	})
+	}})
 	if model.read.recordLoading {
 		t.Fatal("expected records load to stay idle until explicitly requested")
 	}
@@ -451,15 +466,15 @@ func TestUpdate_SchemaMsgKeepsCurrentBrowseStateWithoutReloadRestoreLogic(t *tes
 			focus:           FocusContent,
 			viewMode:        ViewRecords,
 			recordPageIndex: 2,
-			currentFilter: &dto.Filter{
+			currentFilter: singleConditionFilter(dto.Filter{
 				Column:   "name",
 				Operator: dto.Operator{Name: "Equals", Kind: dto.OperatorKindEq, RequiresValue: true},
 				Value:    "alice",
-			},
-			currentSort: &dto.Sort{
+			}),
+			currentSort: []dto.Sort{{
 				Column:    "id",
 				Direction: dto.SortDirectionDesc,
-			},
+			}},
 		},
 	}
 
@@ -481,15 +496,15 @@ func TestUpdate_SchemaMsgKeepsCurrentBrowseStateWithoutReloadRestoreLogic(t *tes
 	if model.read.viewMode != ViewRecords {
 		t.Fatalf("expected records view to stay active, got %v", model.read.viewMode)
 	}
-	assertFilterEqual(t, model.read.currentFilter, &dto.Filter{
+	assertFilterEqual(t, model.read.currentFilter, singleConditionFilter(dto.Filter{
 		Column:   "name",
 		Operator: dto.Operator{Name: "Equals", Kind: dto.OperatorKindEq, RequiresValue: true},
 		Value:    "alice",
-	})
-	assertSortEqual(t, model.read.currentSort, &dto.Sort{
+	}))
+	assertSortEqual(t, model.read.currentSort, []dto.Sort{{
 		Column:    "id",
 		Direction: dto.SortDirectionDesc,
-	})
+	}})
 	if recordsSpy.lastFilter != nil || recordsSpy.lastSort != nil {
 		t.Fatal("expected records use case not to run while handling schema only")
 	}
@@ -510,15 +525,15 @@ func TestUpdate_SaveChangesMsgPendingDatabaseTransitionPreservesCurrentBrowseSta
 			recordSelection:  3,
 			recordColumn:     1,
 			recordFieldFocus: true,
-			currentFilter: &dto.Filter{
+			currentFilter: singleConditionFilter(dto.Filter{
 				Column:   "name",
 				Operator: dto.Operator{Name: "Equals", Kind: dto.OperatorKindEq, RequiresValue: true},
 				Value:    "alice",
-			},
-			currentSort: &dto.Sort{
+			}),
+			currentSort: []dto.Sort{{
 				Column:    "id",
 				Direction: dto.SortDirectionDesc,
-			},
+			}},
 		},
 		ui: runtimeUIState{
 			saveInFlight:             true,
@@ -560,15 +575,15 @@ func TestUpdate_SaveChangesMsgPendingDatabaseTransitionPreservesCurrentBrowseSta
 	if model.read.viewMode != ViewRecords {
 		t.Fatalf("expected view mode to stay unchanged until runtime exits, got %v", model.read.viewMode)
 	}
-	assertFilterEqual(t, model.read.currentFilter, &dto.Filter{
+	assertFilterEqual(t, model.read.currentFilter, singleConditionFilter(dto.Filter{
 		Column:   "name",
 		Operator: dto.Operator{Name: "Equals", Kind: dto.OperatorKindEq, RequiresValue: true},
 		Value:    "alice",
-	})
-	assertSortEqual(t, model.read.currentSort, &dto.Sort{
+	}))
+	assertSortEqual(t, model.read.currentSort, []dto.Sort{{
 		Column:    "id",
 		Direction: dto.SortDirectionDesc,
-	})
+	}})
 }
 
 func TestUpdate_ErrMsgIgnoresStaleBundleToken(t *testing.T) {
@@ -598,7 +613,7 @@ func TestUpdate_ErrMsgIgnoresStaleBundleToken(t *testing.T) {
 	}
 }
 
-func assertFilterEqual(t *testing.T, actual, expected *dto.Filter) {
+func assertFilterEqual(t *testing.T, actual, expected *dto.FilterGroup) {
 	t.Helper()
 	if expected == nil {
 		if actual != nil {
@@ -609,29 +624,21 @@ func assertFilterEqual(t *testing.T, actual, expected *dto.Filter) {
 	if actual == nil {
 		t.Fatal("expected non-nil filter")
 	}
-	if actual.Column != expected.Column {
-		t.Fatalf("expected filter column %q, got %q", expected.Column, actual.Column)
+	if !reflect.DeepEqual(*actual, *expected) {
+		t.Fatalf("expected filter %+v, got %+v", *expected, *actual)
 	}
-	if actual.Operator != expected.Operator {
-		t.Fatalf("expected filter operator %+v, got %+v", expected.Operator, actual.Operator)
-	}
-	if actual.Value != expected.Value {
-		t.Fatalf("expected filter value %q, got %q", expected.Value, actual.Value)
+}
+
+func singleConditionFilter(condition dto.Filter) *dto.FilterGroup {
+	return &dto.FilterGroup{
+		Logic:      dto.FilterLogicAnd,
+		Conditions: []dto.Filter{condition},
 	}
 }
 
-func assertSortEqual(t *testing.T, actual, expected *dto.Sort) {
+func assertSortEqual(t *testing.T, actual, expected []dto.Sort) {
 	t.Helper()
-	if expected == nil {
-		if actual != nil {
-			t.Fatalf("expected nil sort, got %+v", actual)
-		}
-		return
-	}
-	if actual == nil {
-		t.Fatal("expected non-nil sort")
-	}
-	if actual.Column != expected.Column || actual.Direction != expected.Direction {
+	if !reflect.DeepEqual(actual, expected) {
 		t.Fatalf("expected sort %+v, got %+v", expected, actual)
 	}
 }
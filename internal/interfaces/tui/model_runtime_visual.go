@@ -0,0 +1,214 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/usecase"
+)
+
+// visualSelection is the row range of visual line mode. Positions count the
+// persisted rows of the current result from its first row, with pending
+// inserts before them at negative positions, so a range can span pages. rows
+// keeps every persisted row loaded while the mode is active by position, so
+// rows of pages left behind stay selected.
+type visualSelection struct {
+	active bool
+	anchor int
+	rows   map[int]dto.RecordRow
+}
+
+func (m *Model) toggleVisualSelection() (tea.Model, tea.Cmd) {
+	if m.read.visual.active {
+		m.exitVisualSelection()
+		return m, nil
+	}
+	if m.read.viewMode != ViewRecords || m.read.focus != FocusContent || m.totalRecordRows() == 0 {
+		return m, nil
+	}
+	m.read.visual = visualSelection{
+		active: true,
+		anchor: m.visualPosition(m.read.recordSelection),
+		rows:   make(map[int]dto.RecordRow),
+	}
+	m.rememberVisualRows()
+	return m, nil
+}
+
+func (m *Model) exitVisualSelection() {
+	m.read.visual = visualSelection{}
+}
+
+// rememberVisualRows adds the loaded page to the visual selection cache.
+func (m *Model) rememberVisualRows() {
+	if !m.read.visual.active {
+		return
+	}
+	start := m.read.recordPageIndex * m.effectiveRecordLimit()
+	for index, record := range m.read.records {
+		m.read.visual.rows[start+index] = record
+	}
+}
+
+func (m *Model) visualPosition(rowIndex int) int {
+	inserts := len(m.currentStagingSnapshot().PendingInserts)
+	if rowIndex < inserts {
+		return rowIndex - inserts
+	}
+	return m.read.recordPageIndex*m.effectiveRecordLimit() + rowIndex - inserts
+}
+
+func (m *Model) visualRange() (int, int) {
+	cursor := m.visualPosition(m.read.recordSelection)
+	if cursor < m.read.visual.anchor {
+		return cursor, m.read.visual.anchor
+	}
+	return m.read.visual.anchor, cursor
+}
+
+func (m *Model) rowInVisualSelection(rowIndex int) bool {
+	if !m.read.visual.active {
+		return false
+	}
+	first, last := m.visualRange()
+	position := m.visualPosition(rowIndex)
+	return position >= first && position <= last
+}
+
+// visualSelectedRows returns the pending inserts and the loaded persisted rows
+// inside the visual range, each in display order.
+func (m *Model) visualSelectedRows() ([]dto.InsertDraftSnapshot, []dto.RecordRow) {
+	first, last := m.visualRange()
+	pendingInserts := m.currentStagingSnapshot().PendingInserts
+	var inserts []dto.InsertDraftSnapshot
+	for index, insert := range pendingInserts {
+		if position := index - len(pendingInserts); position >= first && position <= last {
+			inserts = append(inserts, insert)
+		}
+	}
+	positions := make([]int, 0, len(m.read.visual.rows))
+	for position := range m.read.visual.rows {
+		if position >= first && position <= last {
+			positions = append(positions, position)
+		}
+	}
+	sort.Ints(positions)
+	records := make([]dto.RecordRow, len(positions))
+	for index, position := range positions {
+		records[index] = m.read.visual.rows[position]
+	}
+	return inserts, records
+}
+
+func (m *Model) visualSelectionCount() int {
+	inserts, records := m.visualSelectedRows()
+	return len(inserts) + len(records)
+}
+
+// deleteVisualSelection marks every selected persisted row for delete, or
+// unmarks them when all of them are marked already, and removes selected
+// pending inserts when marking. It is one undo step.
+func (m *Model) deleteVisualSelection() (tea.Model, tea.Cmd) {
+	inserts, records := m.visualSelectedRows()
+	if len(records) > 0 && !m.ensureCurrentTableWritable(usecase.TableWriteDelete) {
+		return m, nil
+	}
+	pendingDeletes := m.currentStagingSnapshot().PendingDeletes
+	refs := make([]dto.PersistedRecordRef, 0, len(records))
+	marked := false
+	for _, record := range records {
+		ref, err := m.recordAccessResolverUseCase().ResolveForDelete(m.read.schema, record)
+		if err != nil {
+			m.ui.statusMessage = "Error: " + err.Error()
+			return m, nil
+		}
+		if _, exists := pendingDeletes[ref.RowKey]; !exists {
+			marked = true
+		}
+		refs = append(refs, ref)
+	}
+	var insertIDs []dto.InsertDraftID
+	if marked || len(records) == 0 {
+		for _, insert := range inserts {
+			insertIDs = append(insertIDs, insert.ID)
+		}
+	}
+	if err := m.stagingSessionUseCase().StageBulkDelete(insertIDs, refs, marked); err != nil {
+		m.ui.statusMessage = "Error: " + err.Error()
+		return m, nil
+	}
+	m.syncStagingSnapshot()
+	m.exitVisualSelection()
+	m.normalizeRecordSelection()
+	switch {
+	case !marked && len(refs) > 0:
+		m.ui.statusMessage = fmt.Sprintf("Unmarked %d row(s) for delete", len(refs))
+	case len(insertIDs) > 0 && len(refs) > 0:
+		m.ui.statusMessage = fmt.Sprintf("Marked %d row(s) for delete, removed %d pending insert(s)", len(refs), len(insertIDs))
+	case len(insertIDs) > 0:
+		m.ui.statusMessage = fmt.Sprintf("Removed %d pending insert(s)", len(insertIDs))
+	default:
+		m.ui.statusMessage = fmt.Sprintf("Marked %d row(s) for delete", len(refs))
+	}
+	return m, nil
+}
+
+// openVisualEditPopup opens the edit popup for the focused column of every
+// selected row, prefilled with the value of the row under the cursor.
+func (m *Model) openVisualEditPopup() (tea.Model, tea.Cmd) {
+	columnIndex := m.read.recordColumn
+	if columnIndex < 0 || columnIndex >= len(m.read.schema.Columns) {
+		return m, nil
+	}
+	inserts, records := m.visualSelectedRows()
+	if len(inserts)+len(records) == 0 {
+		return m, nil
+	}
+	if len(records) > 0 && !m.ensureCurrentTableWritable(usecase.TableWriteUpdate) {
+		return m, nil
+	}
+	column := m.read.schema.Columns[columnIndex]
+	if err := m.stagingPolicyUseCase().EnsureColumnWritable(column); err != nil {
+		m.ui.statusMessage = "Error: " + err.Error()
+		return m, nil
+	}
+	pendingUpdates := m.currentStagingSnapshot().PendingUpdates
+	targets := make([]dto.BulkEditTarget, 0, len(inserts)+len(records))
+	for _, insert := range inserts {
+		targets = append(targets, dto.BulkEditTarget{InsertID: insert.ID})
+	}
+	for _, record := range records {
+		ref, err := m.recordAccessResolverUseCase().ResolveForDelete(m.read.schema, record)
+		if err == nil {
+			if _, staged := pendingUpdates[ref.RowKey].Changes[columnIndex]; !staged {
+				ref, err = m.recordAccessResolverUseCase().ResolveForEdit(m.read.schema, record, columnIndex)
+			}
+		}
+		if err != nil {
+			m.ui.statusMessage = "Error: " + err.Error()
+			return m, nil
+		}
+		targets = append(targets, dto.BulkEditTarget{Record: ref, Original: usecase.PersistedOriginalValue(record, columnIndex)})
+	}
+	currentValue, _ := m.effectiveRecordDetailValue(m.read.recordSelection, columnIndex)
+	popup := newEditPopup(m.read.recordSelection, columnIndex, column, currentValue)
+	popup.bulkTargets = targets
+	m.overlay.editPopup = popup
+	return m, nil
+}
+
+func (m *Model) confirmVisualEdit(column dto.SchemaColumn, value dto.StagedValue) (tea.Model, tea.Cmd) {
+	targets := m.overlay.editPopup.bulkTargets
+	if err := m.stagingSessionUseCase().StageBulkEdit(targets, m.overlay.editPopup.columnIndex, value); err != nil {
+		m.overlay.editPopup.errorMessage = err.Error()
+		return m, nil
+	}
+	m.syncStagingSnapshot()
+	m.closeEditPopup()
+	m.exitVisualSelection()
+	m.ui.statusMessage = fmt.Sprintf("Set %s for %d row(s)", column.Name, len(targets))
+	return m, nil
+}
@@ -0,0 +1,212 @@
+package tui
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+)
+
+func visualRecordForTest(id, status string) dto.RecordRow {
+	return dto.RecordRow{
+		Values: []string{id, status},
+		RowKey: "id=" + id,
+		Identity: dto.RecordIdentity{Keys: []dto.RecordIdentityKey{
+			{Column: "id", Value: dto.StagedValue{Text: id, Raw: id}},
+		}},
+		EditableFromBrowse: []bool{true, true},
+	}
+}
+
+func newVisualTestModel(recordsSpy *spyListRecordsUseCase) *Model {
+	return &Model{
+		ctx:            context.Background(),
+		listRecords:    recordsSpy,
+		runtimeSession: &RuntimeSessionState{RecordsPageLimit: 2},
+		read: runtimeReadState{
+			viewMode:         ViewRecords,
+			focus:            FocusContent,
+			tables:           []dto.Table{{Name: "users", Kind: dto.TableKindTable}},
+			recordTotalPages: 2,
+			recordTotalCount: 4,
+			records:          []dto.RecordRow{visualRecordForTest("1", "active"), visualRecordForTest("2", "active")},
+			schema: dto.Schema{Columns: []dto.SchemaColumn{
+				{Name: "id", Type: "INTEGER", PrimaryKey: true},
+				{Name: "status", Type: "TEXT"},
+			}},
+		},
+	}
+}
+
+func pressKeyForTest(model *Model, key rune) tea.Cmd {
+	_, cmd := model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{key}})
+	return cmd
+}
+
+func selectAcrossPagesForTest(t *testing.T, model *Model) {
+	t.Helper()
+	model.read.recordSelection = 1
+	pressKeyForTest(model, 'V')
+	_, cmd := model.handleKey(tea.KeyMsg{Type: tea.KeyCtrlF})
+	if cmd == nil {
+		t.Fatal("expected next page command")
+	}
+	model.Update(cmd())
+	pressKeyForTest(model, 'j')
+}
+
+func TestHandleKey_VisualDeleteMarksRowsAcrossPagesAsSingleUndoStep(t *testing.T) {
+	// Arrange
+	recordsSpy := &spyListRecordsUseCase{page: dto.RecordPage{
+		Rows: []dto.RecordRow{visualRecordForTest("3", "active"), visualRecordForTest("4", "active")},
+	}}
+	model := newVisualTestModel(recordsSpy)
+	selectAcrossPagesForTest(t, model)
+
+	// Act
+	pressKeyForTest(model, 'd')
+
+	// Assert
+	deletes := model.currentStagingSnapshot().PendingDeletes
+	if len(deletes) != 3 {
+		t.Fatalf("expected rows 2, 3 and 4 to be marked, got %+v", deletes)
+	}
+	for _, key := range []string{"id=2", "id=3", "id=4"} {
+		if _, ok := deletes[key]; !ok {
+			t.Fatalf("expected %s to be marked, got %+v", key, deletes)
+		}
+	}
+	if model.read.visual.active {
+		t.Fatal("expected visual mode to end after bulk delete")
+	}
+	if model.ui.statusMessage != "Marked 3 row(s) for delete" {
+		t.Fatalf("unexpected status %q", model.ui.statusMessage)
+	}
+
+	// Act
+	pressKeyForTest(model, 'u')
+
+	// Assert
+	if len(model.currentStagingSnapshot().PendingDeletes) != 0 {
+		t.Fatal("expected one undo to unmark every row")
+	}
+}
+
+func TestHandleKey_VisualDeleteUnmarksWhenEverySelectedRowIsMarked(t *testing.T) {
+	// Arrange
+	model := newVisualTestModel(&spyListRecordsUseCase{})
+	pressKeyForTest(model, 'V')
+	pressKeyForTest(model, 'j')
+	pressKeyForTest(model, 'd')
+	pressKeyForTest(model, 'V')
+	pressKeyForTest(model, 'k')
+
+	// Act
+	pressKeyForTest(model, 'd')
+
+	// Assert
+	if len(model.currentStagingSnapshot().PendingDeletes) != 0 {
+		t.Fatalf("expected delete marks to be removed, got %+v", model.currentStagingSnapshot().PendingDeletes)
+	}
+	if model.ui.statusMessage != "Unmarked 2 row(s) for delete" {
+		t.Fatalf("unexpected status %q", model.ui.statusMessage)
+	}
+}
+
+func TestHandleKey_VisualEditSetsColumnForSelectedRowsAndInserts(t *testing.T) {
+	// Arrange
+	model := newVisualTestModel(&spyListRecordsUseCase{})
+	pressKeyForTest(model, 'i')
+	model.read.recordFieldFocus = false
+	model.read.recordColumn = 1
+	pressKeyForTest(model, 'V')
+	pressKeyForTest(model, 'j')
+	pressKeyForTest(model, 'j')
+	pressKeyForTest(model, 'e')
+
+	// Act
+	pressKeyForTest(model, 'e')
+	if !model.overlay.editPopup.active || len(model.overlay.editPopup.bulkTargets) != 3 {
+		t.Fatalf("expected bulk edit popup for three rows, got %+v", model.overlay.editPopup)
+	}
+	model.overlay.editPopup.input = "archived"
+	model.handleKey(tea.KeyMsg{Type: tea.KeyEnter})
+
+	// Assert
+	snapshot := model.currentStagingSnapshot()
+	if got := displayValue(snapshot.PendingInserts[0].Values[1].Value); got != "archived" {
+		t.Fatalf("expected insert status archived, got %q", got)
+	}
+	for _, key := range []string{"id=1", "id=2"} {
+		if got := displayValue(snapshot.PendingUpdates[key].Changes[1].Value); got != "archived" {
+			t.Fatalf("expected %s status archived, got %q", key, got)
+		}
+	}
+	if model.ui.statusMessage != "Set status for 3 row(s)" {
+		t.Fatalf("unexpected status %q", model.ui.statusMessage)
+	}
+
+	// Act
+	pressKeyForTest(model, 'u')
+
+	// Assert
+	snapshot = model.currentStagingSnapshot()
+	if len(snapshot.PendingUpdates) != 0 || len(snapshot.PendingInserts) != 1 {
+		t.Fatalf("expected one undo to revert the bulk edit only, got %+v", snapshot)
+	}
+}
+
+func TestHandleKey_VisualEditRefusesGeneratedColumn(t *testing.T) {
+	// Arrange
+	model := newVisualTestModel(&spyListRecordsUseCase{})
+	model.read.schema.Columns[1].Generated = true
+	model.read.recordFieldFocus = true
+	model.read.recordColumn = 1
+	pressKeyForTest(model, 'V')
+
+	// Act
+	pressKeyForTest(model, 'e')
+
+	// Assert
+	if model.overlay.editPopup.active {
+		t.Fatal("expected no popup for generated column")
+	}
+	if !strings.HasPrefix(model.ui.statusMessage, "Error:") {
+		t.Fatalf("expected error status, got %q", model.ui.statusMessage)
+	}
+}
+
+func TestHandleKey_VisualSelectionEndsWhenFilterReloadsRecords(t *testing.T) {
+	// Arrange
+	model := newVisualTestModel(&spyListRecordsUseCase{})
+	pressKeyForTest(model, 'V')
+
+	// Act
+	model.loadRecordsCmd(true)
+
+	// Assert
+	if model.read.visual.active {
+		t.Fatal("expected visual mode to end when records reload from the first page")
+	}
+}
+
+func TestRenderRecords_HighlightsVisualRangeAndShowsCountInStatus(t *testing.T) {
+	// Arrange
+	model := newVisualTestModel(&spyListRecordsUseCase{})
+	pressKeyForTest(model, 'V')
+	pressKeyForTest(model, 'j')
+
+	// Act
+	status := stripANSI(model.renderStatus(200))
+
+	// Assert
+	if !model.rowInVisualSelection(0) || !model.rowInVisualSelection(1) {
+		t.Fatal("expected both rows to be in the visual range")
+	}
+	if !strings.Contains(status, "VISUAL 2 rows") {
+		t.Fatalf("expected visual row count in status, got %q", status)
+	}
+}
@@ -0,0 +1,144 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/usecase"
+)
+
+// errYankWithoutIdentity refuses rows that cannot be read again by key. Their
+// browse values may hold placeholders for capped cells and cannot tell NULL
+// from the text NULL, so they are not copied.
+var errYankWithoutIdentity = errors.New("row has no key to read its stored values by")
+
+type yankTarget int
+
+const (
+	yankTargetCell yankTarget = iota
+	yankTargetRow
+	yankTargetPage
+)
+
+// requestYank copies the focused cell, the selected row, or every row of the
+// visible page. Rows are copied with their staged edits; stored values are
+// read again so that capped and BLOB cells are copied in full.
+func (m *Model) requestYank(target yankTarget) (tea.Model, tea.Cmd) {
+	if m.read.viewMode != ViewRecords || m.read.focus != FocusContent {
+		return m, nil
+	}
+	table := m.currentTableName()
+	if table == "" || len(m.read.schema.Columns) == 0 || m.totalRecordRows() == 0 {
+		m.ui.statusMessage = "Error: no records to yank"
+		return m, nil
+	}
+	if m.yankRecords == nil {
+		m.ui.statusMessage = "Error: yank use case unavailable"
+		return m, nil
+	}
+	columns := append([]dto.SchemaColumn(nil), m.read.schema.Columns...)
+	if target == yankTargetCell {
+		column := m.read.recordColumn
+		if column < 0 || column >= len(columns) {
+			return m, nil
+		}
+		row, err := m.yankRowForVisibleRow(m.read.recordSelection)
+		if err != nil {
+			m.ui.statusMessage = "Error: " + err.Error()
+			return m, nil
+		}
+		label := fmt.Sprintf("cell %s", columns[column].Name)
+		return m, yankCellCmd(m.runtimeReadContext(), m.yankRecords, table, columns, row, column, label, m.runtimeBundleToken)
+	}
+
+	yank := dto.RecordYank{
+		Table:   table,
+		Columns: columns,
+		Format:  usecase.DefaultYankFormat,
+	}
+	if m.runtimeSession != nil {
+		if m.runtimeSession.YankFormat != "" {
+			yank.Format = m.runtimeSession.YankFormat
+		}
+		yank.Blob = m.runtimeSession.ExportBlob
+		yank.NullText = m.runtimeSession.ExportNullText
+	}
+	first, last := 0, m.totalRecordRows()-1
+	if target == yankTargetRow {
+		first, last = m.read.recordSelection, m.read.recordSelection
+	}
+	for rowIndex := first; rowIndex <= last; rowIndex++ {
+		row, err := m.yankRowForVisibleRow(rowIndex)
+		if err != nil {
+			m.ui.statusMessage = "Error: " + err.Error()
+			return m, nil
+		}
+		yank.Rows = append(yank.Rows, row)
+	}
+	label := fmt.Sprintf("%d row(s) as %s", len(yank.Rows), strings.ToUpper(yank.Format))
+	if target == yankTargetRow {
+		label = "row as " + strings.ToUpper(yank.Format)
+	}
+	return m, yankRowsCmd(m.runtimeReadContext(), m.yankRecords, yank, label, m.runtimeBundleToken)
+}
+
+// yankRowForVisibleRow describes a grid row for the yank use case. Pending
+// inserts carry all their values; persisted rows are read by identity with
+// their staged edits on top, and rows without identity are refused.
+func (m *Model) yankRowForVisibleRow(rowIndex int) (dto.YankRow, error) {
+	row := dto.YankRow{Values: map[int]dto.StagedValue{}}
+	if insert, isInsert := m.pendingInsertForRow(rowIndex); isInsert {
+		for columnIndex, edit := range insert.Values {
+			row.Values[columnIndex] = edit.Value
+		}
+		return row, nil
+	}
+	persistedIndex := m.persistedRowIndex(rowIndex)
+	if persistedIndex < 0 {
+		return row, nil
+	}
+	record := m.read.records[persistedIndex]
+	if record.IdentityUnavailable || len(record.Identity.Keys) == 0 {
+		return dto.YankRow{}, errYankWithoutIdentity
+	}
+	row.Identity = record.Identity
+	if key, ok := m.recordKeyForPersistedRow(persistedIndex); ok {
+		if edits, ok := m.currentStagingSnapshot().PendingUpdates[key]; ok {
+			for columnIndex, edit := range edits.Changes {
+				row.Values[columnIndex] = edit.Value
+			}
+		}
+	}
+	return row, nil
+}
+
+func browseStagedValue(value string) dto.StagedValue {
+	if value == "NULL" {
+		return dto.StagedValue{IsNull: true}
+	}
+	return dto.StagedValue{Text: value}
+}
+
+func (m *Model) handleYankMsg(msg yankMsg) (tea.Model, tea.Cmd) {
+	if msg.bundleToken != m.runtimeBundleToken {
+		return m, nil
+	}
+	if msg.err != nil {
+		m.ui.statusMessage = "Error: " + msg.err.Error()
+		return m, nil
+	}
+	if m.clipboard == nil {
+		m.ui.statusMessage = "Error: clipboard unavailable"
+		return m, nil
+	}
+	if err := m.clipboard.Copy(msg.text); err != nil {
+		m.ui.statusMessage = "Error: " + err.Error()
+		return m, nil
+	}
+	m.ui.statusMessage = fmt.Sprintf("Yanked %s to clipboard", msg.label)
+	return m, nil
+}
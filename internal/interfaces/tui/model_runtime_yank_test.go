@@ -0,0 +1,173 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+)
+
+func yankIdentityForTest() dto.RecordIdentity {
+	return dto.RecordIdentity{Keys: []dto.RecordIdentityKey{{Column: "id", Value: dto.StagedValue{Text: "1", Raw: int64(1)}}}}
+}
+
+func newYankTestModel(yank *spyYankRecordsUseCase, clipboard *spyClipboard) *Model {
+	return withTestStaging(&Model{
+		ctx:         context.Background(),
+		yankRecords: yank,
+		clipboard:   clipboard,
+		read: runtimeReadState{
+			viewMode:     ViewRecords,
+			focus:        FocusContent,
+			tables:       []dto.Table{{Name: "notes"}},
+			recordColumn: 1,
+			records: []dto.RecordRow{{
+				Values:             []string{"1", "<truncated 262145 bytes>"},
+				RowKey:             "id=1",
+				Identity:           yankIdentityForTest(),
+				EditableFromBrowse: []bool{true, false},
+			}},
+			schema: dto.Schema{Columns: []dto.SchemaColumn{
+				{Name: "id", Type: "INTEGER", PrimaryKey: true},
+				{Name: "note", Type: "TEXT"},
+			}},
+		},
+	}, stagingState{})
+}
+
+func pressYankKeysForTest(t *testing.T, model *Model, second rune) {
+	t.Helper()
+	model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	_, cmd := model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{second}})
+	if cmd == nil {
+		t.Fatal("expected yank command")
+	}
+	model.Update(cmd())
+}
+
+func TestHandleKey_YankCellCopiesFocusedColumnOfStoredRow(t *testing.T) {
+	// Arrange
+	yank := &spyYankRecordsUseCase{text: "full note"}
+	clipboard := &spyClipboard{}
+	model := newYankTestModel(yank, clipboard)
+
+	// Act
+	pressYankKeysForTest(t, model, 'c')
+
+	// Assert
+	if yank.lastTable != "notes" || yank.lastColumn != 1 {
+		t.Fatalf("expected note column of notes, got %q column %d", yank.lastTable, yank.lastColumn)
+	}
+	if !reflect.DeepEqual(yank.lastRow.Identity, yankIdentityForTest()) {
+		t.Fatalf("expected row identity to be passed, got %+v", yank.lastRow.Identity)
+	}
+	if !reflect.DeepEqual(clipboard.copied, []string{"full note"}) {
+		t.Fatalf("expected full value on clipboard, got %v", clipboard.copied)
+	}
+	if model.ui.statusMessage != "Yanked cell note to clipboard" {
+		t.Fatalf("unexpected status %q", model.ui.statusMessage)
+	}
+}
+
+func TestHandleKey_YankRowUsesSessionFormatAndStagedEdits(t *testing.T) {
+	// Arrange
+	yank := &spyYankRecordsUseCase{text: `{"id":1}`}
+	clipboard := &spyClipboard{}
+	model := newYankTestModel(yank, clipboard)
+	model = withTestStaging(model, stagingState{pendingUpdates: map[string]recordEdits{
+		"id=1": {
+			identity: yankIdentityForTest(),
+			changes:  map[int]stagedEdit{1: {Value: dto.StagedValue{Text: "edited", Raw: "edited"}}},
+		},
+	}})
+	submitRuntimeCommandForTest(model, "set yankformat=JSON")
+	submitRuntimeCommandForTest(model, "set exportblob=hex")
+
+	// Act
+	pressYankKeysForTest(t, model, 'y')
+
+	// Assert
+	copied := yank.lastYank
+	if copied.Format != "json" || copied.Blob != "hex" || len(copied.Rows) != 1 {
+		t.Fatalf("unexpected yank %+v", copied)
+	}
+	if copied.Rows[0].Values[1].Text != "edited" {
+		t.Fatalf("expected staged edit to be copied, got %+v", copied.Rows[0].Values)
+	}
+	if model.ui.statusMessage != "Yanked row as JSON to clipboard" {
+		t.Fatalf("unexpected status %q", model.ui.statusMessage)
+	}
+}
+
+func TestHandleKey_YankPageCopiesPendingInsertsAndStoredRows(t *testing.T) {
+	// Arrange
+	yank := &spyYankRecordsUseCase{text: "id\tnote"}
+	model := newYankTestModel(yank, &spyClipboard{})
+	model = withTestStaging(model, stagingState{pendingInserts: []pendingInsertRow{{
+		values: map[int]stagedEdit{1: {Value: dto.StagedValue{Text: "draft", Raw: "draft"}}},
+	}}})
+
+	// Act
+	pressYankKeysForTest(t, model, 'p')
+
+	// Assert
+	rows := yank.lastYank.Rows
+	if len(rows) != 2 || yank.lastYank.Format != "tsv" {
+		t.Fatalf("expected two TSV rows, got %+v", yank.lastYank)
+	}
+	if len(rows[0].Identity.Keys) != 0 || rows[0].Values[1].Text != "draft" {
+		t.Fatalf("expected pending insert values first, got %+v", rows[0])
+	}
+	if !reflect.DeepEqual(rows[1].Identity, yankIdentityForTest()) {
+		t.Fatalf("expected stored row identity second, got %+v", rows[1])
+	}
+	if model.ui.statusMessage != "Yanked 2 row(s) as TSV to clipboard" {
+		t.Fatalf("unexpected status %q", model.ui.statusMessage)
+	}
+}
+
+func TestHandleKey_YankRefusesRowsWithoutIdentity(t *testing.T) {
+	// Arrange
+	yank := &spyYankRecordsUseCase{text: "unused"}
+	model := newYankTestModel(yank, &spyClipboard{})
+	model.read.records[0] = dto.RecordRow{
+		Values:             []string{"1", "NULL"},
+		EditableFromBrowse: []bool{true, true},
+	}
+
+	for _, key := range []rune{'c', 'y', 'p'} {
+		// Act
+		model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+		_, cmd := model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{key}})
+
+		// Assert
+		if cmd != nil {
+			t.Fatalf("expected y%c to run no yank for a row without identity", key)
+		}
+		if model.ui.statusMessage != "Error: "+errYankWithoutIdentity.Error() {
+			t.Fatalf("unexpected status after y%c: %q", key, model.ui.statusMessage)
+		}
+	}
+}
+
+func TestHandleKey_YankErrorsShowStatus(t *testing.T) {
+	// Arrange
+	model := newYankTestModel(&spyYankRecordsUseCase{text: "1"}, &spyClipboard{err: errors.New("terminal closed")})
+
+	// Act
+	submitRuntimeCommandForTest(model, "set yankformat=xml")
+	formatStatus := model.ui.statusMessage
+	pressYankKeysForTest(t, model, 'y')
+
+	// Assert
+	if formatStatus != "Error: unknown yank format: xml (expected json, sql, or tsv)" {
+		t.Fatalf("unexpected format status %q", formatStatus)
+	}
+	if model.ui.statusMessage != "Error: terminal closed" {
+		t.Fatalf("unexpected status %q", model.ui.statusMessage)
+	}
+}
@@ -5,20 +5,35 @@ import (
 	"github.com/mgierok/dbc/internal/application/usecase"
 )
 
-func (m *Model) buildTableChanges() (dto.TableChanges, error) {
-	return m.stagingSessionUseCase().BuildTableChanges(m.read.schema)
+func (m *Model) buildDatabaseChanges() ([]dto.TableChangeSet, error) {
+	m.syncCurrentTableSchema()
+	return m.stagingWorkspaceUseCase().BuildDatabaseChanges()
 }
 
+// syncCurrentTableSchema hands the loaded schema of the current table to the
+// workspace before staged changes are translated against it.
+func (m *Model) syncCurrentTableSchema() {
+	if tableName := m.currentTableName(); tableName != "" {
+		m.stagingWorkspaceUseCase().SetSchema(tableName, m.read.schema)
+	}
+}
+
+// dirtyEditCount counts staged rows across every table of the runtime.
 func (m *Model) dirtyEditCount() int {
-	return m.stagingSessionUseCase().DirtyEditCount()
+	return m.stagingWorkspaceUseCase().DirtyEditCount()
 }
 
 func (m *Model) hasDirtyEdits() bool {
-	return m.stagingSessionUseCase().HasDirtyEdits()
+	return m.stagingWorkspaceUseCase().HasDirtyEdits()
+}
+
+func (m *Model) tableDirtyEditCount(tableName string) int {
+	return m.stagingWorkspaceUseCase().DirtyEditCountForTable(tableName)
 }
 
 func (m *Model) clearStagedState() {
-	m.stagingSessionUseCase().Reset()
+	m.stagingWorkspaceUseCase().Reset()
+	m.syncCurrentTableSchema()
 	m.stagingUI = stagingUIState{}
 	m.syncStagingSnapshot()
 }
@@ -72,12 +87,17 @@ func (m *Model) stagingPolicyUseCase() *usecase.StagingPolicy {
 	return usecase.NewStagingPolicy()
 }
 
-func (m *Model) stagingSessionUseCase() *usecase.StagingSession {
-	if m.stagingSession != nil {
-		return m.stagingSession
+func (m *Model) stagingWorkspaceUseCase() *usecase.StagingWorkspace {
+	if m.stagingWorkspace != nil {
+		return m.stagingWorkspace
 	}
-	m.stagingSession = usecase.NewStagingSession(m.stagingPolicyUseCase(), m.translatorUseCase())
-	return m.stagingSession
+	m.stagingWorkspace = usecase.NewStagingWorkspace(m.stagingPolicyUseCase(), m.translatorUseCase())
+	return m.stagingWorkspace
+}
+
+// stagingSessionUseCase returns the staging session of the current table.
+func (m *Model) stagingSessionUseCase() *usecase.StagingSession {
+	return m.stagingWorkspaceUseCase().Session(m.currentTableName())
 }
 
 func (m *Model) syncStagingSnapshot() {
@@ -85,7 +105,7 @@ func (m *Model) syncStagingSnapshot() {
 }
 
 func (m *Model) currentStagingSnapshot() dto.StagingSnapshot {
-	if m.stagingSession == nil {
+	if m.stagingWorkspace == nil {
 		m.syncStagingSnapshot()
 	}
 	return m.stagingSnapshot
@@ -95,22 +115,27 @@ func (m *Model) showAutoForInsert(insertID dto.InsertDraftID) bool {
 	if len(m.stagingUI.showAuto) == 0 {
 		return false
 	}
-	return m.stagingUI.showAuto[insertID]
+	return m.stagingUI.showAuto[m.insertDraftKey(insertID)]
 }
 
 func (m *Model) setShowAutoForInsert(insertID dto.InsertDraftID, show bool) {
+	key := m.insertDraftKey(insertID)
 	if !show {
 		if len(m.stagingUI.showAuto) == 0 {
 			return
 		}
-		delete(m.stagingUI.showAuto, insertID)
+		delete(m.stagingUI.showAuto, key)
 		if len(m.stagingUI.showAuto) == 0 {
 			m.stagingUI.showAuto = nil
 		}
 		return
 	}
 	if m.stagingUI.showAuto == nil {
-		m.stagingUI.showAuto = make(map[dto.InsertDraftID]bool)
+		m.stagingUI.showAuto = make(map[insertDraftKey]bool)
 	}
-	m.stagingUI.showAuto[insertID] = true
+	m.stagingUI.showAuto[key] = true
+}
+
+func (m *Model) insertDraftKey(insertID dto.InsertDraftID) insertDraftKey {
+	return insertDraftKey{table: m.currentTableName(), id: insertID}
 }
@@ -0,0 +1,149 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/usecase"
+)
+
+var errDuplicateBrowseOnly = errors.New("row has values too large to copy and no key to read them by")
+
+// duplicateSelectedRow stages a copy of the selected row as a new pending
+// insert. Persisted rows are read again by identity, so the copy holds full
+// values instead of browse placeholders; their staged edits are applied on
+// top when the read arrives.
+func (m *Model) duplicateSelectedRow() (tea.Model, tea.Cmd) {
+	if m.read.viewMode != ViewRecords || m.read.focus != FocusContent {
+		return m, nil
+	}
+	if m.read.recordSelection < 0 || m.read.recordSelection >= m.totalRecordRows() {
+		return m, nil
+	}
+	if len(m.read.schema.Columns) == 0 {
+		m.ui.statusMessage = "Error: no schema loaded"
+		return m, nil
+	}
+	if !m.ensureCurrentTableWritable(usecase.TableWriteInsert) {
+		return m, nil
+	}
+	if insert, isInsert := m.pendingInsertForSelection(); isInsert {
+		values := make(map[int]dto.StagedValue, len(insert.Values))
+		for columnIndex, edit := range insert.Values {
+			values[columnIndex] = edit.Value
+		}
+		return m.stageDuplicateInsert(values)
+	}
+	persistedIndex := m.persistedRowIndex(m.read.recordSelection)
+	if persistedIndex < 0 {
+		return m, nil
+	}
+	record := m.read.records[persistedIndex]
+	if record.IdentityUnavailable || len(record.Identity.Keys) == 0 {
+		values := make(map[int]dto.StagedValue, len(record.Values))
+		for columnIndex, value := range record.Values {
+			if columnIndex < len(record.EditableFromBrowse) && !record.EditableFromBrowse[columnIndex] {
+				m.ui.statusMessage = "Error: " + errDuplicateBrowseOnly.Error()
+				return m, nil
+			}
+			values[columnIndex] = browseStagedValue(value)
+		}
+		return m.stageDuplicateInsert(values)
+	}
+	if m.readRecord == nil {
+		m.ui.statusMessage = "Error: record read unavailable"
+		return m, nil
+	}
+	rowKey, _ := m.recordKeyForPersistedRow(persistedIndex)
+	return m, readDuplicateRecordCmd(m.runtimeReadContext(), m.readRecord, m.currentTableName(), rowKey, record.Identity, m.runtimeBundleToken)
+}
+
+func (m *Model) handleDuplicateRecordReadMsg(msg duplicateRecordReadMsg) (tea.Model, tea.Cmd) {
+	if msg.bundleToken != m.runtimeBundleToken || msg.table != m.currentTableName() {
+		return m, nil
+	}
+	if msg.err != nil {
+		m.ui.statusMessage = "Error: " + msg.err.Error()
+		return m, nil
+	}
+	values := make(map[int]dto.StagedValue, len(msg.values))
+	for columnIndex, value := range msg.values {
+		values[columnIndex] = value
+	}
+	if edits, ok := m.currentStagingSnapshot().PendingUpdates[msg.rowKey]; ok && msg.rowKey != "" {
+		for columnIndex, edit := range edits.Changes {
+			values[columnIndex] = edit.Value
+		}
+	}
+	return m.stageDuplicateInsert(values)
+}
+
+// stageDuplicateInsert adds the copy on top of the records and focuses its
+// first unique column that still holds a copied value.
+func (m *Model) stageDuplicateInsert(values map[int]dto.StagedValue) (tea.Model, tea.Cmd) {
+	insertID, err := m.stagingSessionUseCase().DuplicateInsert(m.read.schema, values)
+	if err != nil {
+		m.ui.statusMessage = "Error: " + err.Error()
+		return m, nil
+	}
+	m.syncStagingSnapshot()
+
+	policy := m.stagingPolicyUseCase()
+	copiedUnique := map[int]dto.StagedValue{}
+	var uniqueNames []string
+	for columnIndex, column := range m.read.schema.Columns {
+		source, ok := values[columnIndex]
+		if !ok || !column.Unique {
+			continue
+		}
+		if value, copied := policy.DuplicateInsertValue(column, source); copied {
+			copiedUnique[columnIndex] = value
+			uniqueNames = append(uniqueNames, column.Name)
+		}
+	}
+	m.setDuplicateUniqueValues(insertID, copiedUnique)
+
+	m.read.recordSelection = 0
+	m.read.recordColumn = m.defaultRecordColumnForRow(0)
+	m.read.recordFieldFocus = true
+	if len(uniqueNames) == 0 {
+		m.ui.statusMessage = "Row duplicated as a pending insert"
+		return m, nil
+	}
+	for _, columnIndex := range m.visibleColumnIndicesForRow(0) {
+		if _, ok := copiedUnique[columnIndex]; ok {
+			m.read.recordColumn = columnIndex
+			break
+		}
+	}
+	m.ui.statusMessage = fmt.Sprintf("Row duplicated as a pending insert; adjust unique %s", strings.Join(uniqueNames, ", "))
+	return m, nil
+}
+
+func (m *Model) setDuplicateUniqueValues(insertID dto.InsertDraftID, values map[int]dto.StagedValue) {
+	if len(values) == 0 {
+		return
+	}
+	if m.stagingUI.duplicateUnique == nil {
+		m.stagingUI.duplicateUnique = make(map[insertDraftKey]map[int]dto.StagedValue)
+	}
+	m.stagingUI.duplicateUnique[m.insertDraftKey(insertID)] = values
+}
+
+// duplicateUniqueNeedsAdjust reports whether a unique cell of a duplicated
+// insert still holds the value copied from its source row.
+func (m *Model) duplicateUniqueNeedsAdjust(insert dto.InsertDraftSnapshot, columnIndex int) bool {
+	copied, ok := m.stagingUI.duplicateUnique[m.insertDraftKey(insert.ID)][columnIndex]
+	if !ok {
+		return false
+	}
+	current, ok := insert.Values[columnIndex]
+	if !ok {
+		return false
+	}
+	return current.Value.IsNull == copied.IsNull && displayValue(current.Value) == displayValue(copied)
+}
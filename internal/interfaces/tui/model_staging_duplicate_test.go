@@ -0,0 +1,172 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/interfaces/tui/internal/primitives"
+)
+
+func newDuplicateTestModel(readRecord *spyReadRecordUseCase) *Model {
+	return &Model{
+		ctx:        context.Background(),
+		readRecord: readRecord,
+		read: runtimeReadState{
+			viewMode: ViewRecords,
+			focus:    FocusContent,
+			tables:   []dto.Table{{Name: "users", Kind: dto.TableKindTable}},
+			records: []dto.RecordRow{{
+				Values:             []string{"1", "alice@example.com", "<truncated 262145 bytes>"},
+				RowKey:             "id=1",
+				Identity:           yankIdentityForTest(),
+				EditableFromBrowse: []bool{true, true, false},
+			}},
+			schema: dto.Schema{Columns: []dto.SchemaColumn{
+				{Name: "id", Type: "INTEGER", PrimaryKey: true, AutoIncrement: true},
+				{Name: "email", Type: "TEXT", Unique: true},
+				{Name: "bio", Type: "TEXT", Nullable: true},
+			}},
+		},
+	}
+}
+
+func pressDuplicateKeyForTest(model *Model) tea.Cmd {
+	_, cmd := model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'I'}})
+	return cmd
+}
+
+func TestHandleKey_DuplicateStagesStoredRowWithEditsAndResetsKey(t *testing.T) {
+	// Arrange
+	readRecord := &spyReadRecordUseCase{values: []dto.StagedValue{
+		{Text: "1", Raw: int64(1)},
+		{Text: "alice@example.com", Raw: "alice@example.com"},
+		{Text: "full bio", Raw: "full bio"},
+	}}
+	model := newDuplicateTestModel(readRecord)
+	model = withTestStaging(model, stagingState{pendingUpdates: map[string]recordEdits{
+		"id=1": {
+			identity: yankIdentityForTest(),
+			changes:  map[int]stagedEdit{2: {Value: dto.StagedValue{Text: "edited bio", Raw: "edited bio"}}},
+		},
+	}})
+
+	// Act
+	cmd := pressDuplicateKeyForTest(model)
+	if cmd == nil {
+		t.Fatal("expected record read command")
+	}
+	model.Update(cmd())
+
+	// Assert
+	if readRecord.lastTable != "users" || !reflect.DeepEqual(readRecord.lastIdentity, yankIdentityForTest()) {
+		t.Fatalf("expected selected row to be read, got %q %+v", readRecord.lastTable, readRecord.lastIdentity)
+	}
+	inserts := model.currentStagingSnapshot().PendingInserts
+	if len(inserts) != 1 {
+		t.Fatalf("expected one pending insert, got %d", len(inserts))
+	}
+	values := inserts[0].Values
+	if values[0].Value.Text == "1" {
+		t.Fatalf("expected primary key to be reset, got %+v", values[0].Value)
+	}
+	if values[1].Value.Text != "alice@example.com" || values[2].Value.Text != "edited bio" {
+		t.Fatalf("expected copied values with staged edit, got %+v", values)
+	}
+	if model.read.recordSelection != 0 || !model.read.recordFieldFocus || model.read.recordColumn != 1 {
+		t.Fatalf("expected focus on unique column of new row, got row %d column %d", model.read.recordSelection, model.read.recordColumn)
+	}
+	if !model.duplicateUniqueNeedsAdjust(inserts[0], 1) {
+		t.Fatal("expected copied unique column to be flagged")
+	}
+	if model.ui.statusMessage != "Row duplicated as a pending insert; adjust unique email" {
+		t.Fatalf("unexpected status %q", model.ui.statusMessage)
+	}
+
+	// Act
+	model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'u'}})
+
+	// Assert
+	if len(model.currentStagingSnapshot().PendingInserts) != 0 {
+		t.Fatal("expected undo to remove the duplicated row")
+	}
+}
+
+func TestHandleKey_DuplicateCopiesPendingInsert(t *testing.T) {
+	// Arrange
+	model := newDuplicateTestModel(&spyReadRecordUseCase{err: errors.New("unexpected read")})
+	model.read.schema.Columns[1].Unique = false
+	model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}})
+	insertID := model.currentStagingSnapshot().PendingInserts[0].ID
+	if err := model.stagingSessionUseCase().StageInsertEdit(insertID, 1, dto.StagedValue{Text: "bob@example.com", Raw: "bob@example.com"}); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	model.syncStagingSnapshot()
+
+	// Act
+	cmd := pressDuplicateKeyForTest(model)
+
+	// Assert
+	if cmd != nil {
+		t.Fatal("expected pending insert to be copied without a read")
+	}
+	inserts := model.currentStagingSnapshot().PendingInserts
+	if len(inserts) != 2 || inserts[0].ID == insertID {
+		t.Fatalf("expected new insert on top, got %+v", inserts)
+	}
+	if inserts[0].Values[1].Value.Text != "bob@example.com" {
+		t.Fatalf("expected copied email, got %+v", inserts[0].Values[1].Value)
+	}
+	if model.ui.statusMessage != "Row duplicated as a pending insert" {
+		t.Fatalf("unexpected status %q", model.ui.statusMessage)
+	}
+}
+
+func TestHandleKey_DuplicateRefusesViewWithoutInsert(t *testing.T) {
+	// Arrange
+	model := newDuplicateTestModel(&spyReadRecordUseCase{})
+	model.read.tables = []dto.Table{{Name: "users", Kind: dto.TableKindView}}
+
+	// Act
+	cmd := pressDuplicateKeyForTest(model)
+
+	// Assert
+	if cmd != nil || len(model.currentStagingSnapshot().PendingInserts) != 0 {
+		t.Fatal("expected no duplicate for read-only view")
+	}
+	if !strings.HasPrefix(model.ui.statusMessage, "Error:") {
+		t.Fatalf("expected error status, got %q", model.ui.statusMessage)
+	}
+}
+
+func TestRenderRecords_MarksCopiedUniqueValueUntilChanged(t *testing.T) {
+	// Arrange
+	model := newDuplicateTestModel(&spyReadRecordUseCase{values: []dto.StagedValue{
+		{Text: "1", Raw: int64(1)},
+		{Text: "alice@example.com", Raw: "alice@example.com"},
+		{Text: "bio", Raw: "bio"},
+	}})
+	model.Update(pressDuplicateKeyForTest(model)())
+	insertID := model.currentStagingSnapshot().PendingInserts[0].ID
+
+	// Act
+	flagged := strings.Join(model.renderRecords(120, 5), "\n")
+	if err := model.stagingSessionUseCase().StageInsertEdit(insertID, 1, dto.StagedValue{Text: "new@example.com", Raw: "new@example.com"}); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	model.syncStagingSnapshot()
+	adjusted := strings.Join(model.renderRecords(120, 5), "\n")
+
+	// Assert
+	if !strings.Contains(flagged, primitives.IconAdjust+" alice@example.com") {
+		t.Fatalf("expected copied unique value to be marked, got %q", flagged)
+	}
+	if strings.Contains(adjusted, primitives.IconAdjust) {
+		t.Fatalf("expected marker to clear after change, got %q", adjusted)
+	}
+}
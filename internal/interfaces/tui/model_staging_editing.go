@@ -6,6 +6,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/usecase"
 )
 
 func (m *Model) addPendingInsert() (tea.Model, tea.Cmd) {
@@ -16,6 +17,9 @@ func (m *Model) addPendingInsert() (tea.Model, tea.Cmd) {
 		m.ui.statusMessage = "Error: no schema loaded"
 		return m, nil
 	}
+	if !m.ensureCurrentTableWritable(usecase.TableWriteInsert) {
+		return m, nil
+	}
 	if _, err := m.stagingSessionUseCase().AddInsert(m.read.schema); err != nil {
 		m.ui.statusMessage = "Error: " + err.Error()
 		return m, nil
@@ -43,6 +47,9 @@ func (m *Model) toggleDeleteSelection() (tea.Model, tea.Cmd) {
 		m.normalizeRecordSelection()
 		return m, nil
 	}
+	if !m.ensureCurrentTableWritable(usecase.TableWriteDelete) {
+		return m, nil
+	}
 	recordRef, err := m.persistedRecordRefForVisibleRow(m.read.recordSelection)
 	if err != nil {
 		m.ui.statusMessage = "Error: " + err.Error()
@@ -126,7 +133,7 @@ func (m *Model) stageEdit(rowIndex, columnIndex int, value dto.StagedValue) erro
 		recordRef.RowKey,
 		recordRef.Identity,
 		columnIndex,
-		m.visibleRowValue(rowIndex, columnIndex),
+		m.recordOriginalValue(m.persistedRowIndex(rowIndex), columnIndex),
 		value,
 	); err != nil {
 		return err
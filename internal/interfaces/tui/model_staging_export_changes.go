@@ -0,0 +1,38 @@
+package tui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// requestExportChanges writes every staged change to path as a SQL script,
+// leaving the staged state untouched.
+func (m *Model) requestExportChanges(path string) (tea.Model, tea.Cmd) {
+	if !m.nonBlockingRuntimeCommandContextActive() {
+		return m, nil
+	}
+	changeSets, err := m.buildDatabaseChanges()
+	if err != nil {
+		m.ui.statusMessage = "Error: " + err.Error()
+		return m, nil
+	}
+	if len(changeSets) == 0 {
+		m.ui.statusMessage = "No changes to export"
+		return m, nil
+	}
+	if m.exportChanges == nil {
+		m.ui.statusMessage = "Error: export use case unavailable"
+		return m, nil
+	}
+	return m, exportChangesCmd(m.ctx, m.exportChanges, path, changeSets)
+}
+
+func (m *Model) handleExportChangesMsg(msg exportChangesMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		m.ui.statusMessage = "Error: " + msg.err.Error()
+		return m, nil
+	}
+	m.ui.statusMessage = fmt.Sprintf("Exported %d statement(s) to %s", msg.count, msg.path)
+	return m, nil
+}
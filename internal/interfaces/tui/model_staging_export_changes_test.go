@@ -0,0 +1,70 @@
+package tui
+
+import (
+	"errors"
+	"testing"
+)
+
+func newExportChangesTestModel(exportChanges *spyExportChangesUseCase) *Model {
+	model := newSaveConflictTestModel(&spySaveChangesUseCase{})
+	model.exportChanges = exportChanges
+	return model
+}
+
+func TestSubmitCommandInput_ExportChangesWritesScriptAndKeepsStagedChanges(t *testing.T) {
+	// Arrange
+	exportChanges := &spyExportChangesUseCase{count: 1}
+	model := newExportChangesTestModel(exportChanges)
+
+	// Act
+	cmd := submitRuntimeCommandForTest(model, "export-changes /tmp/fix.sql")
+	if cmd == nil {
+		t.Fatal("expected export command")
+	}
+	model.Update(cmd())
+
+	// Assert
+	if exportChanges.lastPath != "/tmp/fix.sql" {
+		t.Fatalf("expected export path %q, got %q", "/tmp/fix.sql", exportChanges.lastPath)
+	}
+	if len(exportChanges.lastChangeSets) != 1 || exportChanges.lastChangeSets[0].Table != "users" {
+		t.Fatalf("expected users change set to be exported, got %+v", exportChanges.lastChangeSets)
+	}
+	if model.ui.statusMessage != "Exported 1 statement(s) to /tmp/fix.sql" {
+		t.Fatalf("unexpected status %q", model.ui.statusMessage)
+	}
+	if !model.hasDirtyEdits() {
+		t.Fatal("expected export to keep staged changes")
+	}
+}
+
+func TestSubmitCommandInput_ExportChangesWithoutChangesShowsStatus(t *testing.T) {
+	// Arrange
+	exportChanges := &spyExportChangesUseCase{}
+	model := withTestStaging(newExportChangesTestModel(exportChanges), stagingState{})
+
+	// Act
+	cmd := submitRuntimeCommandForTest(model, "export-changes /tmp/fix.sql")
+
+	// Assert
+	if cmd != nil {
+		t.Fatal("expected no export command without staged changes")
+	}
+	if model.ui.statusMessage != "No changes to export" {
+		t.Fatalf("unexpected status %q", model.ui.statusMessage)
+	}
+}
+
+func TestSubmitCommandInput_ExportChangesErrorShowsStatus(t *testing.T) {
+	// Arrange
+	exportChanges := &spyExportChangesUseCase{err: errors.New("permission denied")}
+	model := newExportChangesTestModel(exportChanges)
+
+	// Act
+	model.Update(submitRuntimeCommandForTest(model, "export-changes /tmp/fix.sql")())
+
+	// Assert
+	if model.ui.statusMessage != "Error: permission denied" {
+		t.Fatalf("unexpected status %q", model.ui.statusMessage)
+	}
+}
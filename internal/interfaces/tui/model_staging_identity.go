@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/usecase"
 )
 
 func (m *Model) recordValue(rowIndex, columnIndex int) string {
@@ -17,6 +18,13 @@ func (m *Model) recordValue(rowIndex, columnIndex int) string {
 	return values[columnIndex]
 }
 
+func (m *Model) recordOriginalValue(rowIndex, columnIndex int) dto.StagedValue {
+	if rowIndex < 0 || rowIndex >= len(m.read.records) {
+		return dto.StagedValue{}
+	}
+	return usecase.PersistedOriginalValue(m.read.records[rowIndex], columnIndex)
+}
+
 func (m *Model) stagedEditForRow(rowIndex, columnIndex int) (dto.StagedEdit, bool) {
 	persistedIndex := m.persistedRowIndex(rowIndex)
 	if persistedIndex < 0 {
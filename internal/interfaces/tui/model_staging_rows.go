@@ -107,6 +107,9 @@ func (m *Model) visibleColumnIndicesForRow(rowIndex int) []int {
 		if isInsert && !m.showAutoForInsert(insert.ID) && column.AutoIncrement {
 			continue
 		}
+		if isInsert && column.Generated {
+			continue
+		}
 		columns = append(columns, idx)
 	}
 	return columns
@@ -63,6 +63,33 @@ func TestHandleKey_InsertCreatesPendingRowAtTop(t *testing.T) {
 	}
 }
 
+func TestHandleKey_InsertSkipsGeneratedColumns(t *testing.T) {
+	// Arrange
+	model := &Model{
+		read: runtimeReadState{
+			viewMode: ViewRecords,
+			focus:    FocusContent,
+			schema: dto.Schema{
+				Columns: []dto.SchemaColumn{
+					{Name: "total", Type: "INTEGER", Generated: true},
+					{Name: "qty", Type: "INTEGER", Nullable: false},
+				},
+			},
+		},
+	}
+
+	// Act
+	model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}})
+
+	// Assert
+	if model.read.recordColumn != 1 {
+		t.Fatalf("expected first editable column to skip generated field, got %d", model.read.recordColumn)
+	}
+	if got := model.visibleColumnIndicesForSelection(); len(got) != 1 || got[0] != 1 {
+		t.Fatalf("expected generated column hidden from pending insert navigation, got %v", got)
+	}
+}
+
 func TestHandleKey_DeleteTogglesPersistedRow(t *testing.T) {
 	// Arrange
 	model := &Model{
@@ -144,7 +171,7 @@ func TestHandleKey_DeleteBlocksUnremovableRows(t *testing.T) {
 					{Name: "name", Type: "TEXT"},
 				},
 			},
-			wantErr: "Error: table has no primary key",
+			wantErr: "Error: table has no primary key or rowid",
 		},
 	}
 
@@ -186,3 +213,194 @@ func TestHandleKey_DeleteRemovesPendingInsert(t *testing.T) {
 		t.Fatalf("expected pending insert to be removed")
 	}
 }
+
+func newViewStagingTestModel(table dto.Table) *Model {
+	return &Model{
+		read: runtimeReadState{
+			viewMode: ViewRecords,
+			focus:    FocusContent,
+			tables:   []dto.Table{table},
+			records: []dto.RecordRow{{
+				Values: []string{"1", "alice"},
+				RowKey: "id=1",
+				Identity: dto.RecordIdentity{
+					Keys: []dto.RecordIdentityKey{
+						{Column: "id", Value: dto.StagedValue{Text: "1", Raw: int64(1)}},
+					},
+				},
+			}},
+			schema: dto.Schema{
+				Columns: []dto.SchemaColumn{
+					{Name: "id", Type: "INTEGER", PrimaryKey: true},
+					{Name: "name", Type: "TEXT", Nullable: true},
+				},
+			},
+			recordColumn: 1,
+		},
+	}
+}
+
+func TestHandleKey_ViewWithoutTriggersRefusesWrites(t *testing.T) {
+	view := dto.Table{Name: "active_users", Kind: dto.TableKindView}
+
+	t.Run("insert", func(t *testing.T) {
+		// Arrange
+		model := newViewStagingTestModel(view)
+
+		// Act
+		model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}})
+
+		// Assert
+		if len(model.currentStagingSnapshot().PendingInserts) != 0 {
+			t.Fatalf("expected no pending inserts on read-only view")
+		}
+		if model.ui.statusMessage != "Error: view is read-only: no INSTEAD OF INSERT trigger on active_users" {
+			t.Fatalf("unexpected status %q", model.ui.statusMessage)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		// Arrange
+		model := newViewStagingTestModel(view)
+
+		// Act
+		model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+
+		// Assert
+		if len(model.currentStagingSnapshot().PendingDeletes) != 0 {
+			t.Fatalf("expected no pending deletes on read-only view")
+		}
+		if model.ui.statusMessage != "Error: view is read-only: no INSTEAD OF DELETE trigger on active_users" {
+			t.Fatalf("unexpected status %q", model.ui.statusMessage)
+		}
+	})
+
+	t.Run("edit", func(t *testing.T) {
+		// Arrange
+		model := newViewStagingTestModel(view)
+
+		// Act
+		model.openEditPopup()
+
+		// Assert
+		if model.overlay.editPopup.active {
+			t.Fatal("expected edit popup to stay closed on read-only view")
+		}
+		if model.ui.statusMessage != "Error: view is read-only: no INSTEAD OF UPDATE trigger on active_users" {
+			t.Fatalf("unexpected status %q", model.ui.statusMessage)
+		}
+	})
+}
+
+func TestHandleKey_ReadOnlySessionRefusesWrites(t *testing.T) {
+	table := dto.Table{Name: "users", Kind: dto.TableKindTable}
+	const expectedStatus = "Error: session is read-only"
+
+	t.Run("insert", func(t *testing.T) {
+		// Arrange
+		model := newViewStagingTestModel(table)
+		model.readOnly = true
+
+		// Act
+		model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}})
+
+		// Assert
+		if len(model.currentStagingSnapshot().PendingInserts) != 0 {
+			t.Fatal("expected no pending inserts in read-only session")
+		}
+		if model.ui.statusMessage != expectedStatus {
+			t.Fatalf("unexpected status %q", model.ui.statusMessage)
+		}
+	})
+
+	t.Run("duplicate", func(t *testing.T) {
+		// Arrange
+		model := newViewStagingTestModel(table)
+		model.readOnly = true
+
+		// Act
+		model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'I'}})
+
+		// Assert
+		if len(model.currentStagingSnapshot().PendingInserts) != 0 {
+			t.Fatal("expected no duplicated row in read-only session")
+		}
+		if model.ui.statusMessage != expectedStatus {
+			t.Fatalf("unexpected status %q", model.ui.statusMessage)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		// Arrange
+		model := newViewStagingTestModel(table)
+		model.readOnly = true
+
+		// Act
+		model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+
+		// Assert
+		if len(model.currentStagingSnapshot().PendingDeletes) != 0 {
+			t.Fatal("expected no pending deletes in read-only session")
+		}
+		if model.ui.statusMessage != expectedStatus {
+			t.Fatalf("unexpected status %q", model.ui.statusMessage)
+		}
+	})
+
+	t.Run("edit", func(t *testing.T) {
+		// Arrange
+		model := newViewStagingTestModel(table)
+		model.readOnly = true
+		model.read.recordFieldFocus = true
+
+		// Act
+		model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+
+		// Assert
+		if model.overlay.editPopup.active {
+			t.Fatal("expected edit popup to stay closed in read-only session")
+		}
+		if model.ui.statusMessage != expectedStatus {
+			t.Fatalf("unexpected status %q", model.ui.statusMessage)
+		}
+	})
+
+	for _, command := range []string{"w", "wq"} {
+		t.Run(":"+command, func(t *testing.T) {
+			// Arrange
+			model := newViewStagingTestModel(table)
+			model.readOnly = true
+
+			// Act
+			cmd := submitRuntimeCommand(t, model, command)
+
+			// Assert
+			if cmd != nil {
+				t.Fatal("expected no save or quit command in read-only session")
+			}
+			if model.ui.saveInFlight {
+				t.Fatal("expected no save to start in read-only session")
+			}
+			if model.ui.statusMessage != expectedStatus {
+				t.Fatalf("unexpected status %q", model.ui.statusMessage)
+			}
+		})
+	}
+}
+
+func TestHandleKey_ViewWithInsteadOfInsertTriggerAllowsInsert(t *testing.T) {
+	// Arrange
+	model := newViewStagingTestModel(dto.Table{
+		Name:      "active_users",
+		Kind:      dto.TableKindView,
+		InsteadOf: dto.TableWriteTriggers{Insert: true},
+	})
+
+	// Act
+	model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}})
+
+	// Assert
+	if len(model.currentStagingSnapshot().PendingInserts) != 1 {
+		t.Fatalf("expected one pending insert, got %d", len(model.currentStagingSnapshot().PendingInserts))
+	}
+}
@@ -0,0 +1,109 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/usecase"
+)
+
+const (
+	saveConflictDecisionReload = "save_conflict_reload"
+	saveConflictDecisionForce  = "save_conflict_force"
+	saveConflictDecisionCancel = "save_conflict_cancel"
+)
+
+type saveConflictState struct {
+	conflicts []dto.RecordConflict
+	intent    usecase.RuntimeSaveIntent
+}
+
+// openSaveConflictReport shows the records a save conflicted with and lets
+// the user reapply the staged changes onto the current values or force them.
+// It reports false when err is not a save conflict.
+func (m *Model) openSaveConflictReport(err error, successAction usecase.RuntimeSaveSuccessAction) bool {
+	var conflictErr *usecase.SaveConflictError
+	if !errors.As(err, &conflictErr) {
+		return false
+	}
+	intent := usecase.RuntimeSaveIntentSaveOnly
+	if successAction == usecase.RuntimeSaveSuccessActionQuitRuntime {
+		intent = usecase.RuntimeSaveIntentSaveAndQuit
+	}
+	m.overlay.pendingSaveConflict = &saveConflictState{
+		conflicts: conflictErr.Conflicts,
+		intent:    intent,
+	}
+	m.openModalConfirmPopupWithOptions(
+		"Save Conflict",
+		fmt.Sprintf("%d record(s) changed since they were loaded; nothing was saved", len(conflictErr.Conflicts)),
+		[]confirmOption{
+			{label: "Reload and reapply staged changes", decisionID: saveConflictDecisionReload},
+			{label: "Force save over current values", decisionID: saveConflictDecisionForce},
+			{label: "Cancel", decisionID: saveConflictDecisionCancel},
+		},
+		0,
+	)
+	m.overlay.confirmPopup.details = saveConflictReportLines(conflictErr.Conflicts)
+	return true
+}
+
+func (m *Model) resolveSaveConflict(decisionID string) (tea.Model, tea.Cmd) {
+	conflict := m.overlay.pendingSaveConflict
+	m.overlay.pendingSaveConflict = nil
+	if conflict == nil {
+		return m, nil
+	}
+	switch decisionID {
+	case saveConflictDecisionReload:
+		m.ui.pendingNavigation = nil
+		m.ui.pendingCommandInput = ""
+		m.syncCurrentTableSchema()
+		rebased := m.stagingWorkspaceUseCase().RebaseConflicts(conflict.conflicts)
+		m.syncStagingSnapshot()
+		m.normalizeRecordSelection()
+		m.ui.statusMessage = fmt.Sprintf("Reloaded %d conflicting record(s); staged changes reapplied", rebased)
+		m.invalidateRecordCount()
+		return m, m.loadRecordsCmd(false)
+	case saveConflictDecisionForce:
+		return m.beginRuntimeSave(conflict.intent, true)
+	default:
+		m.ui.pendingNavigation = nil
+		m.ui.pendingCommandInput = ""
+		return m, nil
+	}
+}
+
+func saveConflictReportLines(conflicts []dto.RecordConflict) []string {
+	lines := make([]string, 0, len(conflicts)*2)
+	for _, conflict := range conflicts {
+		label := conflict.Table + " " + recordIdentityLabel(conflict.Identity)
+		if conflict.Missing {
+			lines = append(lines, label+": removed")
+			continue
+		}
+		lines = append(lines, label+":")
+		for _, column := range conflict.Columns {
+			lines = append(lines, fmt.Sprintf(
+				"  %s: original %s, current %s, staged %s",
+				column.Column,
+				displayValue(column.Original),
+				displayValue(column.Current),
+				displayValue(column.Staged),
+			))
+		}
+	}
+	return lines
+}
+
+func recordIdentityLabel(identity dto.RecordIdentity) string {
+	parts := make([]string, 0, len(identity.Keys))
+	for _, key := range identity.Keys {
+		parts = append(parts, key.Column+"="+displayValue(key.Value))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -3,7 +3,6 @@ package tui
 import (
 	tea "github.com/charmbracelet/bubbletea"
 
-	"github.com/mgierok/dbc/internal/application/dto"
 	"github.com/mgierok/dbc/internal/application/usecase"
 )
 
@@ -40,25 +39,37 @@ func (m *Model) requestRuntimeSave(intent usecase.RuntimeSaveIntent) (tea.Model,
 		m.ui.statusMessage = "Error: save use case unavailable"
 		return m, nil
 	}
+	if m.confirmSave {
+		return m.beginSavePreview(true, intent)
+	}
 	return m.startRuntimeSave(intent)
 }
 
 func (m *Model) startRuntimeSave(intent usecase.RuntimeSaveIntent) (tea.Model, tea.Cmd) {
-	changes, err := m.buildTableChanges()
+	return m.beginRuntimeSave(intent, false)
+}
+
+// beginRuntimeSave starts saving the staged changes; force skips the
+// concurrency checks after the user chose to overwrite conflicting records.
+func (m *Model) beginRuntimeSave(intent usecase.RuntimeSaveIntent, force bool) (tea.Model, tea.Cmd) {
+	changeSets, err := m.buildDatabaseChanges()
 	if err != nil {
 		m.ui.pendingSaveSuccessAction = usecase.RuntimeSaveSuccessActionNone
 		m.ui.statusMessage = "Error: " + err.Error()
 		return m, nil
 	}
-	decision := m.saveWorkflowUseCase().PlanStart(intent, hasEffectiveTableChanges(changes))
+	decision := m.saveWorkflowUseCase().PlanStart(intent, len(changeSets) > 0)
 	if !decision.StartSave {
 		m.ui.pendingSaveSuccessAction = usecase.RuntimeSaveSuccessActionNone
 		return m.applyRuntimeSaveRequestDecision(decision)
 	}
+	for i := range changeSets {
+		changeSets[i].Changes.Force = force
+	}
 	m.ui.saveInFlight = true
 	m.ui.pendingSaveSuccessAction = decision.SuccessAction
 	m.ui.statusMessage = "Saving changes..."
-	return m, saveChangesCmd(m.ctx, m.saveChanges, m.currentTableName(), changes)
+	return m, saveChangesCmd(m.ctx, m.saveChanges, changeSets)
 }
 
 func (m *Model) startSaveForPendingNavigation() (tea.Model, tea.Cmd) {
@@ -66,15 +77,21 @@ func (m *Model) startSaveForPendingNavigation() (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 	model, cmd := m.requestRuntimeSave(usecase.RuntimeSaveIntentSaveOnly)
-	if m.ui.saveInFlight {
+	if m.ui.saveInFlight || m.ui.savePreviewInFlight {
 		return model, cmd
 	}
+	m.dropPendingNavigation()
+	return model, cmd
+}
+
+// dropPendingNavigation abandons navigation that waited on a save which did
+// not start, restoring the submitted command so the user can retry it.
+func (m *Model) dropPendingNavigation() {
 	m.ui.pendingNavigation = nil
 	if m.ui.pendingCommandInput != "" {
 		m.restoreEditingCommandInput(m.ui.pendingCommandInput)
 	}
 	m.ui.pendingCommandInput = ""
-	return model, cmd
 }
 
 func (m *Model) applyRuntimeSaveRequestDecision(decision usecase.RuntimeSaveRequestDecision) (tea.Model, tea.Cmd) {
@@ -86,7 +103,3 @@ func (m *Model) applyRuntimeSaveRequestDecision(decision usecase.RuntimeSaveRequ
 	}
 	return m, nil
 }
-
-func hasEffectiveTableChanges(changes dto.TableChanges) bool {
-	return len(changes.Inserts) > 0 || len(changes.Updates) > 0 || len(changes.Deletes) > 0
-}
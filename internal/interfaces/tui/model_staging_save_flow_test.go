@@ -118,7 +118,7 @@ func TestConfirmSaveChanges_StartsBlockingSaveStateAndShowsSavingStatus(t *testi
 	assertRuntimeSaveStarted(t, model, "confirmSaveChanges")
 }
 
-func TestSetTableSelection_WithDirtyStateOpensInformationalSwitchTablePopup(t *testing.T) {
+func TestSetTableSelection_WithDirtyStateSwitchesAndKeepsStagedChanges(t *testing.T) {
 	// Arrange
 	model := newDirtyTableSwitchModel()
 
@@ -126,106 +126,40 @@ func TestSetTableSelection_WithDirtyStateOpensInformationalSwitchTablePopup(t *t
 	model.setTableSelection(1)
 
 	// Assert
-	if !model.overlay.confirmPopup.active {
-		t.Fatalf("expected discard confirmation popup")
-	}
-	if !model.overlay.confirmPopup.modal {
-		t.Fatalf("expected table switch popup to be modal")
-	}
-	if model.overlay.confirmPopup.title != "Switch Table" {
-		t.Fatalf("expected switch table title, got %q", model.overlay.confirmPopup.title)
-	}
-	if !strings.Contains(model.overlay.confirmPopup.message, "Switching tables will cause loss of unsaved data (3 rows).") {
-		t.Fatalf("expected message with unsaved row count, got %q", model.overlay.confirmPopup.message)
+	if model.overlay.confirmPopup.active {
+		t.Fatalf("expected table switch without confirmation popup, got %q", model.overlay.confirmPopup.title)
 	}
-	if !strings.Contains(model.overlay.confirmPopup.message, "Are you sure you want to discard unsaved data?") {
-		t.Fatalf("expected discard confirmation question, got %q", model.overlay.confirmPopup.message)
+	if model.read.selectedTable != 1 {
+		t.Fatalf("expected selected table 1, got %d", model.read.selectedTable)
 	}
-	if len(model.overlay.confirmPopup.options) != 2 {
-		t.Fatalf("expected two explicit options, got %d", len(model.overlay.confirmPopup.options))
+	if got := model.tableDirtyEditCount("users"); got != 3 {
+		t.Fatalf("expected users to keep 3 staged rows, got %d", got)
 	}
-	if model.overlay.confirmPopup.options[0].label != "Discard changes and switch table" {
-		t.Fatalf("expected discard option label, got %q", model.overlay.confirmPopup.options[0].label)
+	if got := model.tableDirtyEditCount("orders"); got != 0 {
+		t.Fatalf("expected orders to start without staged rows, got %d", got)
 	}
-	if model.overlay.confirmPopup.options[1].label != "Continue editing" {
-		t.Fatalf("expected continue-editing option label, got %q", model.overlay.confirmPopup.options[1].label)
+	if model.dirtyEditCount() != 3 {
+		t.Fatalf("expected runtime dirty count 3, got %d", model.dirtyEditCount())
 	}
-	if model.read.selectedTable != 0 {
-		t.Fatalf("expected table selection not to change before confirmation")
+	if len(model.stagingSnapshot.PendingUpdates) != 0 || len(model.stagingSnapshot.PendingInserts) != 0 {
+		t.Fatalf("expected orders snapshot to be empty, got %+v", model.stagingSnapshot)
 	}
 }
 
-func TestSetTableSelection_WithDirtyStatePopupDecisions(t *testing.T) {
-	for _, tc := range []struct {
-		name                string
-		keys                []tea.KeyMsg
-		wantSelectedTable   int
-		wantDirty           bool
-		wantPopupActive     bool
-		wantPendingNav      bool
-		wantPendingTable    string
-		wantPendingNavReset bool
-	}{
-		{
-			name:              "discard switches table and clears staging",
-			keys:              []tea.KeyMsg{{Type: tea.KeyEnter}},
-			wantSelectedTable: 1,
-			wantDirty:         false,
-			wantPopupActive:   false,
-		},
-		{
-			name: "continue editing preserves staging",
-			keys: []tea.KeyMsg{
-				{Type: tea.KeyRunes, Runes: []rune{'j'}},
-				{Type: tea.KeyEnter},
-			},
-			wantSelectedTable:   0,
-			wantDirty:           true,
-			wantPopupActive:     false,
-			wantPendingNavReset: true,
-		},
-		{
-			name: "n keeps popup open and navigation pending",
-			keys: []tea.KeyMsg{
-				{Type: tea.KeyRunes, Runes: []rune{'n'}},
-			},
-			wantSelectedTable: 0,
-			wantDirty:         true,
-			wantPopupActive:   true,
-			wantPendingNav:    true,
-			wantPendingTable:  "orders",
-		},
-	} {
-		t.Run(tc.name, func(t *testing.T) {
-			// Arrange
-			model := newDirtyTableSwitchModel()
-			model.setTableSelection(1)
+func TestSetTableSelection_ReturningToTableRestoresStagedChanges(t *testing.T) {
+	// Arrange
+	model := newDirtyTableSwitchModel()
+	model.setTableSelection(1)
 
-			// Act
-			for _, key := range tc.keys {
-				model.handleConfirmPopupKey(key)
-			}
+	// Act
+	model.setTableSelection(0)
 
-			// Assert
-			if model.read.selectedTable != tc.wantSelectedTable {
-				t.Fatalf("expected selected table %d, got %d", tc.wantSelectedTable, model.read.selectedTable)
-			}
-			if model.hasDirtyEdits() != tc.wantDirty {
-				t.Fatalf("expected dirty state %t, got %t", tc.wantDirty, model.hasDirtyEdits())
-			}
-			if model.overlay.confirmPopup.active != tc.wantPopupActive {
-				t.Fatalf("expected popup active=%t, got %t", tc.wantPopupActive, model.overlay.confirmPopup.active)
-			}
-			if tc.wantPendingNav && model.ui.pendingNavigation == nil {
-				t.Fatal("expected pending navigation to remain set")
-			}
-			if !tc.wantPendingNav && tc.wantPendingNavReset && model.ui.pendingNavigation != nil {
-				t.Fatalf("expected pending navigation reset, got %+v", model.ui.pendingNavigation)
-			}
-			if tc.wantPendingTable != "" && model.ui.pendingNavigation.Action.TargetTableName != tc.wantPendingTable {
-				t.Fatalf("expected pending table target %q, got %q", tc.wantPendingTable, model.ui.pendingNavigation.Action.TargetTableName)
-			}
-		})
+	// Assert
+	if _, ok := model.stagingSnapshot.PendingUpdates["id=1"]; !ok {
+		t.Fatalf("expected users staged update to be restored, got %+v", model.stagingSnapshot.PendingUpdates)
+	}
+	if len(model.stagingSnapshot.PendingInserts) != 1 {
+		t.Fatalf("expected users staged insert to be restored, got %d", len(model.stagingSnapshot.PendingInserts))
 	}
 }
 
@@ -235,10 +169,10 @@ func TestSetTableSelection_ClearsSortOnTableSwitch(t *testing.T) {
 		read: runtimeReadState{
 			tables:        []dto.Table{{Name: "users"}, {Name: "orders"}},
 			selectedTable: 0,
-			currentSort: &dto.Sort{
+			currentSort: []dto.Sort{{
 				Column:    "name",
 				Direction: dto.SortDirectionAsc,
-			},
+			}},
 		},
 	}
 
@@ -337,7 +271,7 @@ func TestHandleConfirmPopupKey_SaveDecisionForPendingDatabaseNavigationCleansPen
 	if model.overlay.commandInput.value != "edit" {
 		t.Fatalf("expected restored :edit input, got %q", model.overlay.commandInput.value)
 	}
-	if model.ui.statusMessage != `Error: value for column "name" is required` {
+	if model.ui.statusMessage != `Error: users: value for column "name" is required` {
 		t.Fatalf("expected validation error status, got %q", model.ui.statusMessage)
 	}
 }
@@ -491,3 +425,127 @@ func TestRequestSaveAndQuit_StartsSaveImmediatelyWithoutPopup(t *testing.T) {
 		t.Fatalf("expected save-and-quit flow to set quit action, got %v", model.ui.pendingSaveSuccessAction)
 	}
 }
+
+func newSaveConflictTestModel(saveChanges *spySaveChangesUseCase) *Model {
+	identity := dto.RecordIdentity{
+		Keys: []dto.RecordIdentityKey{{Column: "id", Value: dto.StagedValue{Text: "1", Raw: int64(1)}}},
+	}
+	return withTestStaging(&Model{
+		ctx:         context.Background(),
+		saveChanges: saveChanges,
+		read: runtimeReadState{
+			viewMode: ViewRecords,
+			focus:    FocusContent,
+			schema: dto.Schema{
+				Columns: []dto.SchemaColumn{
+					{Name: "id", Type: "INTEGER", PrimaryKey: true},
+					{Name: "name", Type: "TEXT"},
+				},
+			},
+			tables:  []dto.Table{{Name: "users"}},
+			records: []dto.RecordRow{{Values: []string{"1", "alison"}, RowKey: "id=1", Identity: identity}},
+		},
+	}, stagingState{
+		pendingUpdates: map[string]recordEdits{
+			"id=1": {identity: identity, changes: map[int]stagedEdit{1: {Value: dto.StagedValue{Text: "alicia", Raw: "alicia"}}}},
+		},
+	})
+}
+
+func saveConflictForTest() *usecase.SaveConflictError {
+	return &usecase.SaveConflictError{Conflicts: []dto.RecordConflict{
+		{
+			Table: "users",
+			Identity: dto.RecordIdentity{
+				Keys: []dto.RecordIdentityKey{{Column: "id", Value: dto.StagedValue{Text: "1", Raw: int64(1)}}},
+			},
+			Columns: []dto.ColumnConflict{{
+				Column:   "name",
+				Original: dto.StagedValue{Text: "alison"},
+				Current:  dto.StagedValue{Text: "alice"},
+				Staged:   dto.StagedValue{Text: "alicia", Raw: "alicia"},
+			}},
+		},
+	}}
+}
+
+func TestUpdate_SaveConflictOpensConflictReport(t *testing.T) {
+	// Arrange
+	model := newSaveConflictTestModel(&spySaveChangesUseCase{})
+	model.ui.width = 100
+	model.ui.height = 30
+	_, cmd := model.confirmSaveChanges()
+	if cmd == nil {
+		t.Fatal("expected save command to be returned")
+	}
+
+	// Act
+	model.Update(saveChangesMsg{err: saveConflictForTest()})
+
+	// Assert
+	if !model.overlay.confirmPopup.active || model.overlay.pendingSaveConflict == nil {
+		t.Fatal("expected conflict report popup to open")
+	}
+	view := stripANSI(model.View())
+	for _, expected := range []string{"Save Conflict", "id=1:", "name: original alison, current alice, staged alicia", "Reload and reapply", "Force save"} {
+		if !strings.Contains(view, expected) {
+			t.Fatalf("expected conflict report to contain %q, got %q", expected, view)
+		}
+	}
+	if !model.hasDirtyEdits() {
+		t.Fatal("expected staged changes to be kept after a conflict")
+	}
+}
+
+func TestHandleConfirmPopupKey_SaveConflictDecisions(t *testing.T) {
+	t.Run("force saves over current values", func(t *testing.T) {
+		// Arrange
+		saveChanges := &spySaveChangesUseCase{count: 1}
+		model := newSaveConflictTestModel(saveChanges)
+		model.openSaveConflictReport(saveConflictForTest(), usecase.RuntimeSaveSuccessActionStayInRuntime)
+		model.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+
+		// Act
+		_, cmd := model.handleKey(tea.KeyMsg{Type: tea.KeyEnter})
+
+		// Assert
+		if cmd == nil {
+			t.Fatal("expected forced save command")
+		}
+		cmd()
+		if !saveChanges.lastChanges.Force {
+			t.Fatal("expected forced save to skip concurrency checks")
+		}
+		if model.overlay.pendingSaveConflict != nil {
+			t.Fatal("expected conflict state to be cleared")
+		}
+	})
+
+	t.Run("reload reapplies staged changes onto current values", func(t *testing.T) {
+		// Arrange
+		model := newSaveConflictTestModel(&spySaveChangesUseCase{})
+		model.read.recordTotalCount = 1
+		model.openSaveConflictReport(saveConflictForTest(), usecase.RuntimeSaveSuccessActionStayInRuntime)
+
+		// Act
+		_, cmd := model.handleKey(tea.KeyMsg{Type: tea.KeyEnter})
+
+		// Assert
+		if cmd == nil {
+			t.Fatal("expected records reload command")
+		}
+		edits := model.currentStagingSnapshot().PendingUpdates["id=1"]
+		if edits.Originals[1] != (dto.StagedValue{Text: "alice"}) {
+			t.Fatalf("expected original to become the current value, got %+v", edits.Originals[1])
+		}
+		if displayValue(edits.Changes[1].Value) != "alicia" {
+			t.Fatalf("expected staged value to be reapplied, got %+v", edits.Changes[1])
+		}
+		if model.ui.statusMessage != "Reloaded 1 conflicting record(s); staged changes reapplied" {
+			t.Fatalf("unexpected status %q", model.ui.statusMessage)
+		}
+		if model.read.recordCountStatus != recordCountCounting || !model.read.recordCountQueued {
+			t.Fatalf("expected the reload to restart the record count, got status %v queued %v", model.read.recordCountStatus, model.read.recordCountQueued)
+		}
+	})
+}
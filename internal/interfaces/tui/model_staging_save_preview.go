@@ -0,0 +1,170 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/mgierok/dbc/internal/application/usecase"
+	"github.com/mgierok/dbc/internal/interfaces/tui/internal/primitives"
+)
+
+// requestSavePreview opens the SQL preview of every staged change.
+func (m *Model) requestSavePreview() (tea.Model, tea.Cmd) {
+	if !m.nonBlockingRuntimeCommandContextActive() {
+		return m, nil
+	}
+	return m.beginSavePreview(false, usecase.RuntimeSaveIntentSaveOnly)
+}
+
+// beginSavePreview builds the staged change sets and asks the engine for the
+// statements a save would run. With confirm set, the save for intent starts
+// only after the user accepts the preview.
+func (m *Model) beginSavePreview(confirm bool, intent usecase.RuntimeSaveIntent) (tea.Model, tea.Cmd) {
+	changeSets, err := m.buildDatabaseChanges()
+	if err != nil {
+		m.ui.pendingSaveSuccessAction = usecase.RuntimeSaveSuccessActionNone
+		m.ui.statusMessage = "Error: " + err.Error()
+		return m, nil
+	}
+	if len(changeSets) == 0 {
+		if confirm {
+			return m.startRuntimeSave(intent)
+		}
+		m.ui.statusMessage = "No changes to preview"
+		return m, nil
+	}
+	if m.previewChanges == nil {
+		m.ui.pendingSaveSuccessAction = usecase.RuntimeSaveSuccessActionNone
+		m.ui.statusMessage = "Error: preview use case unavailable"
+		return m, nil
+	}
+	m.ui.savePreviewInFlight = true
+	return m, savePreviewCmd(m.ctx, m.previewChanges, changeSets, confirm, intent)
+}
+
+func (m *Model) handleSavePreviewMsg(msg savePreviewMsg) (tea.Model, tea.Cmd) {
+	m.ui.savePreviewInFlight = false
+	if msg.err != nil {
+		m.ui.statusMessage = "Error: " + msg.err.Error()
+		if msg.confirm {
+			m.ui.pendingSaveSuccessAction = usecase.RuntimeSaveSuccessActionNone
+			m.dropPendingNavigation()
+		}
+		return m, nil
+	}
+	m.overlay.savePreview = savePreviewPopup{
+		active:     true,
+		statements: msg.statements,
+		confirm:    msg.confirm,
+		intent:     msg.intent,
+	}
+	return m, nil
+}
+
+func (m *Model) handleSavePreviewKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+
+	switch {
+	case primitives.KeyMatches(primitives.KeyRuntimeEsc, key):
+		preview := m.overlay.savePreview
+		m.overlay.savePreview = savePreviewPopup{}
+		if preview.confirm {
+			m.ui.pendingSaveSuccessAction = usecase.RuntimeSaveSuccessActionNone
+			m.ui.pendingNavigation = nil
+			m.ui.pendingCommandInput = ""
+			m.ui.statusMessage = "Save cancelled"
+		}
+		return m, nil
+	case primitives.KeyMatches(primitives.KeyRuntimeEnter, key):
+		preview := m.overlay.savePreview
+		if !preview.confirm {
+			return m, nil
+		}
+		m.overlay.savePreview = savePreviewPopup{}
+		model, cmd := m.startRuntimeSave(preview.intent)
+		if !m.ui.saveInFlight && m.ui.pendingNavigation != nil {
+			m.dropPendingNavigation()
+		}
+		return model, cmd
+	case primitives.KeyMatches(primitives.KeyPopupMoveDown, key):
+		m.moveSavePreviewScroll(1)
+	case primitives.KeyMatches(primitives.KeyPopupMoveUp, key):
+		m.moveSavePreviewScroll(-1)
+	case primitives.KeyMatches(primitives.KeyRuntimePageDown, key):
+		m.moveSavePreviewScroll(m.savePreviewVisibleLines())
+	case primitives.KeyMatches(primitives.KeyRuntimePageUp, key):
+		m.moveSavePreviewScroll(-m.savePreviewVisibleLines())
+	case primitives.KeyMatches(primitives.KeyPopupJumpTop, key):
+		m.overlay.savePreview.scrollOffset = 0
+	case primitives.KeyMatches(primitives.KeyPopupJumpBottom, key):
+		m.overlay.savePreview.scrollOffset = m.savePreviewMaxOffset()
+	}
+	return m, nil
+}
+
+func (m *Model) moveSavePreviewScroll(delta int) {
+	m.overlay.savePreview.scrollOffset = clamp(m.overlay.savePreview.scrollOffset+delta, 0, m.savePreviewMaxOffset())
+}
+
+func (m *Model) savePreviewVisibleLines() int {
+	const minVisibleLines = 6
+	const maxVisibleLines = 20
+
+	visible := m.contentHeight() - 10
+	if visible < minVisibleLines {
+		return minVisibleLines
+	}
+	if visible > maxVisibleLines {
+		return maxVisibleLines
+	}
+	return visible
+}
+
+func (m *Model) savePreviewMaxOffset() int {
+	maxOffset := len(m.savePreviewContentLines(m.savePreviewTotalWidth())) - m.savePreviewVisibleLines()
+	if maxOffset < 0 {
+		return 0
+	}
+	return maxOffset
+}
+
+func (m *Model) savePreviewTotalWidth() int {
+	if m.ui.width <= 0 {
+		return 80
+	}
+	return m.ui.width
+}
+
+func savePreviewPopupSpec() primitives.StandardizedPopupSpec {
+	return primitives.StandardizedPopupSpec{
+		ShowScrollIndicator: true,
+		DefaultWidth:        80,
+		MinWidth:            30,
+		MaxWidth:            100,
+	}
+}
+
+// savePreviewContentLines numbers each statement in execution order and lists
+// its bound values below it, wrapped to the popup width.
+func (m *Model) savePreviewContentLines(totalWidth int) []string {
+	width := primitives.StandardizedPopupContentWidth(totalWidth, savePreviewPopupSpec())
+	lines := make([]string, 0, len(m.overlay.savePreview.statements)*3)
+	for i, statement := range m.overlay.savePreview.statements {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		sql := primitives.SanitizeDisplayText(statement.SQL, primitives.DisplaySanitizeSingleLine)
+		lines = append(lines, primitives.WrapTextToWidth(fmt.Sprintf("%d. %s;", i+1, sql), width)...)
+		if len(statement.Args) == 0 {
+			continue
+		}
+		args := make([]string, len(statement.Args))
+		for j, arg := range statement.Args {
+			args[j] = primitives.SanitizeDisplayText(arg, primitives.DisplaySanitizeSingleLine)
+		}
+		lines = append(lines, primitives.WrapTextToWidth("   args: "+strings.Join(args, ", "), width)...)
+	}
+	return lines
+}
@@ -0,0 +1,153 @@
+package tui
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/mgierok/dbc/internal/application/dto"
+)
+
+func newSavePreviewTestModel(saveChanges *spySaveChangesUseCase, previewChanges *spyPreviewChangesUseCase) *Model {
+	model := newSaveConflictTestModel(saveChanges)
+	model.previewChanges = previewChanges
+	model.ui.width = 100
+	model.ui.height = 30
+	return model
+}
+
+func savePreviewStatementsForTest() []dto.WriteStatement {
+	return []dto.WriteStatement{{
+		Table: "users",
+		SQL:   `UPDATE "users" SET "name" = ? WHERE "id" = ?`,
+		Args:  []string{"'alicia'", "1"},
+	}}
+}
+
+func submitRuntimeCommandForTest(model *Model, command string) tea.Cmd {
+	model.overlay.commandInput = commandInput{active: true, value: command, cursor: len(command)}
+	_, cmd := model.submitCommandInput()
+	return cmd
+}
+
+func TestSubmitCommandInput_PreviewOpensStatementsPopup(t *testing.T) {
+	// Arrange
+	previewChanges := &spyPreviewChangesUseCase{statements: savePreviewStatementsForTest()}
+	model := newSavePreviewTestModel(&spySaveChangesUseCase{}, previewChanges)
+
+	// Act
+	cmd := submitRuntimeCommandForTest(model, "preview")
+	if cmd == nil {
+		t.Fatal("expected preview command")
+	}
+	model.Update(cmd())
+
+	// Assert
+	if len(previewChanges.lastChangeSets) != 1 || previewChanges.lastChangeSets[0].Table != "users" {
+		t.Fatalf("expected users change set to be previewed, got %+v", previewChanges.lastChangeSets)
+	}
+	if !model.overlay.savePreview.active || model.overlay.savePreview.confirm {
+		t.Fatalf("expected read-only preview popup, got %+v", model.overlay.savePreview)
+	}
+	view := stripANSI(model.View())
+	for _, expected := range []string{"SQL Preview", `1. UPDATE "users" SET "name" = ? WHERE "id" = ?;`, "args: 'alicia', 1"} {
+		if !strings.Contains(view, expected) {
+			t.Fatalf("expected preview to contain %q, got %q", expected, view)
+		}
+	}
+	if !model.hasDirtyEdits() {
+		t.Fatal("expected preview to keep staged changes")
+	}
+}
+
+func TestSubmitCommandInput_PreviewWithoutChangesShowsStatus(t *testing.T) {
+	// Arrange
+	previewChanges := &spyPreviewChangesUseCase{}
+	model := withTestStaging(newSavePreviewTestModel(&spySaveChangesUseCase{}, previewChanges), stagingState{})
+
+	// Act
+	cmd := submitRuntimeCommandForTest(model, "preview")
+
+	// Assert
+	if cmd != nil {
+		t.Fatal("expected no preview command without staged changes")
+	}
+	if model.ui.statusMessage != "No changes to preview" {
+		t.Fatalf("unexpected status %q", model.ui.statusMessage)
+	}
+}
+
+func TestSubmitCommandInput_PreviewErrorShowsStatus(t *testing.T) {
+	// Arrange
+	previewChanges := &spyPreviewChangesUseCase{err: errors.New("boom")}
+	model := newSavePreviewTestModel(&spySaveChangesUseCase{}, previewChanges)
+
+	// Act
+	cmd := submitRuntimeCommandForTest(model, "preview")
+	model.Update(cmd())
+
+	// Assert
+	if model.overlay.savePreview.active {
+		t.Fatal("expected no preview popup after error")
+	}
+	if model.ui.statusMessage != "Error: boom" {
+		t.Fatalf("unexpected status %q", model.ui.statusMessage)
+	}
+}
+
+func TestSubmitCommandInput_SaveWithConfirmSaveRequiresPreviewConfirmation(t *testing.T) {
+	t.Run("enter saves", func(t *testing.T) {
+		// Arrange
+		saveChanges := &spySaveChangesUseCase{count: 1}
+		model := newSavePreviewTestModel(saveChanges, &spyPreviewChangesUseCase{statements: savePreviewStatementsForTest()})
+		model.confirmSave = true
+		model.Update(submitRuntimeCommandForTest(model, "w")())
+
+		// Act
+		_, cmd := model.handleKey(tea.KeyMsg{Type: tea.KeyEnter})
+
+		// Assert
+		if cmd == nil {
+			t.Fatal("expected save command after confirmation")
+		}
+		if _, ok := cmd().(saveChangesMsg); !ok {
+			t.Fatal("expected save to run after confirmation")
+		}
+		if len(saveChanges.lastChangeSets) != 1 {
+			t.Fatalf("expected staged changes to be saved, got %+v", saveChanges.lastChangeSets)
+		}
+		if model.overlay.savePreview.active {
+			t.Fatal("expected preview popup to close")
+		}
+	})
+
+	t.Run("esc cancels", func(t *testing.T) {
+		// Arrange
+		saveChanges := &spySaveChangesUseCase{count: 1}
+		model := newSavePreviewTestModel(saveChanges, &spyPreviewChangesUseCase{statements: savePreviewStatementsForTest()})
+		model.confirmSave = true
+		model.Update(submitRuntimeCommandForTest(model, "w")())
+		if !model.overlay.savePreview.active || !model.overlay.savePreview.confirm {
+			t.Fatalf("expected confirming preview popup, got %+v", model.overlay.savePreview)
+		}
+
+		// Act
+		_, cmd := model.handleKey(tea.KeyMsg{Type: tea.KeyEsc})
+
+		// Assert
+		if cmd != nil {
+			t.Fatal("expected no save command after cancel")
+		}
+		if saveChanges.lastChangeSets != nil {
+			t.Fatal("expected nothing to be saved")
+		}
+		if model.ui.statusMessage != "Save cancelled" {
+			t.Fatalf("unexpected status %q", model.ui.statusMessage)
+		}
+		if !model.hasDirtyEdits() {
+			t.Fatal("expected staged changes to be kept")
+		}
+	})
+}
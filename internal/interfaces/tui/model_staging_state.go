@@ -7,8 +7,19 @@ import (
 	"github.com/mgierok/dbc/internal/application/dto"
 )
 
+// insertDraftKey identifies a pending insert across tables, since each
+// table's staging session numbers its drafts independently.
+type insertDraftKey struct {
+	table string
+	id    dto.InsertDraftID
+}
+
 type stagingUIState struct {
-	showAuto map[dto.InsertDraftID]bool
+	showAuto map[insertDraftKey]bool
+	// duplicateUnique holds the unique-column values a duplicated insert
+	// copied, by column index, so those cells stay highlighted until the user
+	// changes them.
+	duplicateUnique map[insertDraftKey]map[int]dto.StagedValue
 }
 
 func displayValue(value dto.StagedValue) string {
@@ -37,7 +37,7 @@ func withTestStaging(model *Model, seed stagingState) *Model {
 	if model == nil {
 		panic("model is nil")
 	}
-	model.stagingSession = usecase.NewStagingSession(model.stagingPolicyUseCase(), model.translatorUseCase())
+	model.stagingWorkspace = usecase.NewStagingWorkspace(model.stagingPolicyUseCase(), model.translatorUseCase())
 	model.stagingUI = stagingUIState{}
 	model.stagingSnapshot = dto.StagingSnapshot{}
 
@@ -64,7 +64,7 @@ func withTestStaging(model *Model, seed stagingState) *Model {
 
 	for key, update := range seed.pendingUpdates {
 		for columnIndex, change := range update.changes {
-			original := model.originalDisplayValueForSeed(key, columnIndex)
+			original := model.originalValueForSeed(key, columnIndex)
 			if err := model.stagingSessionUseCase().StagePersistedEdit(key, update.identity, columnIndex, original, change.Value); err != nil {
 				panic(fmt.Sprintf("seed update %q column %d failed: %v", key, columnIndex, err))
 			}
@@ -121,15 +121,15 @@ func stagedValueEqual(left, right dto.StagedValue) bool {
 	return reflect.DeepEqual(left.Raw, right.Raw)
 }
 
-func (m *Model) originalDisplayValueForSeed(recordKey string, columnIndex int) string {
+func (m *Model) originalValueForSeed(recordKey string, columnIndex int) dto.StagedValue {
 	for rowIndex := range m.read.records {
 		recordRef, err := m.persistedRecordRefForPersistedRow(rowIndex)
 		if err != nil || recordRef.RowKey != recordKey {
 			continue
 		}
-		return m.recordValue(rowIndex, columnIndex)
+		return m.recordOriginalValue(rowIndex, columnIndex)
 	}
-	return ""
+	return dto.StagedValue{}
 }
 
 func testPendingInsertsFromSnapshot(snapshot dto.StagingSnapshot) []pendingInsertRow {
@@ -2,21 +2,24 @@ package tui
 
 import (
 	"context"
+	"errors"
 
 	"github.com/mgierok/dbc/internal/application/dto"
+	"github.com/mgierok/dbc/internal/application/usecase"
 )
 
 type spyListRecordsUseCase struct {
-	lastSort          *dto.Sort
-	lastFilter        *dto.Filter
+	lastSort          []dto.Sort
+	lastFilter        *dto.FilterGroup
 	lastRecordsOffset int
 	lastRecordsLimit  int
+	lastCursor        string
 	page              dto.RecordPage
 	err               error
 }
 
-func (s *spyListRecordsUseCase) Execute(ctx context.Context, tableName string, offset, limit int, filter *dto.Filter, sort *dto.Sort) (dto.RecordPage, error) {
-	s.lastSort = sort
+func (s *spyListRecordsUseCase) Execute(ctx context.Context, tableName string, offset, limit int, filter *dto.FilterGroup, sorts []dto.Sort) (dto.RecordPage, error) {
+	s.lastSort = sorts
 	if filter != nil {
 		copied := *filter
 		s.lastFilter = &copied
@@ -31,6 +34,35 @@ func (s *spyListRecordsUseCase) Execute(ctx context.Context, tableName string, o
 	return s.page, nil
 }
 
+func (s *spyListRecordsUseCase) ExecuteFromCursor(ctx context.Context, tableName, cursor string, limit int, filter *dto.FilterGroup, sorts []dto.Sort) (dto.RecordPage, error) {
+	s.lastCursor = cursor
+	return s.Execute(ctx, tableName, 0, limit, filter, sorts)
+}
+
+type spyCountRecordsUseCase struct {
+	count       int
+	estimate    int
+	hasEstimate bool
+	err         error
+	lastTable   string
+	lastFilter  *dto.FilterGroup
+	calls       int
+}
+
+func (s *spyCountRecordsUseCase) Execute(ctx context.Context, tableName string, filter *dto.FilterGroup) (int, error) {
+	s.calls++
+	s.lastTable = tableName
+	s.lastFilter = filter
+	if s.err != nil {
+		return 0, s.err
+	}
+	return s.count, nil
+}
+
+func (s *spyCountRecordsUseCase) Estimate(ctx context.Context, tableName string, filter *dto.FilterGroup) (int, bool, error) {
+	return s.estimate, s.hasEstimate, nil
+}
+
 type spyListOperatorsUseCase struct {
 	operators      []dto.Operator
 	err            error
@@ -46,12 +78,209 @@ func (s *spyListOperatorsUseCase) Execute(ctx context.Context, columnType string
 }
 
 type spySaveChangesUseCase struct {
-	lastChanges dto.TableChanges
-	count       int
-	err         error
+	lastChangeSets []dto.TableChangeSet
+	lastChanges    dto.TableChanges
+	count          int
+	err            error
 }
 
-func (s *spySaveChangesUseCase) ExecuteDTO(ctx context.Context, tableName string, changes dto.TableChanges) (int, error) {
-	s.lastChanges = changes
+func (s *spySaveChangesUseCase) ExecuteAllDTO(ctx context.Context, changeSets []dto.TableChangeSet) (int, error) {
+	s.lastChangeSets = changeSets
+	s.lastChanges = dto.TableChanges{}
+	if len(changeSets) > 0 {
+		s.lastChanges = changeSets[0].Changes
+	}
 	return s.count, s.err
 }
+
+type spyPreviewChangesUseCase struct {
+	lastChangeSets []dto.TableChangeSet
+	statements     []dto.WriteStatement
+	err            error
+}
+
+func (s *spyPreviewChangesUseCase) ExecuteDTO(ctx context.Context, changeSets []dto.TableChangeSet) ([]dto.WriteStatement, error) {
+	s.lastChangeSets = changeSets
+	return s.statements, s.err
+}
+
+type spyExportChangesUseCase struct {
+	lastPath       string
+	lastChangeSets []dto.TableChangeSet
+	count          int
+	err            error
+}
+
+func (s *spyExportChangesUseCase) ExecuteDTO(ctx context.Context, path string, changeSets []dto.TableChangeSet) (int, error) {
+	s.lastPath = path
+	s.lastChangeSets = changeSets
+	return s.count, s.err
+}
+
+type spyExportRecordsUseCase struct {
+	lastExport dto.RecordExport
+	count      int
+	err        error
+}
+
+func (s *spyExportRecordsUseCase) Execute(ctx context.Context, export dto.RecordExport) (int, error) {
+	s.lastExport = export
+	return s.count, s.err
+}
+
+// spyImportRecordsUseCase returns a prepared file from Read and maps and
+// validates it with the real import use case.
+type spyImportRecordsUseCase struct {
+	file         dto.ImportFile
+	err          error
+	lastPath     string
+	lastNullText string
+}
+
+func (s *spyImportRecordsUseCase) Read(ctx context.Context, path string) (dto.ImportFile, error) {
+	s.lastPath = path
+	if s.err != nil {
+		return dto.ImportFile{}, s.err
+	}
+	file := s.file
+	file.Path = path
+	return file, nil
+}
+
+func (s *spyImportRecordsUseCase) MatchColumns(schema dto.Schema, headers []string) []string {
+	return usecase.NewImportRecords(nil, nil, nil, nil).MatchColumns(schema, headers)
+}
+
+func (s *spyImportRecordsUseCase) BuildInserts(schema dto.Schema, file dto.ImportFile, mapping []string, nullText string) (dto.ImportResult, error) {
+	s.lastNullText = nullText
+	return usecase.NewImportRecords(nil, nil, nil, nil).BuildInserts(schema, file, mapping, nullText)
+}
+
+type spyRunSQLUseCase struct {
+	lastStatement string
+	lastOffset    int
+	lastLimit     int
+	pageCalls     int
+	result        dto.SQLResult
+	err           error
+}
+
+func (s *spyRunSQLUseCase) Execute(ctx context.Context, statement string, limit int) (dto.SQLResult, error) {
+	s.lastStatement = statement
+	s.lastOffset = 0
+	s.lastLimit = limit
+	return s.result, s.err
+}
+
+func (s *spyRunSQLUseCase) ExecutePage(ctx context.Context, statement string, offset, limit int) (dto.SQLResult, error) {
+	s.pageCalls++
+	s.lastStatement = statement
+	s.lastOffset = offset
+	s.lastLimit = limit
+	return s.result, s.err
+}
+
+type spyHistoryUseCase struct {
+	entries     map[dto.HistoryKind][]string
+	recordKind  dto.HistoryKind
+	recordEntry string
+	recordCalls int
+	loadErr     error
+}
+
+func (s *spyHistoryUseCase) Load(ctx context.Context, kind dto.HistoryKind) ([]string, error) {
+	if s.loadErr != nil {
+		return nil, s.loadErr
+	}
+	return append([]string(nil), s.entries[kind]...), nil
+}
+
+func (s *spyHistoryUseCase) Record(ctx context.Context, kind dto.HistoryKind, entry string) ([]string, error) {
+	s.recordCalls++
+	s.recordKind = kind
+	s.recordEntry = entry
+	return append([]string(nil), s.entries[kind]...), nil
+}
+
+type spySavedViewsUseCase struct {
+	views     []dto.SavedView
+	saved     []dto.SavedView
+	listTable string
+	loadErr   error
+}
+
+func (s *spySavedViewsUseCase) List(ctx context.Context, table string) ([]string, error) {
+	s.listTable = table
+	names := []string{}
+	for _, view := range s.views {
+		if view.Table == table {
+			names = append(names, view.Name)
+		}
+	}
+	return names, nil
+}
+
+func (s *spySavedViewsUseCase) Save(ctx context.Context, view dto.SavedView) error {
+	s.saved = append(s.saved, view)
+	return nil
+}
+
+func (s *spySavedViewsUseCase) Load(ctx context.Context, table, name string) (dto.SavedView, error) {
+	if s.loadErr != nil {
+		return dto.SavedView{}, s.loadErr
+	}
+	for _, view := range s.views {
+		if view.Table == table && view.Name == name {
+			return view, nil
+		}
+	}
+	return dto.SavedView{}, errors.New("saved view not found")
+}
+
+type spyYankRecordsUseCase struct {
+	text       string
+	err        error
+	lastTable  string
+	lastRow    dto.YankRow
+	lastColumn int
+	lastYank   dto.RecordYank
+}
+
+func (s *spyYankRecordsUseCase) Cell(ctx context.Context, table string, columns []dto.SchemaColumn, row dto.YankRow, column int) (string, error) {
+	s.lastTable = table
+	s.lastRow = row
+	s.lastColumn = column
+	return s.text, s.err
+}
+
+func (s *spyYankRecordsUseCase) Rows(ctx context.Context, yank dto.RecordYank) (string, error) {
+	s.lastTable = yank.Table
+	s.lastYank = yank
+	return s.text, s.err
+}
+
+type spyReadRecordUseCase struct {
+	values       []dto.StagedValue
+	err          error
+	lastTable    string
+	lastIdentity dto.RecordIdentity
+}
+
+func (s *spyReadRecordUseCase) Execute(ctx context.Context, tableName string, identity dto.RecordIdentity) ([]dto.StagedValue, error) {
+	s.lastTable = tableName
+	s.lastIdentity = identity
+	return s.values, s.err
+}
+
+type spyClipboard struct {
+	copied []string
+	err    error
+}
+
+func (s *spyClipboard) Copy(text string) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.copied = append(s.copied, text)
+	return nil
+}
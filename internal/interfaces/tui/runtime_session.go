@@ -2,6 +2,10 @@ package tui
 
 type RuntimeSessionState struct {
 	RecordsPageLimit       int
+	ExportBlob             string
+	ExportNullText         string
+	ImportNullText         string
+	YankFormat             string
 	nextRuntimeBundleToken int
 }
 
@@ -295,6 +295,14 @@ func (f *fakeSelectorConfigStore) ActivePath(_ context.Context) (string, error)
 	return f.activePath, nil
 }
 
+func (f *fakeSelectorConfigStore) ListViews(_ context.Context, _ int) ([]port.ConfigView, error) {
+	return nil, nil
+}
+
+func (f *fakeSelectorConfigStore) SaveView(_ context.Context, _ int, _ port.ConfigView) error {
+	return nil
+}
+
 type fakeSelectorConnectionChecker struct {
 	callCount int
 	paths     []string
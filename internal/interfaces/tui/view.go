@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/mgierok/dbc/internal/application/dto"
 	"github.com/mgierok/dbc/internal/interfaces/tui/internal/primitives"
 )
 
@@ -42,6 +43,10 @@ func (m *Model) activeRuntimeOverlay(width, height int) []string {
 	switch {
 	case m.overlay.helpPopup.active:
 		return m.renderHelpPopup(width)
+	case m.overlay.savePreview.active:
+		return m.renderSavePreviewPopup(width)
+	case m.overlay.importPopup.active:
+		return m.renderImportPopup(width)
 	case m.overlay.confirmPopup.active:
 		return m.renderConfirmPopup(width)
 	case m.overlay.editPopup.active:
@@ -117,12 +122,15 @@ func (m *Model) panelWidths() (int, int) {
 }
 
 func (m *Model) contentPanelTitle() string {
+	if m.overlay.sqlConsole.active {
+		return "SQL Console"
+	}
 	if m.read.viewMode == ViewRecords {
 		if m.overlay.recordDetail.active {
 			return "Record Detail"
 		}
-		if m.hasDirtyEdits() {
-			return fmt.Sprintf("Records [staged rows: %d]", m.dirtyEditCount())
+		if count := m.tableDirtyEditCount(m.currentTableName()); count > 0 {
+			return fmt.Sprintf("Records [staged rows: %d]", count)
 		}
 		return "Records"
 	}
@@ -139,7 +147,14 @@ func (m *Model) maxTablePanelWidth() int {
 	longestNameWidth := 0
 	for _, table := range m.read.tables {
 		sanitizedName := primitives.SanitizeDisplayText(table.Name, primitives.DisplaySanitizeSingleLine)
-		longestNameWidth = primitives.MaxInt(longestNameWidth, primitives.TextWidth(sanitizedName))
+		nameWidth := primitives.TextWidth(sanitizedName)
+		if table.Kind == dto.TableKindView {
+			nameWidth += primitives.TextWidth(tableViewBadge)
+		}
+		if m.tableDirtyEditCount(table.Name) > 0 {
+			nameWidth += primitives.TextWidth(tableDirtyBadge)
+		}
+		longestNameWidth = primitives.MaxInt(longestNameWidth, nameWidth)
 	}
 	if longestNameWidth == 0 {
 		return maxWidth
@@ -149,6 +164,12 @@ func (m *Model) maxTablePanelWidth() int {
 	return primitives.MaxInt(maxWidth, tableListWidth)
 }
 
+// tableViewBadge marks views in the Tables panel.
+const tableViewBadge = " [view]"
+
+// tableDirtyBadge marks tables with staged changes in the Tables panel.
+const tableDirtyBadge = " " + primitives.IconEdit
+
 func (m *Model) renderTables(width, height int) []string {
 	return m.renderTablesWithStyles(width, height, m.styles)
 }
@@ -157,6 +178,12 @@ func (m *Model) renderTablesWithStyles(width, height int, styles primitives.Rend
 	semanticItems := make([]primitives.SemanticLine, len(m.read.tables))
 	for i, table := range m.read.tables {
 		semanticItems[i] = primitives.SemanticText(primitives.SemanticRoleBody, table.Name)
+		if table.Kind == dto.TableKindView {
+			semanticItems[i] = append(semanticItems[i], primitives.Span(primitives.SemanticRoleMuted, tableViewBadge))
+		}
+		if m.tableDirtyEditCount(table.Name) > 0 {
+			semanticItems[i] = append(semanticItems[i], primitives.Span(primitives.SemanticRoleDirty, tableDirtyBadge))
+		}
 	}
 
 	listLines := primitives.RenderList(semanticItems, m.read.selectedTable, height, width, true, styles)
@@ -168,6 +195,12 @@ func (m *Model) renderContent(width, height int) []string {
 }
 
 func (m *Model) renderContentWithStyles(width, height int, styles primitives.RenderStyles) []string {
+	if m.overlay.sqlConsole.active {
+		if styles == m.styles {
+			return m.renderSQLConsole(width, height)
+		}
+		return m.renderSQLConsoleWithStyles(width, height, styles)
+	}
 	switch m.read.viewMode {
 	case ViewRecords:
 		if m.overlay.recordDetail.active {
@@ -55,6 +55,9 @@ func (m *Model) visibleCommandPrompt(width int) string {
 	if m.overlay.commandInput.mode == commandInputModePending {
 		return truncateToWidth(m.overlay.commandInput.pendingStatus, width)
 	}
+	if m.overlay.commandInput.search.active {
+		return truncateToWidth(m.overlay.commandInput.search.prompt(m.ui.commandHistory), width)
+	}
 	if width == 1 {
 		return ":"
 	}
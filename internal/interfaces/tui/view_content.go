@@ -1,6 +1,7 @@
 package tui
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/mgierok/dbc/internal/application/dto"
@@ -16,14 +17,100 @@ func (m *Model) renderSchemaWithStyles(width, height int, styles primitives.Rend
 		return primitives.PadLines([]string{primitives.PadRight(styles.Render(primitives.SemanticRoleBody, "No schema loaded."), width)}, height, width)
 	}
 
-	items := make([]primitives.SemanticLine, len(m.read.schema.Columns))
-	for i, column := range m.read.schema.Columns {
-		items[i] = renderSchemaLine(column)
-	}
+	items := schemaViewLines(m.read.schema)
 	lines := primitives.RenderList(items, m.read.schemaIndex, height, width, m.read.focus == FocusContent && m.read.viewMode == ViewSchema, styles)
 	return primitives.PadLines(lines, height, width)
 }
 
+// schemaViewLines lists the column rows followed by the Constraints, Indexes,
+// Triggers, and DDL sections, which are omitted when empty.
+func schemaViewLines(schema dto.Schema) []primitives.SemanticLine {
+	items := make([]primitives.SemanticLine, 0, len(schema.Columns)+len(schema.Constraints)+len(schema.Indexes)+len(schema.Triggers)+4)
+	for _, column := range schema.Columns {
+		items = append(items, renderSchemaLine(column))
+	}
+	if len(schema.Constraints) > 0 {
+		items = append(items, schemaSectionHeader("Constraints"))
+		for _, constraint := range schema.Constraints {
+			items = append(items, renderSchemaConstraintLine(constraint))
+		}
+	}
+	if len(schema.Indexes) > 0 {
+		items = append(items, schemaSectionHeader("Indexes"))
+		for _, index := range schema.Indexes {
+			items = append(items, renderSchemaIndexLine(index))
+		}
+	}
+	if len(schema.Triggers) > 0 {
+		items = append(items, schemaSectionHeader("Triggers"))
+		for _, trigger := range schema.Triggers {
+			items = append(items, primitives.SemanticLine{
+				primitives.Span(primitives.SemanticRoleHeader, schemaSectionIndent+trigger.Name),
+			})
+		}
+	}
+	statements := schemaDefinitionStatements(schema)
+	if len(statements) > 0 {
+		items = append(items, schemaSectionHeader("DDL"))
+		for _, statement := range statements {
+			for _, line := range strings.Split(statement, "\n") {
+				items = append(items, primitives.SemanticText(primitives.SemanticRoleMuted, schemaSectionIndent+line))
+			}
+		}
+	}
+	return items
+}
+
+const schemaSectionIndent = "  "
+
+func schemaSectionHeader(title string) primitives.SemanticLine {
+	return primitives.SemanticText(primitives.SemanticRoleTitle, title)
+}
+
+func renderSchemaConstraintLine(constraint dto.SchemaConstraint) primitives.SemanticLine {
+	return primitives.SemanticLine{
+		primitives.Span(primitives.SemanticRoleBody, schemaSectionIndent+constraint.Label),
+	}
+}
+
+func renderSchemaIndexLine(index dto.SchemaIndex) primitives.SemanticLine {
+	line := primitives.SemanticLine{
+		primitives.Span(primitives.SemanticRoleHeader, schemaSectionIndent+index.Name),
+		primitives.Span(primitives.SemanticRoleBody, " ("+strings.Join(index.Columns, ", ")+")"),
+	}
+	switch {
+	case index.Origin == dto.SchemaIndexOriginPrimaryKey:
+		line = append(line, schemaBadge("PK")...)
+	case index.Unique:
+		line = append(line, schemaBadge("UNIQUE")...)
+	}
+	if index.Partial {
+		line = append(line, schemaBadge("PARTIAL")...)
+	}
+	return line
+}
+
+// schemaDefinitionStatements returns the stored CREATE statements of the
+// table, its explicit indexes, and its triggers, like the sqlite3 .schema
+// command.
+func schemaDefinitionStatements(schema dto.Schema) []string {
+	statements := make([]string, 0, 1+len(schema.Indexes)+len(schema.Triggers))
+	if strings.TrimSpace(schema.SQL) != "" {
+		statements = append(statements, schema.SQL+";")
+	}
+	for _, index := range schema.Indexes {
+		if strings.TrimSpace(index.SQL) != "" {
+			statements = append(statements, index.SQL+";")
+		}
+	}
+	for _, trigger := range schema.Triggers {
+		if strings.TrimSpace(trigger.SQL) != "" {
+			statements = append(statements, trigger.SQL+";")
+		}
+	}
+	return statements
+}
+
 func renderSchemaLine(column dto.SchemaColumn) primitives.SemanticLine {
 	line := primitives.SemanticLine{
 		primitives.Span(primitives.SemanticRoleHeader, column.Name),
@@ -97,6 +184,9 @@ func (m *Model) renderRecordsWithStyles(width, height int, styles primitives.Ren
 				if value, ok := insert.Values[colIndex]; ok {
 					displayValues[colIndex] = displayValue(value.Value)
 				}
+				if m.duplicateUniqueNeedsAdjust(insert, colIndex) {
+					displayValues[colIndex] = primitives.IconAdjust + " " + displayValues[colIndex]
+				}
 			}
 		} else {
 			for colIndex := range columns {
@@ -113,13 +203,14 @@ func (m *Model) renderRecordsWithStyles(width, height int, styles primitives.Ren
 		}
 		row := formatRecordRow(displayValues, columnWidths, focusColumn)
 		rowMarker := m.recordRowMarker(i)
+		highlighted := selected || m.rowInVisualSelection(i)
 		lineRole := primitives.SemanticRoleBody
 		if m.isRowMarkedDelete(i) {
 			lineRole = primitives.SemanticRoleDeleted
-			if selected {
+			if highlighted {
 				lineRole = primitives.SemanticRoleSelectedDeleted
 			}
-		} else if selected {
+		} else if highlighted {
 			lineRole = primitives.SemanticRoleSelected
 		}
 		line := styles.Render(lineRole, primitives.PadRight(prefix+rowMarker+" "+row, width))
@@ -209,6 +300,9 @@ func (m *Model) recordDetailContentLinesWithStyles(width int, styles primitives.
 		if edited {
 			header += " " + styles.Render(primitives.SemanticRoleBody, primitives.IconEdit)
 		}
+		if insert, isInsert := m.pendingInsertForRow(rowIndex); isInsert && m.duplicateUniqueNeedsAdjust(insert, columnIndex) {
+			header += " " + styles.Render(primitives.SemanticRoleBody, primitives.IconAdjust+" copied unique value")
+		}
 		lines = append(lines, primitives.WrapTextToWidth(header, width)...)
 		if len(column.MetadataBadges) > 0 {
 			metadataLine := styles.RenderLine(renderMetadataBadgesLine(column.MetadataBadges))
@@ -226,6 +320,14 @@ func (m *Model) recordDetailContentLinesWithStyles(width int, styles primitives.
 			lines = append(lines, "")
 		}
 	}
+	if len(m.read.schema.Constraints) > 0 {
+		lines = append(lines, "")
+		lines = append(lines, styles.RenderLine(schemaSectionHeader("Constraints")))
+		for _, constraint := range m.read.schema.Constraints {
+			constraintLine := styles.RenderLine(renderSchemaConstraintLine(constraint))
+			lines = append(lines, primitives.WrapTextToWidth(constraintLine, width)...)
+		}
+	}
 	return lines
 }
 
@@ -276,13 +378,20 @@ func (m *Model) schemaColumnsForRecordsHeader() []string {
 	columns := make([]string, len(m.read.schema.Columns))
 	for i, column := range m.read.schema.Columns {
 		label := primitives.SanitizeDisplayText(column.Name, primitives.DisplaySanitizeSingleLine)
-		if m.read.currentSort != nil && column.Name == m.read.currentSort.Column {
-			switch m.read.currentSort.Direction {
+		for priority, key := range m.read.currentSort {
+			if column.Name != key.Column {
+				continue
+			}
+			switch key.Direction {
 			case dto.SortDirectionAsc:
 				label += " " + primitives.IconSortAsc
 			case dto.SortDirectionDesc:
 				label += " " + primitives.IconSortDesc
 			}
+			if len(m.read.currentSort) > 1 {
+				label += strconv.Itoa(priority + 1)
+			}
+			break
 		}
 		columns[i] = label
 	}
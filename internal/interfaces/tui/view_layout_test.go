@@ -102,6 +102,24 @@ func TestRenderTables_ShowsSelectionMarkerWithoutBoldWhenTablePanelIsNotFocused(
 	}
 }
 
+func TestRenderTables_MarksTablesWithStagedChanges(t *testing.T) {
+	// Arrange
+	model := newDirtyTableSwitchModel()
+	model.ui.width = 80
+
+	// Act
+	lines := model.renderTables(20, 4)
+
+	// Assert
+	content := stripANSI(strings.Join(lines, "\n"))
+	if !strings.Contains(content, "users"+tableDirtyBadge) {
+		t.Fatalf("expected dirty marker for users, got %q", content)
+	}
+	if strings.Contains(content, "orders"+tableDirtyBadge) {
+		t.Fatalf("expected no dirty marker for clean orders table, got %q", content)
+	}
+}
+
 func TestPanelWidths_PreservesMinimumRightPanelWidthInNarrowWindow(t *testing.T) {
 	// Arrange
 	model := &Model{
@@ -127,3 +145,34 @@ func TestPanelWidths_PreservesMinimumRightPanelWidthInNarrowWindow(t *testing.T)
 		t.Fatalf("expected panel widths to match available width, got left=%d right=%d total=%d", leftWidth, rightWidth, model.ui.width)
 	}
 }
+
+func TestRenderTables_MarksViewsWithBadgeWithinComputedMaxWidth(t *testing.T) {
+	// Arrange
+	model := &Model{
+		ui: runtimeUIState{width: 180},
+		read: runtimeReadState{
+			focus: FocusTables,
+			tables: []dto.Table{
+				{Name: "users", Kind: dto.TableKindTable},
+				{Name: "active_users", Kind: dto.TableKindView},
+			},
+		},
+	}
+
+	leftWidth, _ := model.panelWidths()
+
+	// Act
+	lines := model.renderTables(leftWidth, 4)
+
+	// Assert
+	rendered := stripANSI(strings.Join(lines, "\n"))
+	if !strings.Contains(rendered, "active_users [view]") {
+		t.Fatalf("expected view badge next to view name, got %q", rendered)
+	}
+	if strings.Count(rendered, "[view]") != 1 {
+		t.Fatalf("expected only the view to carry the badge, got %q", rendered)
+	}
+	if strings.Contains(rendered, "...") {
+		t.Fatalf("expected no truncation of badged view name, got %q", rendered)
+	}
+}
@@ -23,10 +23,66 @@ func (m *Model) renderHelpPopup(totalWidth int) []string {
 	})
 }
 
+func (m *Model) renderSavePreviewPopup(totalWidth int) []string {
+	spec := savePreviewPopupSpec()
+	spec.Title = primitives.SemanticText(primitives.SemanticRoleTitle, "SQL Preview")
+	spec.Summary = primitives.SemanticText(
+		primitives.SemanticRoleSummary,
+		primitives.RuntimeSavePreviewSummaryLine(len(m.overlay.savePreview.statements), m.overlay.savePreview.confirm),
+	)
+	spec.Rows = primitives.PopupTextRows(m.savePreviewContentLines(totalWidth))
+	spec.ScrollOffset = m.overlay.savePreview.scrollOffset
+	spec.VisibleRows = m.savePreviewVisibleLines()
+	spec.Styles = m.styles
+	return primitives.RenderStandardizedPopup(totalWidth, m.ui.height, spec)
+}
+
+func (m *Model) renderImportPopup(totalWidth int) []string {
+	popup := m.overlay.importPopup
+	spec := importPopupSpec()
+	spec.VisibleRows = m.importPopupVisibleLines()
+	spec.Styles = m.styles
+	if popup.step == importStepReport {
+		spec.Title = primitives.SemanticText(primitives.SemanticRoleTitle, "Import Report")
+		spec.Summary = primitives.SemanticText(
+			primitives.SemanticRoleSummary,
+			primitives.RuntimeImportReportSummaryLine(popup.stagedCount, rejectedImportLineCount(popup.errors)),
+		)
+		spec.Rows = primitives.PopupTextRows(m.importReportContentLines(totalWidth))
+		spec.ScrollOffset = popup.scrollOffset
+		return primitives.RenderStandardizedPopup(totalWidth, m.ui.height, spec)
+	}
+	spec.Title = primitives.SemanticText(primitives.SemanticRoleTitle, "Import "+primitives.SanitizeDisplayText(popup.file.Path, primitives.DisplaySanitizeSingleLine))
+	spec.Summary = primitives.SemanticText(primitives.SemanticRoleSummary, primitives.RuntimeImportMappingSummaryLine(len(popup.file.Rows)))
+	spec.Rows = m.importMappingRows()
+	if len(spec.Rows) <= spec.VisibleRows {
+		spec.VisibleRows = 0
+	} else if popup.columnIndex >= spec.VisibleRows {
+		spec.ScrollOffset = popup.columnIndex - spec.VisibleRows + 1
+	}
+	return primitives.RenderStandardizedPopup(totalWidth, m.ui.height, spec)
+}
+
 func (m *Model) renderFilterPopup(totalWidth int) []string {
 	stepLabel := "Select column"
 	rows := []primitives.StandardizedPopupRow{}
 	switch m.overlay.filterPopup.step {
+	case filterSelectCondition:
+		logic := dto.FilterLogicAnd
+		if m.read.currentFilter != nil && m.read.currentFilter.Logic != "" {
+			logic = m.read.currentFilter.Logic
+		}
+		stepLabel = fmt.Sprintf("Conditions (%s)", logic)
+		conditions := m.currentFilterConditions()
+		conditionRows := make([]primitives.SemanticLine, len(conditions))
+		for i, condition := range conditions {
+			conditionRows[i] = primitives.SemanticText(primitives.SemanticRoleBody, formatFilterCondition(condition))
+		}
+		selected := -1
+		if len(conditionRows) > 0 {
+			selected = clamp(m.overlay.filterPopup.conditionIndex, 0, len(conditionRows)-1)
+		}
+		rows = primitives.PopupSemanticSelectableRows(conditionRows, selected)
 	case filterSelectColumn:
 		columnRows := make([]primitives.SemanticLine, len(m.read.schema.Columns))
 		for i, column := range m.read.schema.Columns {
@@ -53,7 +109,21 @@ func (m *Model) renderFilterPopup(totalWidth int) []string {
 		input := m.overlay.filterPopup.input
 		cursor := clamp(m.overlay.filterPopup.cursor, 0, len(input))
 		value := input[:cursor] + "|" + input[cursor:]
-		rows = primitives.PopupSemanticTextRows([]primitives.SemanticLine{rawLabelValueLine("Value", value)})
+		lines := []primitives.SemanticLine{}
+		operator, _ := m.selectedFilterOperator()
+		switch operator.ValueMode {
+		case dto.OperatorValueRange:
+			stepLabel = "Enter lower bound"
+			if len(m.overlay.filterPopup.values) > 0 {
+				stepLabel = "Enter upper bound"
+				lines = append(lines, rawLabelValueLine("From", m.overlay.filterPopup.values[0]))
+			}
+		case dto.OperatorValueList:
+			stepLabel = "Enter values (Enter on empty value applies)"
+			lines = append(lines, rawLabelValueLine("Values", strings.Join(m.overlay.filterPopup.values, ", ")))
+		}
+		lines = append(lines, rawLabelValueLine("Value", value))
+		rows = primitives.PopupSemanticTextRows(lines)
 	}
 
 	return primitives.RenderStandardizedPopup(totalWidth, m.ui.height, primitives.StandardizedPopupSpec{
@@ -94,6 +164,41 @@ func (m *Model) renderSortPopup(totalWidth int) []string {
 			selected = clamp(m.overlay.sortPopup.directionIndex, 0, len(directionRows)-1)
 		}
 		rows = primitives.PopupSemanticSelectableRows(directionRows, selected)
+	case sortSelectNulls:
+		stepLabel = "Select NULL placement"
+		options := sortNullsOptions()
+		optionRows := make([]primitives.SemanticLine, len(options))
+		for i, nulls := range options {
+			label := "Default"
+			if nulls != dto.SortNullsDefault {
+				label = "NULLS " + string(nulls)
+			}
+			optionRows[i] = primitives.SemanticText(primitives.SemanticRoleBody, label)
+		}
+		rows = primitives.PopupSemanticSelectableRows(optionRows, clamp(m.overlay.sortPopup.nullsIndex, 0, len(optionRows)-1))
+	case sortSelectCollation:
+		stepLabel = "Select collation"
+		options := sortCollationOptions()
+		optionRows := make([]primitives.SemanticLine, len(options))
+		for i, collation := range options {
+			label := "Default"
+			if collation != dto.SortCollationDefault {
+				label = string(collation)
+			}
+			optionRows[i] = primitives.SemanticText(primitives.SemanticRoleBody, label)
+		}
+		rows = primitives.PopupSemanticSelectableRows(optionRows, clamp(m.overlay.sortPopup.collationIndex, 0, len(optionRows)-1))
+	case sortSelectKey:
+		stepLabel = "Sort keys by priority"
+		keyRows := make([]primitives.SemanticLine, len(m.read.currentSort))
+		for i, key := range m.read.currentSort {
+			keyRows[i] = primitives.SemanticText(primitives.SemanticRoleBody, fmt.Sprintf("%d. %s", i+1, formatSortKey(key)))
+		}
+		selected := -1
+		if len(keyRows) > 0 {
+			selected = clamp(m.overlay.sortPopup.keyIndex, 0, len(keyRows)-1)
+		}
+		rows = primitives.PopupSemanticSelectableRows(keyRows, selected)
 	}
 
 	return primitives.RenderStandardizedPopup(totalWidth, m.ui.height, primitives.StandardizedPopupSpec{
@@ -152,8 +257,12 @@ func (m *Model) renderEditPopup(totalWidth int) []string {
 		rows = append(rows, primitives.StandardizedPopupRow{Line: primitives.SemanticText(primitives.SemanticRoleError, "Error: "+m.overlay.editPopup.errorMessage)})
 	}
 
+	title := "Edit Cell"
+	if rowCount := len(m.overlay.editPopup.bulkTargets); rowCount > 0 {
+		title = fmt.Sprintf("Set Column for %d Rows", rowCount)
+	}
 	return primitives.RenderStandardizedPopup(totalWidth, m.ui.height, primitives.StandardizedPopupSpec{
-		Title:        primitives.SemanticText(primitives.SemanticRoleTitle, "Edit Cell"),
+		Title:        primitives.SemanticText(primitives.SemanticRoleTitle, title),
 		Summary:      primitives.SemanticText(primitives.SemanticRoleSummary, columnLabel+primitives.FrameSegmentSeparator+nullableLabel),
 		Rows:         rows,
 		DefaultWidth: 60,
@@ -181,11 +290,13 @@ func (m *Model) renderConfirmPopup(totalWidth int) []string {
 	if len(options) > 0 {
 		selected = clamp(m.overlay.confirmPopup.selected, 0, len(options)-1)
 	}
+	rows := primitives.PopupTextRows(m.overlay.confirmPopup.details)
+	rows = append(rows, primitives.PopupSemanticSelectableRows(primitives.SemanticTexts(primitives.SemanticRoleBody, options), selected)...)
 
 	return primitives.RenderStandardizedPopup(totalWidth, m.ui.height, primitives.StandardizedPopupSpec{
 		Title:        primitives.SemanticText(primitives.SemanticRoleTitle, title),
 		Summary:      primitives.SemanticText(primitives.SemanticRoleSummary, message),
-		Rows:         primitives.PopupSemanticSelectableRows(primitives.SemanticTexts(primitives.SemanticRoleBody, options), selected),
+		Rows:         rows,
 		DefaultWidth: 50,
 		MinWidth:     20,
 		MaxWidth:     60,
@@ -417,43 +417,6 @@ func TestRenderConfirmPopup_DirtyDatabaseReloadShowsMessageAndOptionLabels(t *te
 	}
 }
 
-func TestRenderConfirmPopup_DirtyTableSwitchShowsMessageAndExplicitActions(t *testing.T) {
-	// Arrange
-	model := &Model{
-		overlay: runtimeOverlayState{
-			confirmPopup: confirmPopup{
-				active:  true,
-				title:   "Switch Table",
-				message: "Switching tables will cause loss of unsaved data (3 rows). Are you sure you want to discard unsaved data?",
-				options: []confirmOption{
-					{label: "Discard changes and switch table", decisionID: usecase.DirtyDecisionDiscard},
-					{label: "Continue editing", decisionID: usecase.DirtyDecisionCancel},
-				},
-				selected: 0,
-				modal:    true,
-			},
-		},
-	}
-
-	// Act
-	lines := model.renderConfirmPopup(120)
-	popup := stripANSI(strings.Join(lines, "\n"))
-
-	// Assert
-	if !strings.Contains(popup, "Switching tables will cause loss of unsaved data") {
-		t.Fatalf("expected informational switch-table summary in popup, got %q", popup)
-	}
-	if !strings.Contains(popup, "Discard changes and switch table") {
-		t.Fatalf("expected discard action label in popup, got %q", popup)
-	}
-	if !strings.Contains(popup, "Continue editing") {
-		t.Fatalf("expected continue-editing action label in popup, got %q", popup)
-	}
-	if !strings.Contains(popup, primitives.IconSelection+" Discard changes and switch table") {
-		t.Fatalf("expected selected discard action in popup, got %q", popup)
-	}
-}
-
 func TestRenderConfirmPopup_DirtyQuitShowsMessageAndExplicitActions(t *testing.T) {
 	// Arrange
 	model := &Model{
@@ -161,6 +161,32 @@ func TestRecordDetailContentLines_ShowsProjectedMetadataBadgesBelowFieldHeader(t
 	}
 }
 
+func TestRecordDetailContentLines_ShowsTableLevelConstraintsAfterFields(t *testing.T) {
+	// Arrange
+	model := newRecordsViewModel(
+		dto.Schema{
+			Columns: []dto.SchemaColumn{
+				{Name: "parent_id", Type: "INTEGER"},
+				{Name: "parent_code", Type: "TEXT"},
+			},
+			Constraints: []dto.SchemaConstraint{
+				{Kind: dto.SchemaConstraintForeignKey, Label: "FOREIGN KEY (parent_id, parent_code) REFERENCES parents (id, code) ON DELETE CASCADE"},
+				{Kind: dto.SchemaConstraintCheck, Label: "CHECK (parent_id > 0)"},
+			},
+		},
+		[]dto.RecordRow{{Values: []string{"1", "a"}}},
+	)
+
+	// Act
+	content := stripANSI(strings.Join(model.recordDetailContentLines(120), "\n"))
+
+	// Assert
+	expected := "  a\n\nConstraints\n  FOREIGN KEY (parent_id, parent_code) REFERENCES parents (id, code) ON DELETE CASCADE\n  CHECK (parent_id > 0)"
+	if !strings.HasSuffix(content, expected) {
+		t.Fatalf("expected constraints section after fields, got %q", content)
+	}
+}
+
 func TestRecordDetailContentLines_StrikesDeleteMarkedFieldValuesOnly(t *testing.T) {
 	// Arrange
 	model := newStyledRecordsViewModel(
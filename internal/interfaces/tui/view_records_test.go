@@ -29,10 +29,10 @@ func TestRenderRecords_ShowsSortIndicatorInHeader(t *testing.T) {
 							{Name: "name", Type: "TEXT"},
 						},
 					},
-					currentSort: &dto.Sort{
+					currentSort: []dto.Sort{{
 						Column:    "name",
 						Direction: tc.direction,
-					},
+					}},
 					records: []dto.RecordRow{
 						{Values: []string{"1", "alice"}},
 					},
@@ -57,6 +57,41 @@ func TestRenderRecords_ShowsSortIndicatorInHeader(t *testing.T) {
 	}
 }
 
+func TestRenderRecords_ShowsSortPriorityForMultipleKeys(t *testing.T) {
+	// Arrange
+	model := &Model{
+		styles: primitives.NewRenderStyles(true),
+		read: runtimeReadState{
+			viewMode: ViewRecords,
+			schema: dto.Schema{
+				Columns: []dto.SchemaColumn{
+					{Name: "id", Type: "INTEGER"},
+					{Name: "name", Type: "TEXT"},
+				},
+			},
+			currentSort: []dto.Sort{
+				{Column: "name", Direction: dto.SortDirectionAsc},
+				{Column: "id", Direction: dto.SortDirectionDesc},
+			},
+			records: []dto.RecordRow{
+				{Values: []string{"1", "alice"}},
+			},
+		},
+	}
+
+	// Act
+	lines := model.renderRecords(80, 6)
+
+	// Assert
+	header := lines[1]
+	if !strings.Contains(header, "name "+primitives.IconSortAsc+"1") {
+		t.Fatalf("expected first-priority marker on name, got %q", header)
+	}
+	if !strings.Contains(header, "id "+primitives.IconSortDesc+"2") {
+		t.Fatalf("expected second-priority marker on id, got %q", header)
+	}
+}
+
 func TestBoxWidthForRecordHeaderColumn_UsesFullColumnWidth(t *testing.T) {
 	// Arrange
 	testCases := []struct {
@@ -80,3 +80,82 @@ func TestRenderSchema_KeepsRenderedLinesWithinWidth(t *testing.T) {
 		}
 	}
 }
+
+func TestRenderSchema_ShowsConstraintsIndexesTriggersAndDDLSections(t *testing.T) {
+	// Arrange
+	model := &Model{
+		read: runtimeReadState{
+			viewMode: ViewSchema,
+			focus:    FocusContent,
+			schema: dto.Schema{
+				TableName: "users",
+				Columns: []dto.SchemaColumn{
+					{Name: "id", Type: "INTEGER", MetadataBadges: []string{"PK"}},
+					{Name: "email", Type: "TEXT", MetadataBadges: []string{"NOT NULL", "UNIQUE"}},
+				},
+				Indexes: []dto.SchemaIndex{
+					{Name: "sqlite_autoindex_users_1", Columns: []string{"email"}, Unique: true, Origin: dto.SchemaIndexOriginUnique},
+					{Name: "users_email_name_idx", Columns: []string{"email", "name DESC"}, Partial: true, Origin: dto.SchemaIndexOriginCreate, SQL: "CREATE INDEX users_email_name_idx ON users(email, name DESC) WHERE email <> ''"},
+				},
+				Constraints: []dto.SchemaConstraint{
+					{Kind: dto.SchemaConstraintUnique, Label: "UNIQUE (email)"},
+				},
+				Triggers: []dto.SchemaTrigger{
+					{Name: "users_audit", SQL: "CREATE TRIGGER users_audit AFTER DELETE ON users BEGIN\n\tSELECT 1;\nEND"},
+				},
+				SQL: "CREATE TABLE users (\n\tid INTEGER PRIMARY KEY,\n\temail TEXT NOT NULL UNIQUE\n)",
+			},
+		},
+	}
+
+	// Act
+	lines := model.renderSchema(120, 20)
+	content := stripANSI(strings.Join(lines, "\n"))
+
+	// Assert
+	for _, expected := range []string{
+		"Constraints",
+		"UNIQUE (email)",
+		"Indexes",
+		"sqlite_autoindex_users_1 (email) [UNIQUE]",
+		"users_email_name_idx (email, name DESC) [PARTIAL]",
+		"Triggers",
+		"users_audit",
+		"DDL",
+		"CREATE TABLE users (",
+		"CREATE INDEX users_email_name_idx ON users(email, name DESC) WHERE email <> '';",
+		"END;",
+	} {
+		if !strings.Contains(content, expected) {
+			t.Fatalf("expected schema view to contain %q, got %q", expected, content)
+		}
+	}
+	for _, line := range lines {
+		if strings.Contains(line, "\n") || strings.Contains(line, "\t") {
+			t.Fatalf("expected single-line sanitized output, got %q", line)
+		}
+	}
+}
+
+func TestMoveContentSelection_SchemaScrollsThroughDetailSections(t *testing.T) {
+	// Arrange
+	model := &Model{
+		read: runtimeReadState{
+			viewMode: ViewSchema,
+			focus:    FocusContent,
+			schema: dto.Schema{
+				Columns: []dto.SchemaColumn{{Name: "id", Type: "INTEGER"}},
+				Indexes: []dto.SchemaIndex{{Name: "users_id_idx", Columns: []string{"id"}, SQL: "CREATE INDEX users_id_idx ON users(id)"}},
+				SQL:     "CREATE TABLE users (id INTEGER)",
+			},
+		},
+	}
+
+	// Act
+	model.moveContentSelection(100)
+
+	// Assert
+	if model.read.schemaIndex != 5 {
+		t.Fatalf("expected selection on last DDL line, got %d", model.read.schemaIndex)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/mgierok/dbc/internal/application/dto"
 	"github.com/mgierok/dbc/internal/interfaces/tui/internal/primitives"
 )
 
@@ -19,14 +20,22 @@ func (m *Model) renderStatusWithStyles(width int, styles primitives.RenderStyles
 	if m.hasDirtyEdits() {
 		mode = primitives.SemanticText(primitives.SemanticRoleDirty, primitives.IconEdit)
 	}
-	parts := []primitives.SemanticLine{
-		mode,
-		m.statusSegment("Table", m.currentTableName()),
+	parts := []primitives.SemanticLine{mode}
+	if m.readOnly {
+		parts = append(parts, primitives.SemanticText(primitives.SemanticRoleLabel, readOnlyStatusIndicator))
 	}
-	if m.read.viewMode == ViewRecords {
-		parts = append(parts, m.recordsSummary(), m.pageSummary())
+	parts = append(parts, m.statusSegment("Table", m.currentTableName()))
+	if m.overlay.sqlConsole.active {
+		parts = append(parts, m.statusSegment("Mode", "SQL"))
+	} else {
+		if m.read.viewMode == ViewRecords {
+			if m.read.visual.active {
+				parts = append(parts, m.statusSegment("Mode", fmt.Sprintf("VISUAL %d rows", m.visualSelectionCount())))
+			}
+			parts = append(parts, m.recordsSummary(), m.pageSummary())
+		}
+		parts = append(parts, m.filterSummary(), m.sortSummary())
 	}
-	parts = append(parts, m.filterSummary(), m.sortSummary())
 	if strings.TrimSpace(m.ui.statusMessage) != "" {
 		parts = append(parts, m.styleStatusMessage(m.ui.statusMessage))
 	}
@@ -35,29 +44,60 @@ func (m *Model) renderStatusWithStyles(width int, styles primitives.RenderStyles
 }
 
 func (m *Model) filterSummary() primitives.SemanticLine {
-	if m.read.currentFilter == nil {
+	if !filterGroupHasConditions(m.read.currentFilter) {
 		return m.statusSegment("Filter", "none")
 	}
-	if m.read.currentFilter.Operator.RequiresValue {
-		return m.statusSegment("Filter", fmt.Sprintf("%s %s %s", m.read.currentFilter.Column, m.read.currentFilter.Operator.Name, m.read.currentFilter.Value))
-	}
-	return m.statusSegment("Filter", fmt.Sprintf("%s %s", m.read.currentFilter.Column, m.read.currentFilter.Operator.Name))
+	return m.statusSegment("Filter", formatFilterGroup(*m.read.currentFilter))
 }
 
 func (m *Model) sortSummary() primitives.SemanticLine {
-	if m.read.currentSort == nil {
+	if len(m.read.currentSort) == 0 {
 		return m.statusSegment("Sort", "none")
 	}
-	return m.statusSegment("Sort", fmt.Sprintf("%s %s", m.read.currentSort.Column, m.read.currentSort.Direction))
+	keys := make([]string, len(m.read.currentSort))
+	for i, key := range m.read.currentSort {
+		keys[i] = formatSortKey(key)
+	}
+	return m.statusSegment("Sort", strings.Join(keys, ", "))
 }
 
+const (
+	recordCountPendingText  = "counting…"
+	recordCountUnknownText  = "?"
+	readOnlyStatusIndicator = "READ-ONLY"
+)
+
 func (m *Model) recordsSummary() primitives.SemanticLine {
-	return m.statusSegment("Records", fmt.Sprintf("%d/%d", len(m.read.records), m.read.recordTotalCount))
+	switch m.read.recordCountStatus {
+	case recordCountCounting:
+		return m.statusSegment("Records", fmt.Sprintf("%d/%s", len(m.read.records), recordCountPendingText))
+	case recordCountUnknown:
+		return m.statusSegment("Records", fmt.Sprintf("%d/%s", len(m.read.records), recordCountUnknownText))
+	case recordCountEstimated:
+		return m.statusSegment("Records", fmt.Sprintf("%d/~%d", len(m.read.records), m.read.recordTotalCount))
+	default:
+		return m.statusSegment("Records", fmt.Sprintf("%d/%d", len(m.read.records), m.read.recordTotalCount))
+	}
 }
 
 func (m *Model) pageSummary() primitives.SemanticLine {
-	currentPage := clamp(m.read.recordPageIndex+1, 1, primitives.MaxInt(1, m.read.recordTotalPages))
-	return m.statusSegment("Page", fmt.Sprintf("%d/%d", currentPage, primitives.MaxInt(1, m.read.recordTotalPages)))
+	switch m.read.recordCountStatus {
+	case recordCountCounting, recordCountUnknown:
+		// Only a lower bound is known: the current page plus one more if the
+		// loaded page reported further rows.
+		currentPage := m.read.recordPageIndex + 1
+		lowerBound := currentPage
+		if m.read.recordHasMore {
+			lowerBound++
+		}
+		return m.statusSegment("Page", fmt.Sprintf("%d/≥%d", currentPage, lowerBound))
+	case recordCountEstimated:
+		currentPage := m.read.recordPageIndex + 1
+		return m.statusSegment("Page", fmt.Sprintf("%d/~%d", currentPage, primitives.MaxInt(currentPage, m.read.recordTotalPages)))
+	default:
+		currentPage := clamp(m.read.recordPageIndex+1, 1, primitives.MaxInt(1, m.read.recordTotalPages))
+		return m.statusSegment("Page", fmt.Sprintf("%d/%d", currentPage, primitives.MaxInt(1, m.read.recordTotalPages)))
+	}
 }
 
 func (m *Model) statusSegment(label, value string) primitives.SemanticLine {
@@ -103,3 +143,45 @@ func (m *Model) renderStatusBox(width int, styles primitives.RenderStyles) []str
 		primitives.FrameBottomLeft + strings.Repeat(primitives.FrameHorizontal, innerWidth) + primitives.FrameBottomRight,
 	}
 }
+
+func formatFilterGroup(group dto.FilterGroup) string {
+	logic := group.Logic
+	if logic == "" {
+		logic = dto.FilterLogicAnd
+	}
+	parts := make([]string, 0, len(group.Conditions)+len(group.Groups))
+	for _, condition := range group.Conditions {
+		parts = append(parts, formatFilterCondition(condition))
+	}
+	for _, nested := range group.Groups {
+		if !filterGroupHasConditions(&nested) {
+			continue
+		}
+		parts = append(parts, "("+formatFilterGroup(nested)+")")
+	}
+	return strings.Join(parts, " "+string(logic)+" ")
+}
+
+func formatFilterCondition(condition dto.Filter) string {
+	switch {
+	case !condition.Operator.RequiresValue:
+		return fmt.Sprintf("%s %s", condition.Column, condition.Operator.Name)
+	case condition.Operator.ValueMode == dto.OperatorValueRange && len(condition.Values) == 2:
+		return fmt.Sprintf("%s %s %s AND %s", condition.Column, condition.Operator.Name, condition.Values[0], condition.Values[1])
+	case condition.Operator.ValueMode == dto.OperatorValueList:
+		return fmt.Sprintf("%s %s (%s)", condition.Column, condition.Operator.Name, strings.Join(condition.Values, ", "))
+	default:
+		return fmt.Sprintf("%s %s %s", condition.Column, condition.Operator.Name, condition.Value)
+	}
+}
+
+func formatSortKey(key dto.Sort) string {
+	label := fmt.Sprintf("%s %s", key.Column, key.Direction)
+	if key.Nulls != dto.SortNullsDefault {
+		label += " NULLS " + string(key.Nulls)
+	}
+	if key.Collation != dto.SortCollationDefault {
+		label += " " + string(key.Collation)
+	}
+	return label
+}
@@ -77,6 +77,19 @@ func TestRenderStatus_ShowsCleanIconInsteadOfReadOnlyLabel(t *testing.T) {
 	}
 }
 
+func TestRenderStatus_ShowsReadOnlyIndicatorForReadOnlySession(t *testing.T) {
+	// Arrange
+	model := &Model{readOnly: true}
+
+	// Act
+	status := stripANSI(model.renderStatus(120))
+
+	// Assert
+	if !strings.Contains(status, "○ | READ-ONLY | Table:") {
+		t.Fatalf("expected read-only indicator after mode icon, got %q", status)
+	}
+}
+
 func TestRenderStatus_ShowsDirtyIconInsteadOfDirtyCountText(t *testing.T) {
 	// Arrange
 	model := withTestStaging(&Model{}, stagingState{
@@ -200,15 +213,15 @@ func TestRenderStatus_SanitizesFilterSortAndErrorSegments(t *testing.T) {
 	model := &Model{
 		read: runtimeReadState{
 			viewMode: ViewRecords,
-			currentFilter: &dto.Filter{
+			currentFilter: singleConditionFilter(dto.Filter{
 				Column:   "na\x1b[31mme",
 				Operator: dto.Operator{Name: "Eq\r\nuals", RequiresValue: true},
 				Value:    "ali\tce\x1b]2;ignored\a",
-			},
-			currentSort: &dto.Sort{
+			}),
+			currentSort: []dto.Sort{{
 				Column:    "id\x1b[32m",
 				Direction: dto.SortDirection("DES\x1b[0mC"),
-			},
+			}},
 		},
 		ui: runtimeUIState{
 			statusMessage: "Error: boom\x1b[31m\r\nnext",
@@ -236,3 +249,82 @@ func TestRenderStatus_SanitizesFilterSortAndErrorSegments(t *testing.T) {
 		t.Fatalf("expected sanitized error status message, got %q", plainStatus)
 	}
 }
+
+func TestRenderStatus_ShowsMultiKeySortSummary(t *testing.T) {
+	// Arrange
+	model := &Model{
+		read: runtimeReadState{
+			viewMode: ViewRecords,
+			currentSort: []dto.Sort{
+				{Column: "name", Direction: dto.SortDirectionAsc, Nulls: dto.SortNullsLast, Collation: dto.SortCollationNoCase},
+				{Column: "id", Direction: dto.SortDirectionDesc},
+			},
+		},
+	}
+
+	// Act
+	plainStatus := stripANSI(model.renderStatus(220))
+
+	// Assert
+	if !strings.Contains(plainStatus, "Sort: name ASC NULLS LAST NOCASE, id DESC") {
+		t.Fatalf("expected multi-key sort summary, got %q", plainStatus)
+	}
+}
+
+func TestRenderStatus_ShowsCompoundFilterSummary(t *testing.T) {
+	// Arrange
+	model := &Model{
+		read: runtimeReadState{
+			viewMode: ViewRecords,
+			currentFilter: &dto.FilterGroup{
+				Logic: dto.FilterLogicAnd,
+				Conditions: []dto.Filter{
+					{Column: "status", Operator: dto.Operator{Name: "Equals", RequiresValue: true}, Value: "failed"},
+				},
+				Groups: []dto.FilterGroup{
+					{
+						Logic: dto.FilterLogicOr,
+						Conditions: []dto.Filter{
+							{Column: "owner", Operator: dto.Operator{Name: "Equals", RequiresValue: true}, Value: "ops"},
+							{Column: "owner", Operator: dto.Operator{Name: "Is Null"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// Act
+	status := stripANSI(model.renderStatus(220))
+
+	// Assert
+	expected := "Filter: status Equals failed AND (owner Equals ops OR owner Is Null)"
+	if !strings.Contains(status, expected) {
+		t.Fatalf("expected compound filter summary %q, got %q", expected, status)
+	}
+}
+
+func TestRenderStatus_ShowsMultiValueFilterSummary(t *testing.T) {
+	// Arrange
+	model := &Model{
+		read: runtimeReadState{
+			viewMode: ViewRecords,
+			currentFilter: &dto.FilterGroup{
+				Logic: dto.FilterLogicAnd,
+				Conditions: []dto.Filter{
+					{Column: "age", Operator: dto.Operator{Name: "Between", RequiresValue: true, ValueMode: dto.OperatorValueRange}, Values: []string{"18", "65"}},
+					{Column: "role", Operator: dto.Operator{Name: "In", RequiresValue: true, ValueMode: dto.OperatorValueList}, Values: []string{"admin", "owner"}},
+				},
+			},
+		},
+	}
+
+	// Act
+	status := stripANSI(model.renderStatus(220))
+
+	// Assert
+	expected := "Filter: age Between 18 AND 65 AND role In (admin, owner)"
+	if !strings.Contains(status, expected) {
+		t.Fatalf("expected multi-value filter summary %q, got %q", expected, status)
+	}
+}
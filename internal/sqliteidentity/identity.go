@@ -1,24 +1,37 @@
 package sqliteidentity
 
 import (
+	"net/url"
 	"path/filepath"
 	"strings"
 )
 
+const (
+	uriScheme      = "file:"
+	memoryDatabase = ":memory:"
+)
+
+// Normalize returns the identity of the database a connection string opens.
+// File-backed databases, whether given as a path or a file: URI, normalize to
+// their absolute clean path, so URI query parameters such as mode=ro do not
+// change identity. In-memory and temporary databases keep the trimmed
+// connection string itself.
 func Normalize(connString string) string {
-	normalized := strings.TrimSpace(connString)
-	if normalized == "" {
+	trimmed := strings.TrimSpace(connString)
+	if trimmed == "" {
 		return ""
 	}
-
-	normalized = filepath.Clean(normalized)
+	path, ok := FilePath(trimmed)
+	if !ok {
+		return trimmed
+	}
+	normalized := filepath.Clean(path)
 	if !filepath.IsAbs(normalized) {
 		absPath, err := filepath.Abs(normalized)
 		if err == nil {
 			normalized = absPath
 		}
 	}
-
 	return normalized
 }
 
@@ -28,6 +41,56 @@ func Equivalent(left, right string) bool {
 	if normalizedLeft == "" || normalizedRight == "" {
 		return false
 	}
-
 	return normalizedLeft == normalizedRight
 }
+
+// IsURI reports whether connString uses SQLite's file: URI syntax.
+func IsURI(connString string) bool {
+	trimmed := strings.TrimSpace(connString)
+	return len(trimmed) >= len(uriScheme) && strings.EqualFold(trimmed[:len(uriScheme)], uriScheme)
+}
+
+// SplitURI splits a file: URI into the part before its query and the raw
+// query, dropping any fragment.
+func SplitURI(connString string) (base string, query string) {
+	trimmed := strings.TrimSpace(connString)
+	if index := strings.IndexByte(trimmed, '#'); index >= 0 {
+		trimmed = trimmed[:index]
+	}
+	base, query, _ = strings.Cut(trimmed, "?")
+	return base, query
+}
+
+// FilePath returns the path of the database file a connection string opens:
+// the string itself for plain paths, or the decoded path of a file: URI. It
+// reports false for in-memory and temporary databases, which have no file.
+func FilePath(connString string) (string, bool) {
+	trimmed := strings.TrimSpace(connString)
+	if !IsURI(trimmed) {
+		if trimmed == "" || trimmed == memoryDatabase {
+			return "", false
+		}
+		return trimmed, true
+	}
+
+	base, query := SplitURI(trimmed)
+	path := base[len(uriScheme):]
+	if strings.HasPrefix(path, "//") {
+		authority, rest, _ := strings.Cut(path[2:], "/")
+		if authority != "" && !strings.EqualFold(authority, "localhost") {
+			return "", false
+		}
+		path = "/" + rest
+	}
+	decoded, err := url.PathUnescape(path)
+	if err != nil {
+		return "", false
+	}
+	if decoded == "" || decoded == memoryDatabase {
+		return "", false
+	}
+	if values, err := url.ParseQuery(query); err == nil && values.Get("mode") == "memory" {
+		return "", false
+	}
+	return decoded, true
+}
@@ -3,6 +3,7 @@ package sqliteidentity
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -44,3 +45,74 @@ func TestEquivalent_ReturnsTrueForEquivalentPaths(t *testing.T) {
 		t.Fatalf("expected paths %q and %q to be equivalent", absolutePath, equivalentPath)
 	}
 }
+
+func TestNormalize_ResolvesFileURIsToDatabasePath(t *testing.T) {
+	t.Parallel()
+
+	absolutePath := filepath.Join(t.TempDir(), "runtime db.sqlite")
+	cases := []struct {
+		name       string
+		connString string
+		want       string
+	}{
+		{
+			name:       "uri with query parameters",
+			connString: "file:" + absolutePath + "?mode=ro&immutable=1",
+			want:       absolutePath,
+		},
+		{
+			name:       "uri with localhost authority and escaped path",
+			connString: "file://localhost" + strings.ReplaceAll(absolutePath, " ", "%20") + "?cache=shared",
+			want:       absolutePath,
+		},
+		{
+			name:       "uri with empty authority and fragment",
+			connString: "file://" + absolutePath + "#main",
+			want:       absolutePath,
+		},
+		{
+			name:       "in-memory uri keeps connection string",
+			connString: "file:shared?mode=memory&cache=shared",
+			want:       "file:shared?mode=memory&cache=shared",
+		},
+		{
+			name:       "memory database keeps connection string",
+			connString: ":memory:",
+			want:       ":memory:",
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			normalized := Normalize(tc.connString)
+
+			// Assert
+			if normalized != tc.want {
+				t.Fatalf("expected normalized identity %q, got %q", tc.want, normalized)
+			}
+		})
+	}
+}
+
+func TestEquivalent_TreatsFileURIAndPathToSameDatabaseAsEquivalent(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	absolutePath := filepath.Join(t.TempDir(), "runtime.sqlite")
+
+	// Act
+	equivalent := Equivalent("file:"+absolutePath+"?mode=ro", absolutePath)
+	memoryEquivalent := Equivalent("file:"+absolutePath+"?mode=memory", absolutePath)
+
+	// Assert
+	if !equivalent {
+		t.Fatalf("expected read-only uri and path for %q to be equivalent", absolutePath)
+	}
+	if memoryEquivalent {
+		t.Fatalf("expected in-memory uri not to match file %q", absolutePath)
+	}
+}